@@ -13,6 +13,7 @@ import (
 	"github.com/alexm24/golang/internal/config"
 	"github.com/alexm24/golang/internal/handler"
 	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/scheduler"
 	"github.com/alexm24/golang/internal/server"
 	"github.com/alexm24/golang/internal/service"
 	"github.com/alexm24/golang/internal/transport"
@@ -37,8 +38,8 @@ func App(configPath string) {
 		log.Panicf("failed to initialize redis db: %s", err.Error())
 	}
 
-	transports := transport.NewTransport(db, rp, cfg.CentrifugoConfig)
-	services := service.NewService(transports)
+	transports := transport.NewTransport(db, rp, cfg.CentrifugoConfig, cfg.RecordingsConfig.Dir)
+	services := service.NewService(transports, *cfg)
 	handlers := handler.NewHandler(services)
 
 	srv := new(server.Server)
@@ -55,6 +56,18 @@ func App(configPath string) {
 		}
 	}()
 
+	sch := scheduler.NewScheduler()
+	log.Printf("start broadcast lifecycle scheduler every %s", cfg.SchedulerConfig.Interval)
+	sch.Start("lifecycle", cfg.SchedulerConfig.Interval, services.ILifeCycle.UpdateLifeCycle)
+	log.Printf("start broadcast report mails every %s", cfg.SchedulerConfig.Interval)
+	sch.Start("reports", cfg.SchedulerConfig.Interval, services.IReports.SendReports)
+	log.Printf("start webhook deliveries every %s", cfg.WebhooksConfig.Interval)
+	sch.Start("webhooks", cfg.WebhooksConfig.Interval, services.IWebhooks.DeliverWebhooks)
+	log.Printf("start broadcast trash purge every %s", cfg.TrashConfig.Interval)
+	sch.Start("trash", cfg.TrashConfig.Interval, services.ITrash.PurgeTrash)
+	log.Printf("start viewers sampling every %s", cfg.SchedulerConfig.Interval)
+	sch.Start("viewers", cfg.SchedulerConfig.Interval, services.IAnalytics.TrackViewers)
+
 	signalLisner := make(chan os.Signal, 1)
 	signal.Notify(signalLisner,
 		syscall.SIGHUP,
@@ -65,6 +78,10 @@ func App(configPath string) {
 	stop := <-signalLisner
 	log.Printf("Shutting Down app: %s", stop)
 
+	if err = sch.Shutdown(context.Background()); err != nil {
+		log.Printf("error occurred on scheduler shutting down: %s", err.Error())
+	}
+
 	if err = srv.Shutdown(context.Background()); err != nil {
 		log.Printf("error occurred on server shutting down: %s", err.Error())
 	}
@@ -13,11 +13,53 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
+// SAccess defines model for SAccess.
+type SAccess struct {
+	BroadcastId *openapi_types.UUID `db:"broadcast_id" json:"broadcast_id,omitempty"`
+	CreatedAt   *time.Time          `db:"created_at" json:"created_at,omitempty"`
+	GrantedBy   *string             `db:"granted_by" json:"granted_by,omitempty"`
+	Username    *string             `json:"username,omitempty"`
+}
+
 // SAdmin defines model for SAdmin.
 type SAdmin struct {
 	IsAdmin *bool `json:"is_admin,omitempty"`
 }
 
+// SAnalytics defines model for SAnalytics.
+type SAnalytics struct {
+	// Users who sent messages
+	Chatters *int `json:"chatters,omitempty"`
+
+	// Confirmed registrations
+	Confirmed *int `json:"confirmed,omitempty"`
+	Messages  *int `json:"messages,omitempty"`
+
+	// Peak concurrent viewers, kept for 30 days
+	PeakViewers   *int              `json:"peak_viewers,omitempty"`
+	Questions     *int              `json:"questions,omitempty"`
+	ReactionTypes *[]SReactionCount `json:"reaction_types,omitempty"`
+	Reactions     *int              `json:"reactions,omitempty"`
+
+	// Registrations on the waitlist
+	Waitlisted *int `json:"waitlisted,omitempty"`
+}
+
+// SAnalyticsBucket defines model for SAnalyticsBucket.
+type SAnalyticsBucket struct {
+	Messages  *int `json:"messages,omitempty"`
+	Questions *int `json:"questions,omitempty"`
+
+	// Reactions to messages sent in the bucket
+	Reactions *int `json:"reactions,omitempty"`
+
+	// Start of the bucket
+	Time *time.Time `json:"time,omitempty"`
+
+	// Peak concurrent viewers in the bucket
+	Viewers *int `json:"viewers,omitempty"`
+}
+
 // SAnyValue defines model for SAnyValue.
 type SAnyValue = interface{}
 
@@ -26,7 +68,65 @@ type SBroadcast struct {
 	Description *string `json:"description,omitempty"`
 	Name        *string `json:"name,omitempty"`
 	Owner       *string `json:"owner,omitempty"`
-	StreamKey   *string `json:"stream_key,omitempty"`
+
+	// Generated by the server, rotated via /broadcasts/{id}/stream_key
+	StreamKey *string `json:"stream_key,omitempty"`
+}
+
+// SBroadcastPatch defines model for SBroadcastPatch.
+type SBroadcastPatch struct {
+	Capacity    *int       `json:"capacity"`
+	Description *string    `json:"description,omitempty"`
+	EndTime     *time.Time `json:"end_time"`
+	Name        *string    `json:"name,omitempty"`
+	StartTime   *time.Time `json:"start_time,omitempty"`
+
+	// public, internal or invite-only
+	Visibility *string `json:"visibility,omitempty"`
+}
+
+// SCalendarFeed defines model for SCalendarFeed.
+type SCalendarFeed struct {
+	// user or all
+	Scope *string `json:"scope,omitempty"`
+	Token *string `json:"token,omitempty"`
+}
+
+// SCapacity defines model for SCapacity.
+type SCapacity struct {
+	// Seats available to participants, unlimited when empty
+	Capacity *int `json:"capacity,omitempty"`
+}
+
+// SCategory defines model for SCategory.
+type SCategory struct {
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+	Name *string             `json:"name,omitempty"`
+}
+
+// SConflict defines model for SConflict.
+type SConflict struct {
+	Code *int `json:"code,omitempty"`
+
+	// Overlapping broadcasts
+	Conflicts *[]SConflictItem `json:"conflicts,omitempty"`
+	Message   *string          `json:"message,omitempty"`
+}
+
+// SConflictItem defines model for SConflictItem.
+type SConflictItem struct {
+	// Default duration is assumed when the broadcast has no end time
+	EndTime   *time.Time          `json:"end_time,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+	Owner     *string             `json:"owner,omitempty"`
+	StartTime *time.Time          `json:"start_time,omitempty"`
+}
+
+// SDeleted defines model for SDeleted.
+type SDeleted struct {
+	DeletedAt *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+	DeletedBy *string    `db:"deleted_by" json:"deleted_by,omitempty"`
 }
 
 // SDescription defines model for SDescription.
@@ -39,11 +139,31 @@ type SEMail struct {
 	Email *string `json:"email,omitempty"`
 }
 
+// SEdited defines model for SEdited.
+type SEdited struct {
+	// set on deleted messages, their text is cleared and replies are kept
+	DeletedAt *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+	EditedAt  *time.Time `db:"edited_at" json:"edited_at,omitempty"`
+}
+
+// SEndTime defines model for SEndTime.
+type SEndTime struct {
+	EndTime *time.Time `db:"end_time" json:"end_time,omitempty"`
+}
+
 // SFile defines model for SFile.
 type SFile struct {
 	File *string `json:"file,omitempty"`
 }
 
+// SFileInfo defines model for SFileInfo.
+type SFileInfo struct {
+	BroadcastId *openapi_types.UUID `db:"broadcast_id" json:"broadcast_id,omitempty"`
+	Name        *string             `json:"name,omitempty"`
+	Size        *int64              `json:"size,omitempty"`
+	Type        *string             `json:"type,omitempty"`
+}
+
 // SFullname defines model for SFullname.
 type SFullname struct {
 	Fullname *string `json:"fullname,omitempty"`
@@ -54,6 +174,40 @@ type SIdentifier struct {
 	Id *openapi_types.UUID `json:"id,omitempty"`
 }
 
+// SIngestCallback defines model for SIngestCallback.
+type SIngestCallback struct {
+	// SRS event name
+	Action *string `json:"action,omitempty"`
+	App    *string `json:"app,omitempty"`
+
+	// nginx-rtmp event name
+	Call *string `json:"call,omitempty"`
+
+	// SRS recorded file
+	File *string `json:"file,omitempty"`
+
+	// nginx-rtmp stream name, the stream key
+	Name *string `json:"name,omitempty"`
+
+	// nginx-rtmp recorded file
+	Path *string `json:"path,omitempty"`
+
+	// SRS stream name, the stream key
+	Stream *string `json:"stream,omitempty"`
+}
+
+// SIngestResult defines model for SIngestResult.
+type SIngestResult struct {
+	Code    *int    `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// SInvite defines model for SInvite.
+type SInvite struct {
+	Token *string `json:"token,omitempty"`
+	Url   *string `json:"url,omitempty"`
+}
+
 // SJson defines model for SJson.
 type SJson struct {
 	Json *string `json:"json,omitempty"`
@@ -61,6 +215,7 @@ type SJson struct {
 
 // SLifeCycle defines model for SLifeCycle.
 type SLifeCycle struct {
+	// created, live or past
 	Life *string `json:"life,omitempty"`
 }
 
@@ -74,26 +229,237 @@ type SMessage struct {
 	Time       *time.Time `json:"time,omitempty"`
 }
 
+// SMessageEdit defines model for SMessageEdit.
+type SMessageEdit struct {
+	EditedAt  *time.Time          `db:"edited_at" json:"edited_at,omitempty"`
+	EditedBy  *string             `db:"edited_by" json:"edited_by,omitempty"`
+	MessageId *openapi_types.UUID `db:"message_id" json:"message_id,omitempty"`
+	Text      *string             `db:"text" json:"text,omitempty"`
+}
+
+// SMessageText defines model for SMessageText.
+type SMessageText struct {
+	Text *string `json:"text,omitempty"`
+}
+
+// SParent defines model for SParent.
+type SParent struct {
+	ParentId *openapi_types.UUID `db:"parent_id" json:"parent_id,omitempty"`
+}
+
 // SPlace defines model for SPlace.
 type SPlace struct {
 	Place *string `json:"place,omitempty"`
 }
 
+// SPlayUrl defines model for SPlayUrl.
+type SPlayUrl struct {
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Signed url of the recording
+	PlayUrl *string `json:"play_url,omitempty"`
+}
+
 // SPreviewUrl defines model for SPreviewUrl.
 type SPreviewUrl struct {
 	PreviewUrl *string `json:"preview_url,omitempty"`
 }
 
+// SQuestion defines model for SQuestion.
+type SQuestion struct {
+	Pinned *bool `db:"pinned" json:"pinned,omitempty"`
+
+	// pending, approved, answered or dismissed
+	QuestionState *string `db:"question_state" json:"question_state,omitempty"`
+	Votes         *int    `db:"votes" json:"votes,omitempty"`
+}
+
+// SQuestionStateInput defines model for SQuestionStateInput.
+type SQuestionStateInput struct {
+	// pending, approved, answered or dismissed
+	State *string `json:"state,omitempty"`
+}
+
+// SRRule defines model for SRRule.
+type SRRule struct {
+	// iCalendar RRULE, e.g. FREQ=WEEKLY;BYDAY=MO;COUNT=10
+	Rrule *string `json:"rrule,omitempty"`
+}
+
+// SReactionCount defines model for SReactionCount.
+type SReactionCount struct {
+	Count *int    `json:"count,omitempty"`
+	Type  *string `json:"type,omitempty"`
+}
+
+// SRecording defines model for SRecording.
+type SRecording struct {
+	BroadcastId *openapi_types.UUID `db:"broadcast_id" json:"broadcast_id,omitempty"`
+	CreatedAt   *time.Time          `db:"created_at" json:"created_at,omitempty"`
+	CreatedBy   *string             `db:"created_by" json:"created_by,omitempty"`
+
+	// Duration in seconds
+	Duration *int `db:"duration" json:"duration,omitempty"`
+
+	// Container format, e.g. mp4, flv
+	Format *string `db:"format" json:"format,omitempty"`
+
+	// Size in bytes
+	Size *int64 `db:"size" json:"size,omitempty"`
+}
+
+// SRecordingFile defines model for SRecordingFile.
+type SRecordingFile struct {
+	// Duration in seconds
+	Duration *int    `json:"duration,omitempty"`
+	File     *string `json:"file,omitempty"`
+}
+
+// SRecordingUrl defines model for SRecordingUrl.
+type SRecordingUrl struct {
+	// Absolute http or https url of the recording
+	Url *string `json:"url,omitempty"`
+}
+
+// SRecordings defines model for SRecordings.
+type SRecordings struct {
+	Recordings *[]struct {
+		BroadcastId *openapi_types.UUID `db:"broadcast_id" json:"broadcast_id,omitempty"`
+		CreatedAt   *time.Time          `db:"created_at" json:"created_at,omitempty"`
+		CreatedBy   *string             `db:"created_by" json:"created_by,omitempty"`
+
+		// Duration in seconds
+		Duration  *int       `db:"duration" json:"duration,omitempty"`
+		ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+		// Container format, e.g. mp4, flv
+		Format *string             `db:"format" json:"format,omitempty"`
+		Id     *openapi_types.UUID `json:"id,omitempty"`
+
+		// Signed url of the recording
+		PlayUrl *string `json:"play_url,omitempty"`
+
+		// Size in bytes
+		Size *int64 `db:"size" json:"size,omitempty"`
+	} `json:"recordings,omitempty"`
+}
+
+// SRegistration defines model for SRegistration.
+type SRegistration struct {
+	CreatedAt *time.Time          `db:"created_at" json:"created_at,omitempty"`
+	Email     *string             `json:"email,omitempty"`
+	Fullname  *string             `json:"fullname,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+
+	// confirmed or waitlisted
+	Status   *string `json:"status,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// SReplyCount defines model for SReplyCount.
+type SReplyCount struct {
+	ReplyCount *int `db:"reply_count" json:"reply_count,omitempty"`
+}
+
+// SReportMail defines model for SReportMail.
+type SReportMail struct {
+	CreatedAt *time.Time `db:"created_at" json:"created_at,omitempty"`
+	Email     *string    `json:"email,omitempty"`
+
+	// Empty until the report is mailed
+	SentAt *time.Time `db:"sent_at" json:"sent_at,omitempty"`
+}
+
+// SRevision defines model for SRevision.
+type SRevision struct {
+	// create, update, delete, restore, image or revert
+	Action      *string             `json:"action,omitempty"`
+	Actor       *string             `json:"actor,omitempty"`
+	BroadcastId *openapi_types.UUID `json:"broadcast_id,omitempty"`
+	Changes     *[]SRevisionChange  `json:"changes,omitempty"`
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+}
+
+// SRevisionChange defines model for SRevisionChange.
+type SRevisionChange struct {
+	Field *string `json:"field,omitempty"`
+
+	// New value, omitted for the stream key
+	New *interface{} `json:"new,omitempty"`
+
+	// Previous value, omitted for the stream key
+	Old *interface{} `json:"old,omitempty"`
+}
+
+// SSearch defines model for SSearch.
+type SSearch struct {
+	Broadcasts *[]SSearchBroadcast `json:"broadcasts,omitempty"`
+	Messages   *[]SSearchMessage   `json:"messages,omitempty"`
+}
+
+// SSearchBroadcast defines model for SSearchBroadcast.
+type SSearchBroadcast struct {
+	// HTML-escaped fragment with matches wrapped in <b>, safe to render as HTML
+	Headline  *string             `json:"headline,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Life      *string             `json:"life,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+	Owner     *string             `json:"owner,omitempty"`
+	Rank      *float32            `json:"rank,omitempty"`
+	StartTime *time.Time          `db:"start_time" json:"start_time,omitempty"`
+}
+
+// SSearchMessage defines model for SSearchMessage.
+type SSearchMessage struct {
+	Channel  *string `json:"channel,omitempty"`
+	Fullname *string `json:"fullname,omitempty"`
+
+	// HTML-escaped fragment with matches wrapped in <b>, safe to render as HTML
+	Headline *string             `json:"headline,omitempty"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+	Rank     *float32            `json:"rank,omitempty"`
+	Time     *time.Time          `json:"time,omitempty"`
+	Username *string             `json:"username,omitempty"`
+}
+
+// SSeries defines model for SSeries.
+type SSeries struct {
+	SeriesId *openapi_types.UUID `db:"series_id" json:"series_id,omitempty"`
+}
+
 // SStartTime defines model for SStartTime.
 type SStartTime struct {
 	StartTime *time.Time `db:"start_time" json:"start_time,omitempty"`
 }
 
+// SStreamKey defines model for SStreamKey.
+type SStreamKey struct {
+	// Key of the personal stream, returned to its owner only via /stream/{username}/stream_key
+	StreamKey *string `db:"streamkey" json:"stream_key,omitempty"`
+}
+
 // SStreamUrl defines model for SStreamUrl.
 type SStreamUrl struct {
 	StreamUrl *string `json:"stream_url,omitempty"`
 }
 
+// STag defines model for STag.
+type STag struct {
+	// Name of the category
+	Category *string             `json:"category,omitempty"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+
+	// Lowercase letters, digits and dashes
+	Name *string `json:"name,omitempty"`
+}
+
+// STags defines model for STags.
+type STags struct {
+	// Names of tags from the vocabulary
+	Tags *[]string `json:"tags,omitempty"`
+}
+
 // SToken defines model for SToken.
 type SToken struct {
 	Exp   *time.Time `json:"exp,omitempty"`
@@ -110,107 +476,829 @@ type SUsername struct {
 	Username *string `json:"username,omitempty"`
 }
 
+// SVisibility defines model for SVisibility.
+type SVisibility struct {
+	// public, internal or invite-only. Internal broadcasts are visible to signed in users, invite-only to the owner, admins and users on the allowlist. Public when empty
+	Visibility *string `json:"visibility,omitempty"`
+}
+
+// SWebhook defines model for SWebhook.
+type SWebhook struct {
+	Active    *bool      `json:"active,omitempty"`
+	CreatedAt *time.Time `db:"created_at" json:"created_at,omitempty"`
+	CreatedBy *string    `db:"created_by" json:"created_by,omitempty"`
+	Url       *string    `json:"url,omitempty"`
+}
+
+// SWebhookAttempt defines model for SWebhookAttempt.
+type SWebhookAttempt struct {
+	DurationMs *int    `json:"duration_ms,omitempty"`
+	Error      *string `json:"error,omitempty"`
+
+	// Empty when no response was received
+	StatusCode *int       `json:"status_code,omitempty"`
+	Time       *time.Time `json:"time,omitempty"`
+}
+
+// SWebhookDelivery defines model for SWebhookDelivery.
+type SWebhookDelivery struct {
+	Attempts    *int       `json:"attempts,omitempty"`
+	CreatedAt   *time.Time `db:"created_at" json:"created_at,omitempty"`
+	DeliveredAt *time.Time `db:"delivered_at" json:"delivered_at,omitempty"`
+	Event       *string    `json:"event,omitempty"`
+
+	// Same for deliveries of one event to several webhooks
+	EventId       *openapi_types.UUID `db:"event_id" json:"event_id,omitempty"`
+	Id            *openapi_types.UUID `json:"id,omitempty"`
+	NextAttemptAt *time.Time          `db:"next_attempt_at" json:"next_attempt_at,omitempty"`
+
+	// pending, delivered or failed
+	Status *string `json:"status,omitempty"`
+}
+
+// SWebhookEvents defines model for SWebhookEvents.
+type SWebhookEvents struct {
+	Events *[]string `json:"events,omitempty"`
+}
+
+// SWebhookHistory defines model for SWebhookHistory.
+type SWebhookHistory struct {
+	History *[]SWebhookAttempt `json:"history,omitempty"`
+}
+
+// SWebhookInput defines model for SWebhookInput.
+type SWebhookInput struct {
+	// true when empty
+	Active *bool `json:"active,omitempty"`
+
+	// broadcast.created, broadcast.changed, broadcast.started, broadcast.ended or registration.created, all events when empty
+	Events *[]string `json:"events,omitempty"`
+
+	// http or https url receiving POST requests
+	Url *string `json:"url,omitempty"`
+}
+
+// SWebhookSecret defines model for SWebhookSecret.
+type SWebhookSecret struct {
+	// Key of the X-Webhook-Signature header, sha256= followed by the hex HMAC-SHA256 of X-Webhook-Timestamp, a dot and the body
+	Secret *string `json:"secret,omitempty"`
+}
+
 // SZoom defines model for SZoom.
 type SZoom struct {
 	RecordingCount *int64  `db:"recording_count" json:"recording_count,omitempty"`
 	Topic          *string `json:"topic,omitempty"`
 }
 
+// PActor defines model for PActor.
+type PActor = string
+
+// PAfter defines model for PAfter.
+type PAfter = string
+
+// PBefore defines model for PBefore.
+type PBefore = string
+
+// PBucket defines model for PBucket.
+type PBucket = string
+
+// PCursor defines model for PCursor.
+type PCursor = string
+
+// PForce defines model for PForce.
+type PForce = bool
+
+// PFrom defines model for PFrom.
+type PFrom = time.Time
+
+// PIfMatch defines model for PIfMatch.
+type PIfMatch = string
+
+// PLife defines model for PLife.
+type PLife = string
+
+// PLimit defines model for PLimit.
+type PLimit = int
+
+// POrder defines model for POrder.
+type POrder = string
+
+// POwner defines model for POwner.
+type POwner = string
+
+// PQuestionState defines model for PQuestionState.
+type PQuestionState = string
+
+// PReader defines model for PReader.
+type PReader = string
+
+// PReportFormat defines model for PReportFormat.
+type PReportFormat = string
+
+// PScope defines model for PScope.
+type PScope = string
+
+// PSort defines model for PSort.
+type PSort = string
+
+// PTags defines model for PTags.
+type PTags = string
+
+// PTo defines model for PTo.
+type PTo = time.Time
+
+// PViewer defines model for PViewer.
+type PViewer = string
+
+// RIngestAllow defines model for RIngestAllow.
+type RIngestAllow = SIngestResult
+
+// RIngestReject defines model for RIngestReject.
+type RIngestReject = SIngestResult
+
+// RIngestCallback defines model for RIngestCallback.
+type RIngestCallback = SIngestCallback
+
 // CheckAdminJSONBody defines parameters for CheckAdmin.
 type CheckAdminJSONBody = SUsername
 
+// GetBroadcastsParams defines parameters for GetBroadcasts.
+type GetBroadcastsParams struct {
+	// Filter by owner
+	Owner *POwner `form:"owner,omitempty" json:"owner,omitempty"`
+
+	// Broadcasts starting at or after this time
+	From *PFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// Broadcasts starting before this time
+	To *PTo `form:"to,omitempty" json:"to,omitempty"`
+
+	// Comma separated lifecycle states (created, live, past)
+	Life *PLife `form:"life,omitempty" json:"life,omitempty"`
+
+	// Sort field (start_time, name)
+	Sort *PSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Sort order (asc, desc)
+	Order *POrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor of the next page from the Link header or next_cursor, only valid with the sort and order it was issued for
+	Cursor *PCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Page size
+	Limit *PLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// User requesting broadcasts, stream keys are returned to owners and admins only
+	Username *PViewer `form:"username,omitempty" json:"username,omitempty"`
+
+	// Comma separated tags, broadcasts must have all of them
+	Tags *PTags `form:"tags,omitempty" json:"tags,omitempty"`
+}
+
 // PostBroadcastsJSONBody defines parameters for PostBroadcasts.
 type PostBroadcastsJSONBody struct {
+	// Seats available to participants, unlimited when empty
+	Capacity    *int       `json:"capacity,omitempty"`
 	Description *string    `json:"description,omitempty"`
+	EndTime     *time.Time `db:"end_time" json:"end_time,omitempty"`
 	Name        *string    `json:"name,omitempty"`
 	Owner       *string    `json:"owner,omitempty"`
-	StartTime   *time.Time `db:"start_time" json:"start_time,omitempty"`
-	StreamKey   *string    `json:"stream_key,omitempty"`
+
+	// iCalendar RRULE, e.g. FREQ=WEEKLY;BYDAY=MO;COUNT=10
+	Rrule     *string    `json:"rrule,omitempty"`
+	StartTime *time.Time `db:"start_time" json:"start_time,omitempty"`
+
+	// Generated by the server, rotated via /broadcasts/{id}/stream_key
+	StreamKey *string `json:"stream_key,omitempty"`
+
+	// Names of tags from the vocabulary
+	Tags *[]string `json:"tags,omitempty"`
+
+	// public, internal or invite-only. Internal broadcasts are visible to signed in users, invite-only to the owner, admins and users on the allowlist. Public when empty
+	Visibility *string `json:"visibility,omitempty"`
+}
+
+// PostBroadcastsParams defines parameters for PostBroadcasts.
+type PostBroadcastsParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+
+	// Schedule over overlapping broadcasts, available to admins
+	Force *PForce `form:"force,omitempty" json:"force,omitempty"`
 }
 
 // PutBroadcastJSONBody defines parameters for PutBroadcast.
 type PutBroadcastJSONBody struct {
 	Description *string             `json:"description,omitempty"`
+	EndTime     *time.Time          `db:"end_time" json:"end_time,omitempty"`
 	Id          *openapi_types.UUID `json:"id,omitempty"`
 	Name        *string             `json:"name,omitempty"`
 	Owner       *string             `json:"owner,omitempty"`
 	StartTime   *time.Time          `db:"start_time" json:"start_time,omitempty"`
-	StreamKey   *string             `json:"stream_key,omitempty"`
+
+	// Generated by the server, rotated via /broadcasts/{id}/stream_key
+	StreamKey *string `json:"stream_key,omitempty"`
+
+	// Names of tags from the vocabulary
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// PutBroadcastParams defines parameters for PutBroadcast.
+type PutBroadcastParams struct {
+	// Occurrences of a series to change (this, following, all)
+	Scope *PScope `form:"scope,omitempty" json:"scope,omitempty"`
+
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+
+	// Schedule over overlapping broadcasts, available to admins
+	Force *PForce `form:"force,omitempty" json:"force,omitempty"`
+
+	// ETag of the version being changed, stale writes are rejected with 412
+	IfMatch *PIfMatch `json:"If-Match,omitempty"`
 }
 
 // PostUserGetBroadcastArchJSONBody defines parameters for PostUserGetBroadcastArch.
 type PostUserGetBroadcastArchJSONBody = SUsername
 
-// PostMsgByChannelJSONBody defines parameters for PostMsgByChannel.
-type PostMsgByChannelJSONBody struct {
-	Avatar     *string    `json:"avatar,omitempty"`
-	Fullname   *string    `json:"fullname,omitempty"`
-	IsAnon     *bool      `db:"is_anon" json:"is_anon,omitempty"`
-	IsQuestion *bool      `db:"is_question" json:"is_question,omitempty"`
-	Reactions  *string    `json:"reactions,omitempty"`
-	Text       *string    `json:"text,omitempty"`
-	Time       *time.Time `json:"time,omitempty"`
-	Username   *string    `json:"username,omitempty"`
+// PostUserGetBroadcastArchParams defines parameters for PostUserGetBroadcastArch.
+type PostUserGetBroadcastArchParams struct {
+	// Filter by owner
+	Owner *POwner `form:"owner,omitempty" json:"owner,omitempty"`
+
+	// Broadcasts starting at or after this time
+	From *PFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// Broadcasts starting before this time
+	To *PTo `form:"to,omitempty" json:"to,omitempty"`
+
+	// Sort field (start_time, name)
+	Sort *PSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Sort order (asc, desc)
+	Order *POrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor of the next page from the Link header or next_cursor, only valid with the sort and order it was issued for
+	Cursor *PCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Page size
+	Limit *PLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Comma separated tags, broadcasts must have all of them
+	Tags *PTags `form:"tags,omitempty" json:"tags,omitempty"`
 }
 
-// PatchReactionMsgJSONBody defines parameters for PatchReactionMsg.
-type PatchReactionMsgJSONBody struct {
-	Id       *openapi_types.UUID `json:"id,omitempty"`
-	Username *string             `json:"username,omitempty"`
+// GetSeriesByIdParams defines parameters for GetSeriesById.
+type GetSeriesByIdParams struct {
+	// User requesting broadcasts, stream keys are returned to owners and admins only
+	Username *PViewer `form:"username,omitempty" json:"username,omitempty"`
 }
 
-// PostReactionMsgJSONBody defines parameters for PostReactionMsg.
-type PostReactionMsgJSONBody struct {
-	Id       *openapi_types.UUID `json:"id,omitempty"`
-	Type     *string             `json:"type,omitempty"`
-	Username *string             `json:"username,omitempty"`
+// PostUserGetBroadcastTrashJSONBody defines parameters for PostUserGetBroadcastTrash.
+type PostUserGetBroadcastTrashJSONBody = SUsername
+
+// DeleteBroadcastParams defines parameters for DeleteBroadcast.
+type DeleteBroadcastParams struct {
+	// Occurrences of a series to change (this, following, all)
+	Scope *PScope `form:"scope,omitempty" json:"scope,omitempty"`
+
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
 }
 
-// PostParticipantsByChannelJSONBody defines parameters for PostParticipantsByChannel.
-type PostParticipantsByChannelJSONBody struct {
-	Email    *string `json:"email,omitempty"`
-	Fullname *string `json:"fullname,omitempty"`
-	Username *string `json:"username,omitempty"`
+// GetBroadcastByIdParams defines parameters for GetBroadcastById.
+type GetBroadcastByIdParams struct {
+	// User requesting broadcasts, stream keys are returned to owners and admins only
+	Username *PViewer `form:"username,omitempty" json:"username,omitempty"`
 }
 
-// PostStreamJSONBody defines parameters for PostStream.
-type PostStreamJSONBody = SUsername
+// PatchBroadcastParams defines parameters for PatchBroadcast.
+type PatchBroadcastParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
 
-// PutStreamJSONBody defines parameters for PutStream.
-type PutStreamJSONBody struct {
-	Description *string `json:"description,omitempty"`
-	Username    *string `json:"username,omitempty"`
+	// Schedule over overlapping broadcasts, available to admins
+	Force *PForce `form:"force,omitempty" json:"force,omitempty"`
+
+	// ETag of the version being changed, stale writes are rejected with 412
+	IfMatch *PIfMatch `json:"If-Match,omitempty"`
 }
 
-// PostUserGetTokenJSONBody defines parameters for PostUserGetToken.
-type PostUserGetTokenJSONBody = SUsername
+// GetBroadcastAccessParams defines parameters for GetBroadcastAccess.
+type GetBroadcastAccessParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
 
-// PostZoomJSONBody defines parameters for PostZoom.
-type PostZoomJSONBody = interface{}
+// PostBroadcastAccessJSONBody defines parameters for PostBroadcastAccess.
+type PostBroadcastAccessJSONBody = SUsername
 
-// CheckAdminJSONRequestBody defines body for CheckAdmin for application/json ContentType.
-type CheckAdminJSONRequestBody = CheckAdminJSONBody
+// PostBroadcastAccessParams defines parameters for PostBroadcastAccess.
+type PostBroadcastAccessParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
 
-// PostBroadcastsJSONRequestBody defines body for PostBroadcasts for application/json ContentType.
-type PostBroadcastsJSONRequestBody PostBroadcastsJSONBody
+// DeleteBroadcastAccessParams defines parameters for DeleteBroadcastAccess.
+type DeleteBroadcastAccessParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
 
-// PutBroadcastJSONRequestBody defines body for PutBroadcast for application/json ContentType.
-type PutBroadcastJSONRequestBody PutBroadcastJSONBody
+// GetBroadcastAnalyticsParams defines parameters for GetBroadcastAnalytics.
+type GetBroadcastAnalyticsParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
 
-// PostUserGetBroadcastArchJSONRequestBody defines body for PostUserGetBroadcastArch for application/json ContentType.
-type PostUserGetBroadcastArchJSONRequestBody = PostUserGetBroadcastArchJSONBody
+// GetBroadcastAnalyticsSeriesParams defines parameters for GetBroadcastAnalyticsSeries.
+type GetBroadcastAnalyticsSeriesParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
 
-// PostMsgByChannelJSONRequestBody defines body for PostMsgByChannel for application/json ContentType.
-type PostMsgByChannelJSONRequestBody PostMsgByChannelJSONBody
+	// Bucket size from 1m to 24h, e.g. 1m, 15m, 1h. 5m when empty
+	Bucket *PBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+}
 
-// PatchReactionMsgJSONRequestBody defines body for PatchReactionMsg for application/json ContentType.
-type PatchReactionMsgJSONRequestBody PatchReactionMsgJSONBody
+// GetBroadcastFilesParams defines parameters for GetBroadcastFiles.
+type GetBroadcastFilesParams struct {
+	// User reading the channel, required for internal and invite-only broadcasts
+	Username *PReader `form:"username,omitempty" json:"username,omitempty"`
+}
 
-// PostReactionMsgJSONRequestBody defines body for PostReactionMsg for application/json ContentType.
-type PostReactionMsgJSONRequestBody PostReactionMsgJSONBody
+// PostBroadcastFileParams defines parameters for PostBroadcastFile.
+type PostBroadcastFileParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
 
-// PostParticipantsByChannelJSONRequestBody defines body for PostParticipantsByChannel for application/json ContentType.
-type PostParticipantsByChannelJSONRequestBody PostParticipantsByChannelJSONBody
+// CreateBroadcastInviteParams defines parameters for CreateBroadcastInvite.
+type CreateBroadcastInviteParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// GetBroadcastRecordingsParams defines parameters for GetBroadcastRecordings.
+type GetBroadcastRecordingsParams struct {
+	// User reading the channel, required for internal and invite-only broadcasts
+	Username *PReader `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// PostBroadcastRecordingJSONBody defines parameters for PostBroadcastRecording.
+type PostBroadcastRecordingJSONBody struct {
+	BroadcastId *openapi_types.UUID `db:"broadcast_id" json:"broadcast_id,omitempty"`
+	CreatedAt   *time.Time          `db:"created_at" json:"created_at,omitempty"`
+	CreatedBy   *string             `db:"created_by" json:"created_by,omitempty"`
+
+	// Duration in seconds
+	Duration *int `db:"duration" json:"duration,omitempty"`
+
+	// Container format, e.g. mp4, flv
+	Format *string `db:"format" json:"format,omitempty"`
+
+	// Size in bytes
+	Size *int64 `db:"size" json:"size,omitempty"`
+
+	// Absolute http or https url of the recording
+	Url *string `json:"url,omitempty"`
+}
+
+// PostBroadcastRecordingParams defines parameters for PostBroadcastRecording.
+type PostBroadcastRecordingParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// UploadBroadcastRecordingParams defines parameters for UploadBroadcastRecording.
+type UploadBroadcastRecordingParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// GetBroadcastReportParams defines parameters for GetBroadcastReport.
+type GetBroadcastReportParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+
+	// csv or xlsx, csv when empty
+	Format *PReportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// DeleteBroadcastReportMailParams defines parameters for DeleteBroadcastReportMail.
+type DeleteBroadcastReportMailParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// PutBroadcastReportMailJSONBody defines parameters for PutBroadcastReportMail.
+type PutBroadcastReportMailJSONBody = SEMail
+
+// PutBroadcastReportMailParams defines parameters for PutBroadcastReportMail.
+type PutBroadcastReportMailParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// RestoreBroadcastJSONBody defines parameters for RestoreBroadcast.
+type RestoreBroadcastJSONBody = SUsername
+
+// GetBroadcastRevisionsParams defines parameters for GetBroadcastRevisions.
+type GetBroadcastRevisionsParams struct {
+	// User reading the channel, required for internal and invite-only broadcasts
+	Username *PReader `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// RevertBroadcastRevisionJSONBody defines parameters for RevertBroadcastRevision.
+type RevertBroadcastRevisionJSONBody = SUsername
+
+// RevertBroadcastRevisionParams defines parameters for RevertBroadcastRevision.
+type RevertBroadcastRevisionParams struct {
+	// Schedule over overlapping broadcasts, available to admins
+	Force *PForce `form:"force,omitempty" json:"force,omitempty"`
+
+	// ETag of the version being changed, stale writes are rejected with 412
+	IfMatch *PIfMatch `json:"If-Match,omitempty"`
+}
+
+// RotateStreamKeyJSONBody defines parameters for RotateStreamKey.
+type RotateStreamKeyJSONBody = SUsername
+
+// CreateCalendarFeedJSONBody defines parameters for CreateCalendarFeed.
+type CreateCalendarFeedJSONBody struct {
+	// user or all
+	Scope    *string `json:"scope,omitempty"`
+	Token    *string `json:"token,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// PostCategoryJSONBody defines parameters for PostCategory.
+type PostCategoryJSONBody = SCategory
+
+// PostCategoryParams defines parameters for PostCategory.
+type PostCategoryParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// DeleteCategoryParams defines parameters for DeleteCategory.
+type DeleteCategoryParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// GetArchiveAtomParams defines parameters for GetArchiveAtom.
+type GetArchiveAtomParams struct {
+	// Filter by owner
+	Owner *POwner `form:"owner,omitempty" json:"owner,omitempty"`
+
+	// Comma separated tags, broadcasts must have all of them
+	Tags *PTags `form:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// GetArchiveRssParams defines parameters for GetArchiveRss.
+type GetArchiveRssParams struct {
+	// Filter by owner
+	Owner *POwner `form:"owner,omitempty" json:"owner,omitempty"`
+
+	// Comma separated tags, broadcasts must have all of them
+	Tags *PTags `form:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// DeleteFileByIdParams defines parameters for DeleteFileById.
+type DeleteFileByIdParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// GetFileByIdParams defines parameters for GetFileById.
+type GetFileByIdParams struct {
+	// User reading the channel, required for internal and invite-only broadcasts
+	Username *PReader `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// PutImageByIdParams defines parameters for PutImageById.
+type PutImageByIdParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// AcceptInviteJSONBody defines parameters for AcceptInvite.
+type AcceptInviteJSONBody = SUsername
+
+// GetMsgByChannelParams defines parameters for GetMsgByChannel.
+type GetMsgByChannelParams struct {
+	// User reading the channel, required for internal and invite-only broadcasts
+	Username *PReader `form:"username,omitempty" json:"username,omitempty"`
+
+	// Cursor of older messages from the Link header, rel="prev"
+	Before *PBefore `form:"before,omitempty" json:"before,omitempty"`
+
+	// Cursor of newer messages from the Link header, rel="next"
+	After *PAfter `form:"after,omitempty" json:"after,omitempty"`
+
+	// Page size
+	Limit *PLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostMsgByChannelJSONBody defines parameters for PostMsgByChannel.
+type PostMsgByChannelJSONBody struct {
+	Avatar     *string             `json:"avatar,omitempty"`
+	Fullname   *string             `json:"fullname,omitempty"`
+	IsAnon     *bool               `db:"is_anon" json:"is_anon,omitempty"`
+	IsQuestion *bool               `db:"is_question" json:"is_question,omitempty"`
+	ParentId   *openapi_types.UUID `db:"parent_id" json:"parent_id,omitempty"`
+	Reactions  *string             `json:"reactions,omitempty"`
+	Text       *string             `json:"text,omitempty"`
+	Time       *time.Time          `json:"time,omitempty"`
+	Username   *string             `json:"username,omitempty"`
+}
+
+// PatchReactionMsgJSONBody defines parameters for PatchReactionMsg.
+type PatchReactionMsgJSONBody struct {
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+	Username *string             `json:"username,omitempty"`
+}
+
+// PostReactionMsgJSONBody defines parameters for PostReactionMsg.
+type PostReactionMsgJSONBody struct {
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+	Type     *string             `json:"type,omitempty"`
+	Username *string             `json:"username,omitempty"`
+}
+
+// DeleteMsgParams defines parameters for DeleteMsg.
+type DeleteMsgParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// EditMsgJSONBody defines parameters for EditMsg.
+type EditMsgJSONBody = SMessageText
+
+// EditMsgParams defines parameters for EditMsg.
+type EditMsgParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// GetMsgEditsParams defines parameters for GetMsgEdits.
+type GetMsgEditsParams struct {
+	// User reading the channel, required for internal and invite-only broadcasts
+	Username *PReader `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// GetMsgRepliesParams defines parameters for GetMsgReplies.
+type GetMsgRepliesParams struct {
+	// User reading the channel, required for internal and invite-only broadcasts
+	Username *PReader `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// GetParticipantsByChannelParams defines parameters for GetParticipantsByChannel.
+type GetParticipantsByChannelParams struct {
+	// User reading the channel, required for internal and invite-only broadcasts
+	Username *PReader `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// PostParticipantsByChannelJSONBody defines parameters for PostParticipantsByChannel.
+type PostParticipantsByChannelJSONBody struct {
+	Email    *string `json:"email,omitempty"`
+	Fullname *string `json:"fullname,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// GetQuestionsParams defines parameters for GetQuestions.
+type GetQuestionsParams struct {
+	// User reading the channel, required for internal and invite-only broadcasts
+	Username *PReader `form:"username,omitempty" json:"username,omitempty"`
+
+	// Comma separated states of questions (pending, approved, answered, dismissed), all by default
+	State *PQuestionState `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// PatchQuestionJSONBody defines parameters for PatchQuestion.
+type PatchQuestionJSONBody = SQuestionStateInput
+
+// PatchQuestionParams defines parameters for PatchQuestion.
+type PatchQuestionParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// UnpinQuestionParams defines parameters for UnpinQuestion.
+type UnpinQuestionParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// PinQuestionParams defines parameters for PinQuestion.
+type PinQuestionParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// UnvoteQuestionParams defines parameters for UnvoteQuestion.
+type UnvoteQuestionParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// VoteQuestionParams defines parameters for VoteQuestion.
+type VoteQuestionParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// DeleteRecordingParams defines parameters for DeleteRecording.
+type DeleteRecordingParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// PlayRecordingParams defines parameters for PlayRecording.
+type PlayRecordingParams struct {
+	// Unix time the url expires at
+	Expires int64 `form:"expires" json:"expires"`
+
+	// Signature of the url
+	Signature string `form:"signature" json:"signature"`
+}
+
+// WatchRecordingParams defines parameters for WatchRecording.
+type WatchRecordingParams struct {
+	// User reading the channel, required for internal and invite-only broadcasts
+	Username *PReader `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// SearchParams defines parameters for Search.
+type SearchParams struct {
+	// Search query
+	Q string `form:"q" json:"q"`
+
+	// User performing the search
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
+
+	// Page size
+	Limit *PLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostStreamJSONBody defines parameters for PostStream.
+type PostStreamJSONBody = SUsername
+
+// PutStreamJSONBody defines parameters for PutStream.
+type PutStreamJSONBody struct {
+	Description *string `json:"description,omitempty"`
+	Username    *string `json:"username,omitempty"`
+}
+
+// PutStreamParams defines parameters for PutStream.
+type PutStreamParams struct {
+	// ETag of the version being changed, stale writes are rejected with 412
+	IfMatch *PIfMatch `json:"If-Match,omitempty"`
+}
+
+// RotateUserStreamKeyJSONBody defines parameters for RotateUserStreamKey.
+type RotateUserStreamKeyJSONBody = SUsername
+
+// PostTagJSONBody defines parameters for PostTag.
+type PostTagJSONBody = STag
+
+// PostTagParams defines parameters for PostTag.
+type PostTagParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// DeleteTagParams defines parameters for DeleteTag.
+type DeleteTagParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// PutTagJSONBody defines parameters for PutTag.
+type PutTagJSONBody = STag
+
+// PutTagParams defines parameters for PutTag.
+type PutTagParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// PostUserGetTokenJSONBody defines parameters for PostUserGetToken.
+type PostUserGetTokenJSONBody = SUsername
+
+// PostUserGetChannelTokenJSONBody defines parameters for PostUserGetChannelToken.
+type PostUserGetChannelTokenJSONBody = SUsername
+
+// GetWebhooksParams defines parameters for GetWebhooks.
+type GetWebhooksParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// PostWebhookJSONBody defines parameters for PostWebhook.
+type PostWebhookJSONBody = SWebhookInput
+
+// PostWebhookParams defines parameters for PostWebhook.
+type PostWebhookParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// DeleteWebhookParams defines parameters for DeleteWebhook.
+type DeleteWebhookParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// PutWebhookJSONBody defines parameters for PutWebhook.
+type PutWebhookJSONBody = SWebhookInput
+
+// PutWebhookParams defines parameters for PutWebhook.
+type PutWebhookParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// GetWebhookDeliveriesParams defines parameters for GetWebhookDeliveries.
+type GetWebhookDeliveriesParams struct {
+	// User performing the change, changes are allowed to the owner of the broadcast and admins
+	Username *PActor `form:"username,omitempty" json:"username,omitempty"`
+
+	// Page size
+	Limit *PLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostZoomJSONBody defines parameters for PostZoom.
+type PostZoomJSONBody = interface{}
+
+// CheckAdminJSONRequestBody defines body for CheckAdmin for application/json ContentType.
+type CheckAdminJSONRequestBody = CheckAdminJSONBody
+
+// PostBroadcastsJSONRequestBody defines body for PostBroadcasts for application/json ContentType.
+type PostBroadcastsJSONRequestBody PostBroadcastsJSONBody
+
+// PutBroadcastJSONRequestBody defines body for PutBroadcast for application/json ContentType.
+type PutBroadcastJSONRequestBody PutBroadcastJSONBody
+
+// PostUserGetBroadcastArchJSONRequestBody defines body for PostUserGetBroadcastArch for application/json ContentType.
+type PostUserGetBroadcastArchJSONRequestBody = PostUserGetBroadcastArchJSONBody
+
+// PostUserGetBroadcastTrashJSONRequestBody defines body for PostUserGetBroadcastTrash for application/json ContentType.
+type PostUserGetBroadcastTrashJSONRequestBody = PostUserGetBroadcastTrashJSONBody
+
+// PostBroadcastAccessJSONRequestBody defines body for PostBroadcastAccess for application/json ContentType.
+type PostBroadcastAccessJSONRequestBody = PostBroadcastAccessJSONBody
+
+// PostBroadcastRecordingJSONRequestBody defines body for PostBroadcastRecording for application/json ContentType.
+type PostBroadcastRecordingJSONRequestBody PostBroadcastRecordingJSONBody
+
+// PutBroadcastReportMailJSONRequestBody defines body for PutBroadcastReportMail for application/json ContentType.
+type PutBroadcastReportMailJSONRequestBody = PutBroadcastReportMailJSONBody
+
+// RestoreBroadcastJSONRequestBody defines body for RestoreBroadcast for application/json ContentType.
+type RestoreBroadcastJSONRequestBody = RestoreBroadcastJSONBody
+
+// RevertBroadcastRevisionJSONRequestBody defines body for RevertBroadcastRevision for application/json ContentType.
+type RevertBroadcastRevisionJSONRequestBody = RevertBroadcastRevisionJSONBody
+
+// RotateStreamKeyJSONRequestBody defines body for RotateStreamKey for application/json ContentType.
+type RotateStreamKeyJSONRequestBody = RotateStreamKeyJSONBody
+
+// CreateCalendarFeedJSONRequestBody defines body for CreateCalendarFeed for application/json ContentType.
+type CreateCalendarFeedJSONRequestBody CreateCalendarFeedJSONBody
+
+// PostCategoryJSONRequestBody defines body for PostCategory for application/json ContentType.
+type PostCategoryJSONRequestBody = PostCategoryJSONBody
+
+// IngestOnPublishJSONRequestBody defines body for IngestOnPublish for application/json ContentType.
+type IngestOnPublishJSONRequestBody = RIngestCallback
+
+// IngestOnPublishDoneJSONRequestBody defines body for IngestOnPublishDone for application/json ContentType.
+type IngestOnPublishDoneJSONRequestBody = RIngestCallback
+
+// IngestOnRecordDoneJSONRequestBody defines body for IngestOnRecordDone for application/json ContentType.
+type IngestOnRecordDoneJSONRequestBody = RIngestCallback
+
+// AcceptInviteJSONRequestBody defines body for AcceptInvite for application/json ContentType.
+type AcceptInviteJSONRequestBody = AcceptInviteJSONBody
+
+// PostMsgByChannelJSONRequestBody defines body for PostMsgByChannel for application/json ContentType.
+type PostMsgByChannelJSONRequestBody PostMsgByChannelJSONBody
+
+// PatchReactionMsgJSONRequestBody defines body for PatchReactionMsg for application/json ContentType.
+type PatchReactionMsgJSONRequestBody PatchReactionMsgJSONBody
+
+// PostReactionMsgJSONRequestBody defines body for PostReactionMsg for application/json ContentType.
+type PostReactionMsgJSONRequestBody PostReactionMsgJSONBody
+
+// EditMsgJSONRequestBody defines body for EditMsg for application/json ContentType.
+type EditMsgJSONRequestBody = EditMsgJSONBody
+
+// PostParticipantsByChannelJSONRequestBody defines body for PostParticipantsByChannel for application/json ContentType.
+type PostParticipantsByChannelJSONRequestBody PostParticipantsByChannelJSONBody
+
+// PatchQuestionJSONRequestBody defines body for PatchQuestion for application/json ContentType.
+type PatchQuestionJSONRequestBody = PatchQuestionJSONBody
 
 // PostStreamJSONRequestBody defines body for PostStream for application/json ContentType.
 type PostStreamJSONRequestBody = PostStreamJSONBody
@@ -218,9 +1306,27 @@ type PostStreamJSONRequestBody = PostStreamJSONBody
 // PutStreamJSONRequestBody defines body for PutStream for application/json ContentType.
 type PutStreamJSONRequestBody PutStreamJSONBody
 
+// RotateUserStreamKeyJSONRequestBody defines body for RotateUserStreamKey for application/json ContentType.
+type RotateUserStreamKeyJSONRequestBody = RotateUserStreamKeyJSONBody
+
+// PostTagJSONRequestBody defines body for PostTag for application/json ContentType.
+type PostTagJSONRequestBody = PostTagJSONBody
+
+// PutTagJSONRequestBody defines body for PutTag for application/json ContentType.
+type PutTagJSONRequestBody = PutTagJSONBody
+
 // PostUserGetTokenJSONRequestBody defines body for PostUserGetToken for application/json ContentType.
 type PostUserGetTokenJSONRequestBody = PostUserGetTokenJSONBody
 
+// PostUserGetChannelTokenJSONRequestBody defines body for PostUserGetChannelToken for application/json ContentType.
+type PostUserGetChannelTokenJSONRequestBody = PostUserGetChannelTokenJSONBody
+
+// PostWebhookJSONRequestBody defines body for PostWebhook for application/json ContentType.
+type PostWebhookJSONRequestBody = PostWebhookJSONBody
+
+// PutWebhookJSONRequestBody defines body for PutWebhook for application/json ContentType.
+type PutWebhookJSONRequestBody = PutWebhookJSONBody
+
 // PostZoomJSONRequestBody defines body for PostZoom for application/json ContentType.
 type PostZoomJSONRequestBody = PostZoomJSONBody
 
@@ -231,22 +1337,112 @@ type ServerInterface interface {
 	CheckAdmin(w http.ResponseWriter, r *http.Request)
 	// List of upcoming or current broadcasts
 	// (GET /broadcasts)
-	GetBroadcasts(w http.ResponseWriter, r *http.Request)
+	GetBroadcasts(w http.ResponseWriter, r *http.Request, params GetBroadcastsParams)
 	// Adds a new broadcast
 	// (POST /broadcasts)
-	PostBroadcasts(w http.ResponseWriter, r *http.Request)
+	PostBroadcasts(w http.ResponseWriter, r *http.Request, params PostBroadcastsParams)
 	// Updates the broadcast
 	// (PUT /broadcasts)
-	PutBroadcast(w http.ResponseWriter, r *http.Request)
+	PutBroadcast(w http.ResponseWriter, r *http.Request, params PutBroadcastParams)
 	// Returns a list of archived broadcasts
 	// (POST /broadcasts/arch)
-	PostUserGetBroadcastArch(w http.ResponseWriter, r *http.Request)
+	PostUserGetBroadcastArch(w http.ResponseWriter, r *http.Request, params PostUserGetBroadcastArchParams)
+	// Get occurrences of a recurring series
+	// (GET /broadcasts/series/{id})
+	GetSeriesById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetSeriesByIdParams)
+	// List trashed broadcasts
+	// (POST /broadcasts/trash)
+	PostUserGetBroadcastTrash(w http.ResponseWriter, r *http.Request)
 	// Delete broadcast by id
 	// (DELETE /broadcasts/{id})
-	DeleteBroadcast(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	DeleteBroadcast(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteBroadcastParams)
 	// Get broadcast by id
 	// (GET /broadcasts/{id})
-	GetBroadcastById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	GetBroadcastById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetBroadcastByIdParams)
+	// Partially updates the broadcast
+	// (PATCH /broadcasts/{id})
+	PatchBroadcast(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PatchBroadcastParams)
+	// Allowlist of the broadcast
+	// (GET /broadcasts/{id}/access)
+	GetBroadcastAccess(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetBroadcastAccessParams)
+	// Allow the user
+	// (POST /broadcasts/{id}/access)
+	PostBroadcastAccess(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostBroadcastAccessParams)
+	// Revoke access of the user
+	// (DELETE /broadcasts/{id}/access/{user})
+	DeleteBroadcastAccess(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, user string, params DeleteBroadcastAccessParams)
+	// Analytics of the broadcast
+	// (GET /broadcasts/{id}/analytics)
+	GetBroadcastAnalytics(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetBroadcastAnalyticsParams)
+	// Analytics of the broadcast over time
+	// (GET /broadcasts/{id}/analytics/series)
+	GetBroadcastAnalyticsSeries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetBroadcastAnalyticsSeriesParams)
+	// List files of the broadcast
+	// (GET /broadcasts/{id}/files)
+	GetBroadcastFiles(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetBroadcastFilesParams)
+	// Attach file to the broadcast
+	// (POST /broadcasts/{id}/files)
+	PostBroadcastFile(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostBroadcastFileParams)
+	// Create invite link
+	// (POST /broadcasts/{id}/invite)
+	CreateBroadcastInvite(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params CreateBroadcastInviteParams)
+	// List recordings of the broadcast
+	// (GET /broadcasts/{id}/recordings)
+	GetBroadcastRecordings(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetBroadcastRecordingsParams)
+	// Link recording by url
+	// (POST /broadcasts/{id}/recordings)
+	PostBroadcastRecording(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostBroadcastRecordingParams)
+	// Upload recording
+	// (POST /broadcasts/{id}/recordings/upload)
+	UploadBroadcastRecording(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params UploadBroadcastRecordingParams)
+	// Report of the broadcast
+	// (GET /broadcasts/{id}/report)
+	GetBroadcastReport(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetBroadcastReportParams)
+	// Cancel the report mail
+	// (DELETE /broadcasts/{id}/report/mail)
+	DeleteBroadcastReportMail(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteBroadcastReportMailParams)
+	// Mail the report
+	// (PUT /broadcasts/{id}/report/mail)
+	PutBroadcastReportMail(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutBroadcastReportMailParams)
+	// Restore broadcast from the trash
+	// (POST /broadcasts/{id}/restore)
+	RestoreBroadcast(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get revision history of the broadcast
+	// (GET /broadcasts/{id}/revisions)
+	GetBroadcastRevisions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetBroadcastRevisionsParams)
+	// Revert the broadcast to a revision
+	// (POST /broadcasts/{id}/revisions/{revision}/revert)
+	RevertBroadcastRevision(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, revision openapi_types.UUID, params RevertBroadcastRevisionParams)
+	// Rotate stream key
+	// (POST /broadcasts/{id}/stream_key)
+	RotateStreamKey(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Issue calendar feed token
+	// (POST /calendar/feeds)
+	CreateCalendarFeed(w http.ResponseWriter, r *http.Request)
+	// Get calendar feed
+	// (GET /calendar/{token}.ics)
+	GetCalendarFeed(w http.ResponseWriter, r *http.Request, token string)
+	// Tag categories
+	// (GET /categories)
+	GetCategories(w http.ResponseWriter, r *http.Request)
+	// Adds a category
+	// (POST /categories)
+	PostCategory(w http.ResponseWriter, r *http.Request, params PostCategoryParams)
+	// Deletes the category
+	// (DELETE /categories/{id})
+	DeleteCategory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteCategoryParams)
+	// Get Atom feed of the archive
+	// (GET /feeds/archive.atom)
+	GetArchiveAtom(w http.ResponseWriter, r *http.Request, params GetArchiveAtomParams)
+	// Get RSS feed of the archive
+	// (GET /feeds/archive.rss)
+	GetArchiveRss(w http.ResponseWriter, r *http.Request, params GetArchiveRssParams)
+	// Delete file
+	// (DELETE /files/{id})
+	DeleteFileById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteFileByIdParams)
+	// Download file
+	// (GET /files/{id})
+	GetFileById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetFileByIdParams)
 	// Post image
 	// (POST /images)
 	PostImage(w http.ResponseWriter, r *http.Request)
@@ -255,7 +1451,19 @@ type ServerInterface interface {
 	GetImageById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Set null by id
 	// (PUT /images/{id})
-	PutImageById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	PutImageById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutImageByIdParams)
+	// Authorize publishing
+	// (POST /ingest/on_publish)
+	IngestOnPublish(w http.ResponseWriter, r *http.Request)
+	// Publishing finished
+	// (POST /ingest/on_publish_done)
+	IngestOnPublishDone(w http.ResponseWriter, r *http.Request)
+	// Recording finished
+	// (POST /ingest/on_record_done)
+	IngestOnRecordDone(w http.ResponseWriter, r *http.Request)
+	// Accept invite
+	// (POST /invites/{token})
+	AcceptInvite(w http.ResponseWriter, r *http.Request, token string)
 	// Get all live
 	// (GET /live)
 	GetLive(w http.ResponseWriter, r *http.Request)
@@ -264,7 +1472,7 @@ type ServerInterface interface {
 	GetLiveById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Get messages
 	// (GET /messages/{channel})
-	GetMsgByChannel(w http.ResponseWriter, r *http.Request, channel string)
+	GetMsgByChannel(w http.ResponseWriter, r *http.Request, channel string, params GetMsgByChannelParams)
 	// Send message
 	// (POST /messages/{channel})
 	PostMsgByChannel(w http.ResponseWriter, r *http.Request, channel string)
@@ -274,27 +1482,105 @@ type ServerInterface interface {
 	// Send reaction for message
 	// (POST /messages/{channel}/reaction)
 	PostReactionMsg(w http.ResponseWriter, r *http.Request, channel string)
+	// Delete message
+	// (DELETE /messages/{channel}/{id})
+	DeleteMsg(w http.ResponseWriter, r *http.Request, channel string, id openapi_types.UUID, params DeleteMsgParams)
+	// Edit message
+	// (PATCH /messages/{channel}/{id})
+	EditMsg(w http.ResponseWriter, r *http.Request, channel string, id openapi_types.UUID, params EditMsgParams)
+	// Edit history of message
+	// (GET /messages/{channel}/{id}/edits)
+	GetMsgEdits(w http.ResponseWriter, r *http.Request, channel string, id openapi_types.UUID, params GetMsgEditsParams)
+	// Replies to message
+	// (GET /messages/{channel}/{id}/replies)
+	GetMsgReplies(w http.ResponseWriter, r *http.Request, channel string, id openapi_types.UUID, params GetMsgRepliesParams)
 	// Stream members
 	// (GET /participants/{channel})
-	GetParticipantsByChannel(w http.ResponseWriter, r *http.Request, channel string)
+	GetParticipantsByChannel(w http.ResponseWriter, r *http.Request, channel string, params GetParticipantsByChannelParams)
 	// Send information about the user
 	// (POST /participants/{channel})
 	PostParticipantsByChannel(w http.ResponseWriter, r *http.Request, channel string)
+	// Unregister the user
+	// (DELETE /participants/{channel}/{username})
+	DeleteParticipant(w http.ResponseWriter, r *http.Request, channel string, username string)
+	// Questions of the channel
+	// (GET /questions/{channel})
+	GetQuestions(w http.ResponseWriter, r *http.Request, channel string, params GetQuestionsParams)
+	// Moderates question
+	// (PATCH /questions/{channel}/{id})
+	PatchQuestion(w http.ResponseWriter, r *http.Request, channel string, id openapi_types.UUID, params PatchQuestionParams)
+	// Unpins question
+	// (DELETE /questions/{channel}/{id}/pin)
+	UnpinQuestion(w http.ResponseWriter, r *http.Request, channel string, id openapi_types.UUID, params UnpinQuestionParams)
+	// Pins question
+	// (PUT /questions/{channel}/{id}/pin)
+	PinQuestion(w http.ResponseWriter, r *http.Request, channel string, id openapi_types.UUID, params PinQuestionParams)
+	// Withdraws vote
+	// (DELETE /questions/{channel}/{id}/vote)
+	UnvoteQuestion(w http.ResponseWriter, r *http.Request, channel string, id openapi_types.UUID, params UnvoteQuestionParams)
+	// Upvotes question
+	// (POST /questions/{channel}/{id}/vote)
+	VoteQuestion(w http.ResponseWriter, r *http.Request, channel string, id openapi_types.UUID, params VoteQuestionParams)
+	// Delete recording
+	// (DELETE /recordings/{id})
+	DeleteRecording(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteRecordingParams)
+	// Play recording
+	// (GET /recordings/{id}/play)
+	PlayRecording(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PlayRecordingParams)
+	// Watch recording
+	// (GET /recordings/{id}/watch)
+	WatchRecording(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params WatchRecordingParams)
+	// Full-text search over broadcasts and chat
+	// (GET /search)
+	Search(w http.ResponseWriter, r *http.Request, params SearchParams)
 	// Added stream
 	// (POST /stream)
 	PostStream(w http.ResponseWriter, r *http.Request)
 	// Update stream field description
 	// (PUT /stream)
-	PutStream(w http.ResponseWriter, r *http.Request)
+	PutStream(w http.ResponseWriter, r *http.Request, params PutStreamParams)
 	// Clear chat history
 	// (DELETE /stream/chat/{channel})
 	DeleteStreamChat(w http.ResponseWriter, r *http.Request, channel string)
 	// Get stream info by username
 	// (GET /stream/{username})
 	GetStreamByUsername(w http.ResponseWriter, r *http.Request, username string)
+	// Rotate personal stream key
+	// (POST /stream/{username}/stream_key)
+	RotateUserStreamKey(w http.ResponseWriter, r *http.Request, username string)
+	// Tag vocabulary
+	// (GET /tags)
+	GetTags(w http.ResponseWriter, r *http.Request)
+	// Adds a tag
+	// (POST /tags)
+	PostTag(w http.ResponseWriter, r *http.Request, params PostTagParams)
+	// Deletes the tag
+	// (DELETE /tags/{id})
+	DeleteTag(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteTagParams)
+	// Updates the tag
+	// (PUT /tags/{id})
+	PutTag(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutTagParams)
 	// Get token
 	// (POST /token)
 	PostUserGetToken(w http.ResponseWriter, r *http.Request)
+	// Get subscription token
+	// (POST /token/{channel})
+	PostUserGetChannelToken(w http.ResponseWriter, r *http.Request, channel string)
+	// Webhook subscriptions
+	// (GET /webhooks)
+	GetWebhooks(w http.ResponseWriter, r *http.Request, params GetWebhooksParams)
+	// Adds a webhook
+	// (POST /webhooks)
+	PostWebhook(w http.ResponseWriter, r *http.Request, params PostWebhookParams)
+	// Deletes the webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteWebhookParams)
+	// Updates the webhook
+	// (PUT /webhooks/{id})
+	PutWebhook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutWebhookParams)
+	// Deliveries of the webhook
+	// (GET /webhooks/{id}/deliveries)
+	GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetWebhookDeliveriesParams)
 	// Hook send zoom service
 	// (POST /zoom)
 	PostZoom(w http.ResponseWriter, r *http.Request)
@@ -320,7 +1606,2511 @@ func (siw *ServerInterfaceWrapper) CheckAdmin(w http.ResponseWriter, r *http.Req
 	ctx := r.Context()
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CheckAdmin(w, r)
+		siw.Handler.CheckAdmin(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetBroadcasts operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcasts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBroadcastsParams
+
+	// ------------- Optional query parameter "owner" -------------
+	if paramValue := r.URL.Query().Get("owner"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "owner", r.URL.Query(), &params.Owner)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "life" -------------
+	if paramValue := r.URL.Query().Get("life"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "life", r.URL.Query(), &params.Life)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "life", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := r.URL.Query().Get("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+	if paramValue := r.URL.Query().Get("cursor"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBroadcasts(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostBroadcasts operation middleware
+func (siw *ServerInterfaceWrapper) PostBroadcasts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBroadcastsParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "force" -------------
+	if paramValue := r.URL.Query().Get("force"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBroadcasts(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutBroadcast operation middleware
+func (siw *ServerInterfaceWrapper) PutBroadcast(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutBroadcastParams
+
+	// ------------- Optional query parameter "scope" -------------
+	if paramValue := r.URL.Query().Get("scope"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "force" -------------
+	if paramValue := r.URL.Query().Get("force"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch PIfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutBroadcast(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostUserGetBroadcastArch operation middleware
+func (siw *ServerInterfaceWrapper) PostUserGetBroadcastArch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUserGetBroadcastArchParams
+
+	// ------------- Optional query parameter "owner" -------------
+	if paramValue := r.URL.Query().Get("owner"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "owner", r.URL.Query(), &params.Owner)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := r.URL.Query().Get("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+	if paramValue := r.URL.Query().Get("cursor"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUserGetBroadcastArch(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetSeriesById operation middleware
+func (siw *ServerInterfaceWrapper) GetSeriesById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSeriesByIdParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSeriesById(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostUserGetBroadcastTrash operation middleware
+func (siw *ServerInterfaceWrapper) PostUserGetBroadcastTrash(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUserGetBroadcastTrash(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteBroadcast operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBroadcast(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteBroadcastParams
+
+	// ------------- Optional query parameter "scope" -------------
+	if paramValue := r.URL.Query().Get("scope"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBroadcast(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetBroadcastById operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBroadcastByIdParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBroadcastById(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchBroadcast operation middleware
+func (siw *ServerInterfaceWrapper) PatchBroadcast(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchBroadcastParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "force" -------------
+	if paramValue := r.URL.Query().Get("force"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch PIfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchBroadcast(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetBroadcastAccess operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastAccess(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBroadcastAccessParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBroadcastAccess(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostBroadcastAccess operation middleware
+func (siw *ServerInterfaceWrapper) PostBroadcastAccess(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBroadcastAccessParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBroadcastAccess(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteBroadcastAccess operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBroadcastAccess(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "user" -------------
+	var user string
+
+	err = runtime.BindStyledParameter("simple", false, "user", chi.URLParam(r, "user"), &user)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteBroadcastAccessParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBroadcastAccess(w, r, id, user, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetBroadcastAnalytics operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastAnalytics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBroadcastAnalyticsParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBroadcastAnalytics(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetBroadcastAnalyticsSeries operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastAnalyticsSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBroadcastAnalyticsSeriesParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+	if paramValue := r.URL.Query().Get("bucket"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", r.URL.Query(), &params.Bucket)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bucket", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBroadcastAnalyticsSeries(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetBroadcastFiles operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastFiles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBroadcastFilesParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBroadcastFiles(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostBroadcastFile operation middleware
+func (siw *ServerInterfaceWrapper) PostBroadcastFile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBroadcastFileParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBroadcastFile(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateBroadcastInvite operation middleware
+func (siw *ServerInterfaceWrapper) CreateBroadcastInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateBroadcastInviteParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBroadcastInvite(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetBroadcastRecordings operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastRecordings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBroadcastRecordingsParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBroadcastRecordings(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostBroadcastRecording operation middleware
+func (siw *ServerInterfaceWrapper) PostBroadcastRecording(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBroadcastRecordingParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBroadcastRecording(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UploadBroadcastRecording operation middleware
+func (siw *ServerInterfaceWrapper) UploadBroadcastRecording(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadBroadcastRecordingParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadBroadcastRecording(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetBroadcastReport operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBroadcastReportParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBroadcastReport(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteBroadcastReportMail operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBroadcastReportMail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteBroadcastReportMailParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBroadcastReportMail(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutBroadcastReportMail operation middleware
+func (siw *ServerInterfaceWrapper) PutBroadcastReportMail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutBroadcastReportMailParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutBroadcastReportMail(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RestoreBroadcast operation middleware
+func (siw *ServerInterfaceWrapper) RestoreBroadcast(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreBroadcast(w, r, id)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetBroadcastRevisions operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBroadcastRevisionsParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBroadcastRevisions(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RevertBroadcastRevision operation middleware
+func (siw *ServerInterfaceWrapper) RevertBroadcastRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "revision", chi.URLParam(r, "revision"), &revision)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevertBroadcastRevisionParams
+
+	// ------------- Optional query parameter "force" -------------
+	if paramValue := r.URL.Query().Get("force"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch PIfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevertBroadcastRevision(w, r, id, revision, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RotateStreamKey operation middleware
+func (siw *ServerInterfaceWrapper) RotateStreamKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateStreamKey(w, r, id)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) CreateCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCalendarFeed(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendarFeed(w, r, token)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetCategories operation middleware
+func (siw *ServerInterfaceWrapper) GetCategories(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCategories(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostCategory operation middleware
+func (siw *ServerInterfaceWrapper) PostCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostCategoryParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCategory(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteCategory operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteCategoryParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCategory(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetArchiveAtom operation middleware
+func (siw *ServerInterfaceWrapper) GetArchiveAtom(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArchiveAtomParams
+
+	// ------------- Optional query parameter "owner" -------------
+	if paramValue := r.URL.Query().Get("owner"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "owner", r.URL.Query(), &params.Owner)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArchiveAtom(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetArchiveRss operation middleware
+func (siw *ServerInterfaceWrapper) GetArchiveRss(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArchiveRssParams
+
+	// ------------- Optional query parameter "owner" -------------
+	if paramValue := r.URL.Query().Get("owner"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "owner", r.URL.Query(), &params.Owner)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArchiveRss(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteFileById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFileById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteFileByIdParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteFileById(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetFileById operation middleware
+func (siw *ServerInterfaceWrapper) GetFileById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFileByIdParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFileById(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostImage operation middleware
+func (siw *ServerInterfaceWrapper) PostImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostImage(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetImageById operation middleware
+func (siw *ServerInterfaceWrapper) GetImageById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetImageById(w, r, id)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutImageById operation middleware
+func (siw *ServerInterfaceWrapper) PutImageById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutImageByIdParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutImageById(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// IngestOnPublish operation middleware
+func (siw *ServerInterfaceWrapper) IngestOnPublish(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IngestOnPublish(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// IngestOnPublishDone operation middleware
+func (siw *ServerInterfaceWrapper) IngestOnPublishDone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IngestOnPublishDone(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// IngestOnRecordDone operation middleware
+func (siw *ServerInterfaceWrapper) IngestOnRecordDone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IngestOnRecordDone(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AcceptInvite operation middleware
+func (siw *ServerInterfaceWrapper) AcceptInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptInvite(w, r, token)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetLive operation middleware
+func (siw *ServerInterfaceWrapper) GetLive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLive(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetLiveById operation middleware
+func (siw *ServerInterfaceWrapper) GetLiveById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLiveById(w, r, id)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetMsgByChannel operation middleware
+func (siw *ServerInterfaceWrapper) GetMsgByChannel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMsgByChannelParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+	if paramValue := r.URL.Query().Get("before"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+	if paramValue := r.URL.Query().Get("after"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMsgByChannel(w, r, channel, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostMsgByChannel operation middleware
+func (siw *ServerInterfaceWrapper) PostMsgByChannel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostMsgByChannel(w, r, channel)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchReactionMsg operation middleware
+func (siw *ServerInterfaceWrapper) PatchReactionMsg(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchReactionMsg(w, r, channel)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostReactionMsg operation middleware
+func (siw *ServerInterfaceWrapper) PostReactionMsg(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReactionMsg(w, r, channel)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteMsg operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMsg(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteMsgParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMsg(w, r, channel, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// EditMsg operation middleware
+func (siw *ServerInterfaceWrapper) EditMsg(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditMsgParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EditMsg(w, r, channel, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetMsgEdits operation middleware
+func (siw *ServerInterfaceWrapper) GetMsgEdits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMsgEditsParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMsgEdits(w, r, channel, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetMsgReplies operation middleware
+func (siw *ServerInterfaceWrapper) GetMsgReplies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMsgRepliesParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMsgReplies(w, r, channel, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsByChannel operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsByChannel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipantsByChannelParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetParticipantsByChannel(w, r, channel, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostParticipantsByChannel operation middleware
+func (siw *ServerInterfaceWrapper) PostParticipantsByChannel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostParticipantsByChannel(w, r, channel)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteParticipant operation middleware
+func (siw *ServerInterfaceWrapper) DeleteParticipant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameter("simple", false, "username", chi.URLParam(r, "username"), &username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteParticipant(w, r, channel, username)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetQuestions operation middleware
+func (siw *ServerInterfaceWrapper) GetQuestions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQuestionsParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQuestions(w, r, channel, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchQuestion operation middleware
+func (siw *ServerInterfaceWrapper) PatchQuestion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchQuestionParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchQuestion(w, r, channel, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UnpinQuestion operation middleware
+func (siw *ServerInterfaceWrapper) UnpinQuestion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UnpinQuestionParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnpinQuestion(w, r, channel, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PinQuestion operation middleware
+func (siw *ServerInterfaceWrapper) PinQuestion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PinQuestionParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PinQuestion(w, r, channel, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UnvoteQuestion operation middleware
+func (siw *ServerInterfaceWrapper) UnvoteQuestion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UnvoteQuestionParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnvoteQuestion(w, r, channel, id, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -330,27 +4120,46 @@ func (siw *ServerInterfaceWrapper) CheckAdmin(w http.ResponseWriter, r *http.Req
 	handler(w, r.WithContext(ctx))
 }
 
-// GetBroadcasts operation middleware
-func (siw *ServerInterfaceWrapper) GetBroadcasts(w http.ResponseWriter, r *http.Request) {
+// VoteQuestion operation middleware
+func (siw *ServerInterfaceWrapper) VoteQuestion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBroadcasts(w, r)
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	handler(w, r.WithContext(ctx))
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VoteQuestionParams
 
-// PostBroadcasts operation middleware
-func (siw *ServerInterfaceWrapper) PostBroadcasts(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostBroadcasts(w, r)
+		siw.Handler.VoteQuestion(w, r, channel, id, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -360,12 +4169,37 @@ func (siw *ServerInterfaceWrapper) PostBroadcasts(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// PutBroadcast operation middleware
-func (siw *ServerInterfaceWrapper) PutBroadcast(w http.ResponseWriter, r *http.Request) {
+// DeleteRecording operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRecording(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteRecordingParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutBroadcast(w, r)
+		siw.Handler.DeleteRecording(w, r, id, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -375,12 +4209,54 @@ func (siw *ServerInterfaceWrapper) PutBroadcast(w http.ResponseWriter, r *http.R
 	handler(w, r.WithContext(ctx))
 }
 
-// PostUserGetBroadcastArch operation middleware
-func (siw *ServerInterfaceWrapper) PostUserGetBroadcastArch(w http.ResponseWriter, r *http.Request) {
+// PlayRecording operation middleware
+func (siw *ServerInterfaceWrapper) PlayRecording(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PlayRecordingParams
+
+	// ------------- Required query parameter "expires" -------------
+	if paramValue := r.URL.Query().Get("expires"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "expires"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "expires", r.URL.Query(), &params.Expires)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expires", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "signature" -------------
+	if paramValue := r.URL.Query().Get("signature"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "signature"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "signature", r.URL.Query(), &params.Signature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUserGetBroadcastArch(w, r)
+		siw.Handler.PlayRecording(w, r, id, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -390,8 +4266,8 @@ func (siw *ServerInterfaceWrapper) PostUserGetBroadcastArch(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// DeleteBroadcast operation middleware
-func (siw *ServerInterfaceWrapper) DeleteBroadcast(w http.ResponseWriter, r *http.Request) {
+// WatchRecording operation middleware
+func (siw *ServerInterfaceWrapper) WatchRecording(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -405,8 +4281,22 @@ func (siw *ServerInterfaceWrapper) DeleteBroadcast(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchRecordingParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteBroadcast(w, r, id)
+		siw.Handler.WatchRecording(w, r, id, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -416,23 +4306,53 @@ func (siw *ServerInterfaceWrapper) DeleteBroadcast(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// GetBroadcastById operation middleware
-func (siw *ServerInterfaceWrapper) GetBroadcastById(w http.ResponseWriter, r *http.Request) {
+// Search operation middleware
+func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchParams
 
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	// ------------- Required query parameter "q" -------------
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBroadcastById(w, r, id)
+		siw.Handler.Search(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -442,12 +4362,12 @@ func (siw *ServerInterfaceWrapper) GetBroadcastById(w http.ResponseWriter, r *ht
 	handler(w, r.WithContext(ctx))
 }
 
-// PostImage operation middleware
-func (siw *ServerInterfaceWrapper) PostImage(w http.ResponseWriter, r *http.Request) {
+// PostStream operation middleware
+func (siw *ServerInterfaceWrapper) PostStream(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostImage(w, r)
+		siw.Handler.PostStream(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -457,23 +4377,64 @@ func (siw *ServerInterfaceWrapper) PostImage(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
-// GetImageById operation middleware
-func (siw *ServerInterfaceWrapper) GetImageById(w http.ResponseWriter, r *http.Request) {
+// PutStream operation middleware
+func (siw *ServerInterfaceWrapper) PutStream(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutStreamParams
 
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch PIfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutStream(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteStreamChat operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStreamChat(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "channel" -------------
+	var channel string
+
+	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetImageById(w, r, id)
+		siw.Handler.DeleteStreamChat(w, r, channel)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -483,23 +4444,23 @@ func (siw *ServerInterfaceWrapper) GetImageById(w http.ResponseWriter, r *http.R
 	handler(w, r.WithContext(ctx))
 }
 
-// PutImageById operation middleware
-func (siw *ServerInterfaceWrapper) PutImageById(w http.ResponseWriter, r *http.Request) {
+// GetStreamByUsername operation middleware
+func (siw *ServerInterfaceWrapper) GetStreamByUsername(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	err = runtime.BindStyledParameter("simple", false, "username", chi.URLParam(r, "username"), &username)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutImageById(w, r, id)
+		siw.Handler.GetStreamByUsername(w, r, username)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -509,12 +4470,38 @@ func (siw *ServerInterfaceWrapper) PutImageById(w http.ResponseWriter, r *http.R
 	handler(w, r.WithContext(ctx))
 }
 
-// GetLive operation middleware
-func (siw *ServerInterfaceWrapper) GetLive(w http.ResponseWriter, r *http.Request) {
+// RotateUserStreamKey operation middleware
+func (siw *ServerInterfaceWrapper) RotateUserStreamKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameter("simple", false, "username", chi.URLParam(r, "username"), &username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateUserStreamKey(w, r, username)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTags operation middleware
+func (siw *ServerInterfaceWrapper) GetTags(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLive(w, r)
+		siw.Handler.GetTags(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -524,8 +4511,39 @@ func (siw *ServerInterfaceWrapper) GetLive(w http.ResponseWriter, r *http.Reques
 	handler(w, r.WithContext(ctx))
 }
 
-// GetLiveById operation middleware
-func (siw *ServerInterfaceWrapper) GetLiveById(w http.ResponseWriter, r *http.Request) {
+// PostTag operation middleware
+func (siw *ServerInterfaceWrapper) PostTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTagParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTag(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -539,8 +4557,22 @@ func (siw *ServerInterfaceWrapper) GetLiveById(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTagParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLiveById(w, r, id)
+		siw.Handler.DeleteTag(w, r, id, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -550,23 +4582,37 @@ func (siw *ServerInterfaceWrapper) GetLiveById(w http.ResponseWriter, r *http.Re
 	handler(w, r.WithContext(ctx))
 }
 
-// GetMsgByChannel operation middleware
-func (siw *ServerInterfaceWrapper) GetMsgByChannel(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// PutTag operation middleware
+func (siw *ServerInterfaceWrapper) PutTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTagParams
 
-	var err error
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
 
-	// ------------- Path parameter "channel" -------------
-	var channel string
+	}
 
-	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMsgByChannel(w, r, channel)
+		siw.Handler.PutTag(w, r, id, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -576,23 +4622,12 @@ func (siw *ServerInterfaceWrapper) GetMsgByChannel(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// PostMsgByChannel operation middleware
-func (siw *ServerInterfaceWrapper) PostMsgByChannel(w http.ResponseWriter, r *http.Request) {
+// PostUserGetToken operation middleware
+func (siw *ServerInterfaceWrapper) PostUserGetToken(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "channel" -------------
-	var channel string
-
-	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
-		return
-	}
-
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostMsgByChannel(w, r, channel)
+		siw.Handler.PostUserGetToken(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -602,8 +4637,8 @@ func (siw *ServerInterfaceWrapper) PostMsgByChannel(w http.ResponseWriter, r *ht
 	handler(w, r.WithContext(ctx))
 }
 
-// PatchReactionMsg operation middleware
-func (siw *ServerInterfaceWrapper) PatchReactionMsg(w http.ResponseWriter, r *http.Request) {
+// PostUserGetChannelToken operation middleware
+func (siw *ServerInterfaceWrapper) PostUserGetChannelToken(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -618,7 +4653,7 @@ func (siw *ServerInterfaceWrapper) PatchReactionMsg(w http.ResponseWriter, r *ht
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchReactionMsg(w, r, channel)
+		siw.Handler.PostUserGetChannelToken(w, r, channel)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -628,23 +4663,28 @@ func (siw *ServerInterfaceWrapper) PatchReactionMsg(w http.ResponseWriter, r *ht
 	handler(w, r.WithContext(ctx))
 }
 
-// PostReactionMsg operation middleware
-func (siw *ServerInterfaceWrapper) PostReactionMsg(w http.ResponseWriter, r *http.Request) {
+// GetWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "channel" -------------
-	var channel string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksParams
 
-	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostReactionMsg(w, r, channel)
+		siw.Handler.GetWebhooks(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -654,23 +4694,28 @@ func (siw *ServerInterfaceWrapper) PostReactionMsg(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// GetParticipantsByChannel operation middleware
-func (siw *ServerInterfaceWrapper) GetParticipantsByChannel(w http.ResponseWriter, r *http.Request) {
+// PostWebhook operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhook(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "channel" -------------
-	var channel string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWebhookParams
 
-	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetParticipantsByChannel(w, r, channel)
+		siw.Handler.PostWebhook(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -680,38 +4725,37 @@ func (siw *ServerInterfaceWrapper) GetParticipantsByChannel(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// PostParticipantsByChannel operation middleware
-func (siw *ServerInterfaceWrapper) PostParticipantsByChannel(w http.ResponseWriter, r *http.Request) {
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "channel" -------------
-	var channel string
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostParticipantsByChannel(w, r, channel)
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteWebhookParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
 
-	handler(w, r.WithContext(ctx))
-}
+	}
 
-// PostStream operation middleware
-func (siw *ServerInterfaceWrapper) PostStream(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostStream(w, r)
+		siw.Handler.DeleteWebhook(w, r, id, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -721,38 +4765,37 @@ func (siw *ServerInterfaceWrapper) PostStream(w http.ResponseWriter, r *http.Req
 	handler(w, r.WithContext(ctx))
 }
 
-// PutStream operation middleware
-func (siw *ServerInterfaceWrapper) PutStream(w http.ResponseWriter, r *http.Request) {
+// PutWebhook operation middleware
+func (siw *ServerInterfaceWrapper) PutWebhook(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutStream(w, r)
-	}
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	handler(w, r.WithContext(ctx))
-}
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-// DeleteStreamChat operation middleware
-func (siw *ServerInterfaceWrapper) DeleteStreamChat(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutWebhookParams
 
-	var err error
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
 
-	// ------------- Path parameter "channel" -------------
-	var channel string
+	}
 
-	err = runtime.BindStyledParameter("simple", false, "channel", chi.URLParam(r, "channel"), &channel)
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteStreamChat(w, r, channel)
+		siw.Handler.PutWebhook(w, r, id, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -762,38 +4805,48 @@ func (siw *ServerInterfaceWrapper) DeleteStreamChat(w http.ResponseWriter, r *ht
 	handler(w, r.WithContext(ctx))
 }
 
-// GetStreamByUsername operation middleware
-func (siw *ServerInterfaceWrapper) GetStreamByUsername(w http.ResponseWriter, r *http.Request) {
+// GetWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "username" -------------
-	var username string
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameter("simple", false, "username", chi.URLParam(r, "username"), &username)
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStreamByUsername(w, r, username)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "username" -------------
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
 	}
 
-	handler(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
-// PostUserGetToken operation middleware
-func (siw *ServerInterfaceWrapper) PostUserGetToken(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUserGetToken(w, r)
+		siw.Handler.GetWebhookDeliveries(w, r, id, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -998,12 +5051,102 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/broadcasts/arch", wrapper.PostUserGetBroadcastArch)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/broadcasts/series/{id}", wrapper.GetSeriesById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/broadcasts/trash", wrapper.PostUserGetBroadcastTrash)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/broadcasts/{id}", wrapper.DeleteBroadcast)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/broadcasts/{id}", wrapper.GetBroadcastById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/broadcasts/{id}", wrapper.PatchBroadcast)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/broadcasts/{id}/access", wrapper.GetBroadcastAccess)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/broadcasts/{id}/access", wrapper.PostBroadcastAccess)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/broadcasts/{id}/access/{user}", wrapper.DeleteBroadcastAccess)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/broadcasts/{id}/analytics", wrapper.GetBroadcastAnalytics)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/broadcasts/{id}/analytics/series", wrapper.GetBroadcastAnalyticsSeries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/broadcasts/{id}/files", wrapper.GetBroadcastFiles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/broadcasts/{id}/files", wrapper.PostBroadcastFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/broadcasts/{id}/invite", wrapper.CreateBroadcastInvite)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/broadcasts/{id}/recordings", wrapper.GetBroadcastRecordings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/broadcasts/{id}/recordings", wrapper.PostBroadcastRecording)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/broadcasts/{id}/recordings/upload", wrapper.UploadBroadcastRecording)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/broadcasts/{id}/report", wrapper.GetBroadcastReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/broadcasts/{id}/report/mail", wrapper.DeleteBroadcastReportMail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/broadcasts/{id}/report/mail", wrapper.PutBroadcastReportMail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/broadcasts/{id}/restore", wrapper.RestoreBroadcast)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/broadcasts/{id}/revisions", wrapper.GetBroadcastRevisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/broadcasts/{id}/revisions/{revision}/revert", wrapper.RevertBroadcastRevision)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/broadcasts/{id}/stream_key", wrapper.RotateStreamKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/calendar/feeds", wrapper.CreateCalendarFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/calendar/{token}.ics", wrapper.GetCalendarFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/categories", wrapper.GetCategories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/categories", wrapper.PostCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/categories/{id}", wrapper.DeleteCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/feeds/archive.atom", wrapper.GetArchiveAtom)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/feeds/archive.rss", wrapper.GetArchiveRss)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/files/{id}", wrapper.DeleteFileById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/files/{id}", wrapper.GetFileById)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/images", wrapper.PostImage)
 	})
@@ -1013,6 +5156,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/images/{id}", wrapper.PutImageById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/ingest/on_publish", wrapper.IngestOnPublish)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/ingest/on_publish_done", wrapper.IngestOnPublishDone)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/ingest/on_record_done", wrapper.IngestOnRecordDone)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/invites/{token}", wrapper.AcceptInvite)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/live", wrapper.GetLive)
 	})
@@ -1031,12 +5186,57 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/messages/{channel}/reaction", wrapper.PostReactionMsg)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/messages/{channel}/{id}", wrapper.DeleteMsg)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/messages/{channel}/{id}", wrapper.EditMsg)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/messages/{channel}/{id}/edits", wrapper.GetMsgEdits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/messages/{channel}/{id}/replies", wrapper.GetMsgReplies)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/participants/{channel}", wrapper.GetParticipantsByChannel)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/participants/{channel}", wrapper.PostParticipantsByChannel)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/participants/{channel}/{username}", wrapper.DeleteParticipant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/questions/{channel}", wrapper.GetQuestions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/questions/{channel}/{id}", wrapper.PatchQuestion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/questions/{channel}/{id}/pin", wrapper.UnpinQuestion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/questions/{channel}/{id}/pin", wrapper.PinQuestion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/questions/{channel}/{id}/vote", wrapper.UnvoteQuestion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/questions/{channel}/{id}/vote", wrapper.VoteQuestion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/recordings/{id}", wrapper.DeleteRecording)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/recordings/{id}/play", wrapper.PlayRecording)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/recordings/{id}/watch", wrapper.WatchRecording)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/search", wrapper.Search)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/stream", wrapper.PostStream)
 	})
@@ -1049,9 +5249,42 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/stream/{username}", wrapper.GetStreamByUsername)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/stream/{username}/stream_key", wrapper.RotateUserStreamKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tags", wrapper.GetTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tags", wrapper.PostTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tags/{id}", wrapper.DeleteTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/tags/{id}", wrapper.PutTag)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/token", wrapper.PostUserGetToken)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/token/{channel}", wrapper.PostUserGetChannelToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks", wrapper.GetWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks", wrapper.PostWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhooks/{id}", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/webhooks/{id}", wrapper.PutWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{id}/deliveries", wrapper.GetWebhookDeliveries)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/zoom", wrapper.PostZoom)
 	})
@@ -29,9 +29,9 @@ func (h *Handler) InitRoutes(basePath string) http.Handler {
 	router.Use(httplog.RequestLogger(logHttp))
 	router.Use(cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "If-Match"},
+		ExposedHeaders:   []string{"Content-Disposition", "ETag", "Link", "X-Total-Count", "X-Facets"},
 		AllowCredentials: false,
 		MaxAge:           300,
 	}).Handler)
@@ -40,9 +40,21 @@ func (h *Handler) InitRoutes(basePath string) http.Handler {
 	router.Use(middleware.SetHeader("Accept", "application/json"))
 
 	router.Route(path.Join("/", basePath), func(router chi.Router) {
-		router.Use(middleware.NoCache)
+		router.Use(noCache)
 		router.Mount("/", api.Handler(routes))
 	})
 
 	return router
 }
+
+// noCache sets the response headers of middleware.NoCache, unlike it the
+// request keeps the If-Match header the routes use to detect lost updates.
+func noCache(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Expires", "Thu, 01 Jan 1970 00:00:00 UTC")
+		w.Header().Set("Cache-Control", "no-cache, no-store, no-transform, must-revalidate, private, max-age=0")
+		w.Header().Set("Pragma", "no-cache")
+		w.Header().Set("X-Accel-Expires", "0")
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func TestHandler_InitRoutes(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIBroadcasts, id uuid.UUID)
+
+	id := uuid.New()
+	name := "test"
+	version := 2
+	broadcast := models.Broadcasts{SIdentifier: api.SIdentifier{Id: &id}, SBroadcast: api.SBroadcast{Name: &name}}
+	broadcast.Version = 3
+
+	tests := []struct {
+		name               string
+		ifMatch            string
+		mockBehavior       mockBehavior
+		expectedStatusCode int
+		expectedETag       string
+	}{
+		{
+			name:    "If-Match reaches the route",
+			ifMatch: `"2"`,
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().PatchBroadcast(id, models.PatchBroadcast{Name: &name}, nil, &version, false).Return(broadcast, nil)
+			},
+			expectedStatusCode: 200,
+			expectedETag:       `"3"`,
+		},
+		{
+			name: "Without If-Match",
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().PatchBroadcast(id, models.PatchBroadcast{Name: &name}, nil, nil, false).Return(broadcast, nil)
+			},
+			expectedStatusCode: 200,
+			expectedETag:       `"3"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIBroadcasts := mockService.NewMockIBroadcasts(c)
+			test.mockBehavior(mockIBroadcasts, id)
+
+			services := &service.Service{IBroadcasts: mockIBroadcasts}
+			router := NewHandler(services).InitRoutes("api/v1")
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPatch, "/api/v1/broadcasts/"+id.String(), bytes.NewBufferString(`{"name":"test"}`))
+			if len(test.ifMatch) > 0 {
+				req.Header.Set("If-Match", test.ifMatch)
+			}
+
+			// Make Request
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Header().Get("ETag"), test.expectedETag)
+			assert.Equal(t, w.Header().Get("Cache-Control"), "no-cache, no-store, no-transform, must-revalidate, private, max-age=0")
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) GetBroadcastAccess(w http.ResponseWriter, _ *http.Request, id types.UUID, params api.GetBroadcastAccessParams) {
+	items, err := c.service.IAccess.GetAccess(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetAccess)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(items)
+}
+
+func (c *Route) PostBroadcastAccess(w http.ResponseWriter, r *http.Request, id types.UUID, params api.PostBroadcastAccessParams) {
+	var user api.SUsername
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+	if user.Username == nil {
+		newErrorResponse(w, http.StatusBadRequest, models.MsgUsernameEmpty, models.MsgUsernameEmpty)
+		return
+	}
+
+	item, err := c.service.IAccess.GrantAccess(id, *user.Username, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGrantAccess)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+func (c *Route) DeleteBroadcastAccess(
+	w http.ResponseWriter, _ *http.Request, id types.UUID, user string, params api.DeleteBroadcastAccessParams) {
+	item, err := c.service.IAccess.RevokeAccess(id, user, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound), errors.Is(err, models.ErrAccessNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceRevokeAccess)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+func (c *Route) CreateBroadcastInvite(
+	w http.ResponseWriter, _ *http.Request, id types.UUID, params api.CreateBroadcastInviteParams) {
+	item, err := c.service.IAccess.CreateInvite(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceCreateInvite)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+func (c *Route) AcceptInvite(w http.ResponseWriter, r *http.Request, token string) {
+	var user api.SUsername
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+	if user.Username == nil {
+		newErrorResponse(w, http.StatusBadRequest, models.MsgUsernameEmpty, models.MsgUsernameEmpty)
+		return
+	}
+
+	item, err := c.service.IAccess.AcceptInvite(token, *user.Username)
+	switch {
+	case errors.Is(err, models.ErrInviteNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceAcceptInvite)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
@@ -0,0 +1,445 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func TestRoute_GetBroadcastAccess(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIAccess, id uuid.UUID, actor *string)
+
+	id := uuid.New()
+	owner := "owner"
+	user := "test"
+	createdAt := time.Date(2022, time.July, 1, 8, 0, 0, 0, time.UTC)
+
+	items := []models.Access{
+		{BroadcastId: &id, Username: &user, GrantedBy: &owner, CreatedAt: &createdAt},
+	}
+
+	jsonItems, _ := json.Marshal(items)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, actor *string) {
+				r.EXPECT().GetAccess(id, actor).Return(items, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItems) + "\n",
+		},
+		{
+			name: "Not the owner",
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, actor *string) {
+				r.EXPECT().GetAccess(id, actor).Return(nil, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name: "Broadcast not found",
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, actor *string) {
+				r.EXPECT().GetAccess(id, actor).Return(nil, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, actor *string) {
+				r.EXPECT().GetAccess(id, actor).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetAccess + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIAccess := mockService.NewMockIAccess(c)
+			test.mockBehavior(mockIAccess, id, &owner)
+
+			services := &service.Service{IAccess: mockIAccess}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			path := "/broadcasts/" + id.String() + "/access?username=" + owner
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_PostBroadcastAccess(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIAccess, id uuid.UUID, username string, actor *string)
+
+	id := uuid.New()
+	owner := "owner"
+	user := "test"
+
+	item := models.Access{BroadcastId: &id, Username: &user, GrantedBy: &owner}
+
+	jsonUser, _ := json.Marshal(api.SUsername{Username: &user})
+	jsonItem, _ := json.Marshal(item)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, username string, actor *string) {
+				r.EXPECT().GrantAccess(id, username, actor).Return(item, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItem) + "\n",
+		},
+		{
+			name:                 "Username field is empty",
+			inputBody:            `{}`,
+			mockBehavior:         func(r *mockService.MockIAccess, id uuid.UUID, username string, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgUsernameEmpty + `"}` + "\n",
+		},
+		{
+			name:      "Not the owner",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, username string, actor *string) {
+				r.EXPECT().GrantAccess(id, username, actor).Return(models.Access{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, username string, actor *string) {
+				r.EXPECT().GrantAccess(id, username, actor).Return(models.Access{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGrantAccess + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIAccess := mockService.NewMockIAccess(c)
+			test.mockBehavior(mockIAccess, id, user, &owner)
+
+			services := &service.Service{IAccess: mockIAccess}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			path := "/broadcasts/" + id.String() + "/access?username=" + owner
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_DeleteBroadcastAccess(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIAccess, id uuid.UUID, username string, actor *string)
+
+	id := uuid.New()
+	owner := "owner"
+	user := "test"
+
+	item := models.Access{BroadcastId: &id, Username: &user}
+
+	jsonItem, _ := json.Marshal(item)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, username string, actor *string) {
+				r.EXPECT().RevokeAccess(id, username, actor).Return(item, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItem) + "\n",
+		},
+		{
+			name: "User is not allowed",
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, username string, actor *string) {
+				r.EXPECT().RevokeAccess(id, username, actor).Return(models.Access{}, models.ErrAccessNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgAccessNotFound + `"}` + "\n",
+		},
+		{
+			name: "Not the owner",
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, username string, actor *string) {
+				r.EXPECT().RevokeAccess(id, username, actor).Return(models.Access{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, username string, actor *string) {
+				r.EXPECT().RevokeAccess(id, username, actor).Return(models.Access{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceRevokeAccess + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIAccess := mockService.NewMockIAccess(c)
+			test.mockBehavior(mockIAccess, id, user, &owner)
+
+			services := &service.Service{IAccess: mockIAccess}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			path := "/broadcasts/" + id.String() + "/access/" + user + "?username=" + owner
+			req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_CreateBroadcastInvite(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIAccess, id uuid.UUID, actor *string)
+
+	id := uuid.New()
+	owner := "owner"
+	token := "3f7a"
+	url := "https://vp.ru/watch/" + id.String() + "?invite=" + token
+
+	invite := api.SInvite{Token: &token, Url: &url}
+
+	jsonInvite, _ := json.Marshal(invite)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, actor *string) {
+				r.EXPECT().CreateInvite(id, actor).Return(invite, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonInvite) + "\n",
+		},
+		{
+			name: "Not the owner",
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, actor *string) {
+				r.EXPECT().CreateInvite(id, actor).Return(api.SInvite{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIAccess, id uuid.UUID, actor *string) {
+				r.EXPECT().CreateInvite(id, actor).Return(api.SInvite{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceCreateInvite + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIAccess := mockService.NewMockIAccess(c)
+			test.mockBehavior(mockIAccess, id, &owner)
+
+			services := &service.Service{IAccess: mockIAccess}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			path := "/broadcasts/" + id.String() + "/invite?username=" + owner
+			req := httptest.NewRequest(http.MethodPost, path, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_AcceptInvite(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIAccess, token string, username string)
+
+	id := uuid.New()
+	name := "test"
+	user := "test"
+	token := "3f7a"
+	visibility := string(models.VisibilityInviteOnly)
+
+	broadcast := models.Broadcasts{
+		SIdentifier: api.SIdentifier{Id: &id},
+		SBroadcast:  api.SBroadcast{Name: &name},
+		SVisibility: api.SVisibility{Visibility: &visibility},
+	}
+
+	jsonUser, _ := json.Marshal(api.SUsername{Username: &user})
+	jsonBroadcast, _ := json.Marshal(broadcast)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIAccess, token string, username string) {
+				r.EXPECT().AcceptInvite(token, username).Return(broadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:                 "Username field is empty",
+			inputBody:            `{}`,
+			mockBehavior:         func(r *mockService.MockIAccess, token string, username string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgUsernameEmpty + `"}` + "\n",
+		},
+		{
+			name:      "Invite not found",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIAccess, token string, username string) {
+				r.EXPECT().AcceptInvite(token, username).Return(models.Broadcasts{}, models.ErrInviteNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgInviteNotFound + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIAccess, token string, username string) {
+				r.EXPECT().AcceptInvite(token, username).Return(models.Broadcasts{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceAcceptInvite + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIAccess := mockService.NewMockIAccess(c)
+			test.mockBehavior(mockIAccess, token, user)
+
+			services := &service.Service{IAccess: mockIAccess}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/invites/"+token, bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package route
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/alexm24/golang/internal/handler/api"
@@ -49,3 +50,28 @@ func (c *Route) PostUserGetToken(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(item)
 }
+
+func (c *Route) PostUserGetChannelToken(w http.ResponseWriter, r *http.Request, channel string) {
+	var username api.SUsername
+	if err := json.NewDecoder(r.Body).Decode(&username); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+	if username.Username == nil {
+		newErrorResponse(w, http.StatusBadRequest, models.MsgUsernameEmpty, models.MsgUsernameEmpty)
+		return
+	}
+
+	item, err := c.service.IAdmin.GetChannelToken(username, channel)
+	switch {
+	case errors.Is(err, models.ErrNoAccess), errors.Is(err, models.ErrNotChatModerator):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetChannelToken)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
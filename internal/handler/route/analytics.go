@@ -0,0 +1,56 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) GetBroadcastAnalytics(
+	w http.ResponseWriter, _ *http.Request, id types.UUID, params api.GetBroadcastAnalyticsParams) {
+	item, err := c.service.IAnalytics.GetAnalytics(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetAnalytics)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+func (c *Route) GetBroadcastAnalyticsSeries(
+	w http.ResponseWriter, _ *http.Request, id types.UUID, params api.GetBroadcastAnalyticsSeriesParams) {
+	bucket, err := models.ParseBucket(params.Bucket)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	items, err := c.service.IAnalytics.GetAnalyticsSeries(id, params.Username, bucket)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetAnalyticsSeries)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(items)
+}
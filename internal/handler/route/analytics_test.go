@@ -0,0 +1,203 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func TestRoute_GetBroadcastAnalytics(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIAnalytics, id uuid.UUID, actor *string)
+
+	id := uuid.New()
+	actor := "owner"
+
+	item := models.Analytics{
+		Messages:      10,
+		Questions:     2,
+		Chatters:      3,
+		Reactions:     4,
+		ReactionTypes: []models.ReactionCount{{Type: "like", Count: 4}},
+		Confirmed:     5,
+		PeakViewers:   7,
+	}
+
+	jsonItem, _ := json.Marshal(item)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIAnalytics, id uuid.UUID, actor *string) {
+				r.EXPECT().GetAnalytics(id, actor).Return(item, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItem) + "\n",
+		},
+		{
+			name: "Not found",
+			mockBehavior: func(r *mockService.MockIAnalytics, id uuid.UUID, actor *string) {
+				r.EXPECT().GetAnalytics(id, actor).Return(models.Analytics{}, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.ErrBroadcastNotFound.Error() + `"}` + "\n",
+		},
+		{
+			name: "Not owner",
+			mockBehavior: func(r *mockService.MockIAnalytics, id uuid.UUID, actor *string) {
+				r.EXPECT().GetAnalytics(id, actor).Return(models.Analytics{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.ErrNotOwner.Error() + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIAnalytics, id uuid.UUID, actor *string) {
+				r.EXPECT().GetAnalytics(id, actor).Return(models.Analytics{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetAnalytics + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIAnalytics := mockService.NewMockIAnalytics(c)
+			test.mockBehavior(mockIAnalytics, id, &actor)
+
+			services := &service.Service{IAnalytics: mockIAnalytics}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/broadcasts/"+id.String()+"/analytics?username="+actor, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_GetBroadcastAnalyticsSeries(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIAnalytics, id uuid.UUID, actor *string)
+
+	id := uuid.New()
+	actor := "owner"
+
+	items := []models.AnalyticsBucket{
+		{Time: time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC), Messages: 3, Questions: 1, Reactions: 2, Viewers: 5},
+		{Time: time.Date(2023, 5, 1, 10, 15, 0, 0, time.UTC), Messages: 1, Viewers: 4},
+	}
+
+	jsonItems, _ := json.Marshal(items)
+
+	tests := []struct {
+		name                 string
+		query                string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:  "Ok",
+			query: "&bucket=15m",
+			mockBehavior: func(r *mockService.MockIAnalytics, id uuid.UUID, actor *string) {
+				r.EXPECT().GetAnalyticsSeries(id, actor, 15*time.Minute).Return(items, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItems) + "\n",
+		},
+		{
+			name: "Default bucket",
+			mockBehavior: func(r *mockService.MockIAnalytics, id uuid.UUID, actor *string) {
+				r.EXPECT().GetAnalyticsSeries(id, actor, models.DefaultBucket).Return(items, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItems) + "\n",
+		},
+		{
+			name:                 "Invalid bucket",
+			query:                "&bucket=90s",
+			mockBehavior:         func(r *mockService.MockIAnalytics, id uuid.UUID, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidBucket + `"}` + "\n",
+		},
+		{
+			name: "Not owner",
+			mockBehavior: func(r *mockService.MockIAnalytics, id uuid.UUID, actor *string) {
+				r.EXPECT().GetAnalyticsSeries(id, actor, models.DefaultBucket).Return(nil, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.ErrNotOwner.Error() + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIAnalytics, id uuid.UUID, actor *string) {
+				r.EXPECT().GetAnalyticsSeries(id, actor, models.DefaultBucket).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetAnalyticsSeries + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIAnalytics := mockService.NewMockIAnalytics(c)
+			test.mockBehavior(mockIAnalytics, id, &actor)
+
+			services := &service.Service{IAnalytics: mockIAnalytics}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet,
+				"/broadcasts/"+id.String()+"/analytics/series?username="+actor+test.query, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package route
 
 import (
 	"encoding/json"
+	"errors"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/deepmap/oapi-codegen/pkg/types"
@@ -10,29 +12,58 @@ import (
 	"github.com/alexm24/golang/internal/models"
 )
 
-func (c *Route) GetBroadcasts(w http.ResponseWriter, _ *http.Request) {
-	items, err := c.service.IBroadcasts.GetBroadcasts()
+func (c *Route) GetBroadcasts(w http.ResponseWriter, r *http.Request, params api.GetBroadcastsParams) {
+	filter, err := models.NewBroadcastFilter(
+		params.Owner, params.From, params.To, params.Sort, params.Order, params.Cursor, params.Limit)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+	if params.Life != nil {
+		if err = filter.SetLife(*params.Life); err != nil {
+			newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+			return
+		}
+	}
+	if params.Tags != nil {
+		if err = filter.SetTags(*params.Tags); err != nil {
+			newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+			return
+		}
+	}
+	filter.Viewer = params.Username
+
+	page, err := c.service.IBroadcasts.GetBroadcasts(filter)
 	if err != nil {
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetBroadcasts)
 		return
 	}
 
+	setPageHeaders(w, r, page.Total, page.NextCursor)
+	setFacetsHeader(w, page.Facets)
 	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(items)
+	_ = json.NewEncoder(w).Encode(page.Body())
 }
 
-func (c *Route) GetBroadcastById(w http.ResponseWriter, _ *http.Request, id types.UUID) {
-	item, err := c.service.IBroadcasts.GetBroadcastById(id)
-	if err != nil {
+func (c *Route) GetBroadcastById(w http.ResponseWriter, _ *http.Request, id types.UUID, params api.GetBroadcastByIdParams) {
+	item, err := c.service.IBroadcasts.GetBroadcastById(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetBroadcastById)
 		return
 	}
 
+	if item.Id != nil {
+		w.Header().Set("ETag", models.ETag(item.Version))
+	}
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(item)
 }
 
-func (c *Route) PostBroadcasts(w http.ResponseWriter, r *http.Request) {
+func (c *Route) PostBroadcasts(w http.ResponseWriter, r *http.Request, params api.PostBroadcastsParams) {
 	var item models.PostBroadcast
 	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
 		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
@@ -44,8 +75,20 @@ func (c *Route) PostBroadcasts(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	broadcast, err := c.service.IBroadcasts.CreateBroadcast(item)
-	if err != nil {
+	var conflict *models.ConflictError
+	force := params.Force != nil && *params.Force
+	broadcast, err := c.service.IBroadcasts.CreateBroadcast(item, params.Username, force)
+	switch {
+	case errors.As(err, &conflict):
+		newConflictResponse(w, conflict)
+		return
+	case errors.Is(err, models.ErrNotAdmin):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrUnknownTag):
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgUnknownTag)
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceCreateBroadcast)
 		return
 	}
@@ -54,7 +97,20 @@ func (c *Route) PostBroadcasts(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(broadcast)
 }
 
-func (c *Route) PostUserGetBroadcastArch(w http.ResponseWriter, r *http.Request) {
+func (c *Route) PostUserGetBroadcastArch(w http.ResponseWriter, r *http.Request, params api.PostUserGetBroadcastArchParams) {
+	filter, err := models.NewArchFilter(
+		params.Owner, params.From, params.To, params.Sort, params.Order, params.Cursor, params.Limit)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+	if params.Tags != nil {
+		if err = filter.SetTags(*params.Tags); err != nil {
+			newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+			return
+		}
+	}
+
 	var user api.SUsername
 	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
 		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
@@ -65,19 +121,73 @@ func (c *Route) PostUserGetBroadcastArch(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	items, err := c.service.IBroadcasts.GetArchBroadcasts(user)
+	page, err := c.service.IBroadcasts.GetArchBroadcasts(user, filter)
 	if err != nil {
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetArchBroadcasts)
 		return
 	}
 
+	setPageHeaders(w, r, page.Total, page.NextCursor)
+	setFacetsHeader(w, page.Facets)
 	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(page.Body())
+}
+
+func (c *Route) GetSeriesById(w http.ResponseWriter, _ *http.Request, id types.UUID, params api.GetSeriesByIdParams) {
+	items, err := c.service.IBroadcasts.GetSeriesById(id, params.Username)
+	if err != nil {
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetSeriesById)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(items)
 }
 
-func (c *Route) DeleteBroadcast(w http.ResponseWriter, _ *http.Request, id types.UUID) {
-	item, err := c.service.IBroadcasts.DeleteBroadcast(id)
+func (c *Route) RotateStreamKey(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	var user api.SUsername
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+	if user.Username == nil {
+		newErrorResponse(w, http.StatusBadRequest, models.MsgUsernameEmpty, models.MsgUsernameEmpty)
+		return
+	}
+
+	item, err := c.service.IBroadcasts.RotateStreamKey(id, user)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceRotateStreamKey)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+func (c *Route) DeleteBroadcast(w http.ResponseWriter, _ *http.Request, id types.UUID, params api.DeleteBroadcastParams) {
+	scope, err := models.ParseScope(params.Scope)
 	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	item, err := c.service.IBroadcasts.DeleteBroadcast(id, scope, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceDeleteBroadcast)
 		return
 	}
@@ -86,7 +196,70 @@ func (c *Route) DeleteBroadcast(w http.ResponseWriter, _ *http.Request, id types
 	_ = json.NewEncoder(w).Encode(item)
 }
 
-func (c *Route) PutBroadcast(w http.ResponseWriter, r *http.Request) {
+func (c *Route) PatchBroadcast(w http.ResponseWriter, r *http.Request, id types.UUID, params api.PatchBroadcastParams) {
+	version, err := models.ParseIfMatch(params.IfMatch)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+
+	patch, err := models.ParsePatchBroadcast(data)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	var conflict *models.ConflictError
+	force := params.Force != nil && *params.Force
+	item, err := c.service.IBroadcasts.PatchBroadcast(id, patch, params.Username, version, force)
+	switch {
+	case errors.As(err, &conflict):
+		newConflictResponse(w, conflict)
+		return
+	case errors.Is(err, models.ErrNotAdmin), errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrEndTimeBeforeStart), errors.Is(err, models.ErrInvalidCapacity),
+		errors.Is(err, models.ErrInvalidVisibility):
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrVersionMismatch):
+		w.Header().Set("ETag", models.ETag(item.Version))
+		w.WriteHeader(http.StatusPreconditionFailed)
+		_ = json.NewEncoder(w).Encode(item)
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServicePatchBroadcast)
+		return
+	}
+
+	w.Header().Set("ETag", models.ETag(item.Version))
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+func (c *Route) PutBroadcast(w http.ResponseWriter, r *http.Request, params api.PutBroadcastParams) {
+	scope, err := models.ParseScope(params.Scope)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	version, err := models.ParseIfMatch(params.IfMatch)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
 	var item models.PutBroadcast
 	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
 		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
@@ -98,12 +271,35 @@ func (c *Route) PutBroadcast(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	broadcast, err := c.service.IBroadcasts.ChangeBroadcast(item)
-	if err != nil {
+	var conflict *models.ConflictError
+	force := params.Force != nil && *params.Force
+	broadcast, err := c.service.IBroadcasts.ChangeBroadcast(item, scope, params.Username, version, force)
+	switch {
+	case errors.As(err, &conflict):
+		newConflictResponse(w, conflict)
+		return
+	case errors.Is(err, models.ErrNotAdmin), errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrVersionMismatch):
+		w.Header().Set("ETag", models.ETag(broadcast.Version))
+		w.WriteHeader(http.StatusPreconditionFailed)
+		_ = json.NewEncoder(w).Encode(broadcast)
+		return
+	case errors.Is(err, models.ErrUnknownTag):
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgUnknownTag)
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceChangeBroadcast)
 		return
 	}
 
+	if broadcast.Id != nil {
+		w.Header().Set("ETag", models.ETag(broadcast.Version))
+	}
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(broadcast)
 }
@@ -48,26 +48,149 @@ func TestRoute_GetBroadcasts(t *testing.T) {
 		},
 	}
 
-	jsonBroadcasts, _ := json.Marshal(broadcasts)
+	filter := models.BroadcastFilter{
+		Sort:  models.SortStartTime,
+		Order: models.OrderAsc,
+		Limit: models.DefaultPageLimit,
+	}
+
+	limit := 1
+	filterOwner := filter
+	filterOwner.Owner = &owner
+	filterOwner.Sort = models.SortName
+	filterOwner.Order = models.OrderDesc
+	filterOwner.Limit = limit
+	filterOwner.Life = []models.LifeCycleBroadcast{models.OnAir}
+	next := filterOwner.NextCursor(broadcasts[0])
+
+	filterCursor := filter
+	filterCursor.Sort = models.SortName
+	filterCursor.Order = models.OrderDesc
+	filterCursor.Cursor = &next
+
+	filterViewer := filter
+	filterViewer.Viewer = &owner
+
+	filterTags := filter
+	filterTags.Tags = []string{"demo", "training"}
+	facets := []models.Facet{{Tag: "demo", Count: 1}, {Tag: "training", Count: 1}}
+
+	body := func(page models.BroadcastsPage) string {
+		data, _ := json.Marshal(page.Body())
+		return string(data) + "\n"
+	}
 
 	tests := []struct {
 		name                 string
+		query                string
 		mockBehavior         mockBehavior
 		expectedStatusCode   int
+		expectedTotal        string
+		expectedLink         string
+		expectedFacets       string
 		expectedResponseBody string
 	}{
 		{
-			name: "Ok",
+			name:  "Ok",
+			query: "",
 			mockBehavior: func(r *mockService.MockIBroadcasts) {
-				r.EXPECT().GetBroadcasts().Return(broadcasts, nil)
+				r.EXPECT().GetBroadcasts(filter).Return(models.BroadcastsPage{Items: broadcasts, Total: 1}, nil)
 			},
 			expectedStatusCode:   200,
-			expectedResponseBody: string(jsonBroadcasts) + "\n",
+			expectedTotal:        "1",
+			expectedResponseBody: body(models.BroadcastsPage{Items: broadcasts, Total: 1}),
 		},
 		{
-			name: "Service failure",
+			name:  "Filter and next page",
+			query: "?owner=test&life=live&sort=name&order=desc&limit=1",
+			mockBehavior: func(r *mockService.MockIBroadcasts) {
+				r.EXPECT().GetBroadcasts(filterOwner).
+					Return(models.BroadcastsPage{Items: broadcasts, Total: 2, NextCursor: &next}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedTotal:        "2",
+			expectedLink:         `</broadcasts?cursor=` + next.Encode() + `&life=live&limit=1&order=desc&owner=test&sort=name>; rel="next"`,
+			expectedResponseBody: body(models.BroadcastsPage{Items: broadcasts, Total: 2, NextCursor: &next}),
+		},
+		{
+			name:  "Cursor",
+			query: "?sort=name&order=desc&cursor=" + next.Encode(),
 			mockBehavior: func(r *mockService.MockIBroadcasts) {
-				r.EXPECT().GetBroadcasts().Return(broadcasts, errors.New("error"))
+				r.EXPECT().GetBroadcasts(filterCursor).Return(models.BroadcastsPage{Items: broadcasts, Total: 2}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedTotal:        "2",
+			expectedResponseBody: body(models.BroadcastsPage{Items: broadcasts, Total: 2}),
+		},
+		{
+			name:  "Viewer",
+			query: "?username=test",
+			mockBehavior: func(r *mockService.MockIBroadcasts) {
+				r.EXPECT().GetBroadcasts(filterViewer).Return(models.BroadcastsPage{Items: broadcasts, Total: 1}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedTotal:        "1",
+			expectedResponseBody: body(models.BroadcastsPage{Items: broadcasts, Total: 1}),
+		},
+		{
+			name:  "Tags and facets",
+			query: "?tags=training,demo",
+			mockBehavior: func(r *mockService.MockIBroadcasts) {
+				r.EXPECT().GetBroadcasts(filterTags).
+					Return(models.BroadcastsPage{Items: broadcasts, Total: 1, Facets: facets}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedTotal:        "1",
+			expectedFacets:       "demo=1, training=1",
+			expectedResponseBody: body(models.BroadcastsPage{Items: broadcasts, Total: 1, Facets: facets}),
+		},
+		{
+			name:                 "Invalid tag",
+			query:                "?tags=Demo",
+			mockBehavior:         func(r *mockService.MockIBroadcasts) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidTag + `"}` + "\n",
+		},
+		{
+			name:                 "Cursor of another sort",
+			query:                "?cursor=" + next.Encode(),
+			mockBehavior:         func(r *mockService.MockIBroadcasts) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidCursor + `"}` + "\n",
+		},
+		{
+			name:                 "Cursor of another order",
+			query:                "?sort=name&cursor=" + next.Encode(),
+			mockBehavior:         func(r *mockService.MockIBroadcasts) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidCursor + `"}` + "\n",
+		},
+		{
+			name:                 "Invalid sort",
+			query:                "?sort=owner",
+			mockBehavior:         func(r *mockService.MockIBroadcasts) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidSort + `"}` + "\n",
+		},
+		{
+			name:                 "Invalid limit",
+			query:                "?limit=1000",
+			mockBehavior:         func(r *mockService.MockIBroadcasts) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidLimit + `"}` + "\n",
+		},
+		{
+			name:                 "Invalid life",
+			query:                "?life=deleted",
+			mockBehavior:         func(r *mockService.MockIBroadcasts) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidLife + `"}` + "\n",
+		},
+		{
+			name:  "Service failure",
+			query: "",
+			mockBehavior: func(r *mockService.MockIBroadcasts) {
+				r.EXPECT().GetBroadcasts(filter).Return(models.BroadcastsPage{}, errors.New("error"))
 			},
 			expectedStatusCode:   500,
 			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetBroadcasts + `"}` + "\n",
@@ -89,11 +212,11 @@ func TestRoute_GetBroadcasts(t *testing.T) {
 			// Init Endpoint
 			r := chi.NewRouter()
 			path := "/broadcasts"
-			r.Get(path, handler.GetBroadcasts)
+			r.Mount("/", api.Handler(&handler))
 
 			// Create Request
 			w := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req := httptest.NewRequest(http.MethodGet, path+test.query, nil)
 
 			// Make Request
 			r.ServeHTTP(w, req)
@@ -101,7 +224,9 @@ func TestRoute_GetBroadcasts(t *testing.T) {
 			// Assert
 			assert.Equal(t, w.Code, test.expectedStatusCode)
 			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
-
+			assert.Equal(t, w.Header().Get("X-Total-Count"), test.expectedTotal)
+			assert.Equal(t, w.Header().Get("Link"), test.expectedLink)
+			assert.Equal(t, w.Header().Get("X-Facets"), test.expectedFacets)
 		})
 	}
 
@@ -139,10 +264,20 @@ func TestRoute_PostUserGetBroadcastArch(t *testing.T) {
 		},
 	}
 
-	jsonBroadcasts, _ := json.Marshal(broadcasts)
+	filter := models.BroadcastFilter{
+		Sort:  models.SortStartTime,
+		Order: models.OrderDesc,
+		Limit: models.DefaultPageLimit,
+	}
+	ascFilter := filter
+	ascFilter.Order = models.OrderAsc
+	page := models.BroadcastsPage{Items: broadcasts, Total: 1}
+
+	jsonPage, _ := json.Marshal(page.Body())
 
 	tests := []struct {
 		name                 string
+		query                string
 		inputBody            string
 		inputUser            api.SUsername
 		mockBehavior         mockBehavior
@@ -151,28 +286,61 @@ func TestRoute_PostUserGetBroadcastArch(t *testing.T) {
 	}{
 		{
 			name:      "Ok",
+			query:     "?order=desc",
 			inputBody: string(jsonUsername),
 			inputUser: username,
 			mockBehavior: func(r *mockService.MockIBroadcasts, u api.SUsername) {
-				r.EXPECT().GetArchBroadcasts(u).Return(broadcasts, nil)
+				r.EXPECT().GetArchBroadcasts(u, filter).Return(page, nil)
 			},
 			expectedStatusCode:   201,
-			expectedResponseBody: string(jsonBroadcasts) + "\n",
+			expectedResponseBody: string(jsonPage) + "\n",
+		},
+		{
+			name:      "Newest first by default",
+			inputBody: string(jsonUsername),
+			inputUser: username,
+			mockBehavior: func(r *mockService.MockIBroadcasts, u api.SUsername) {
+				r.EXPECT().GetArchBroadcasts(u, filter).Return(page, nil)
+			},
+			expectedStatusCode:   201,
+			expectedResponseBody: string(jsonPage) + "\n",
+		},
+		{
+			name:      "Oldest first",
+			query:     "?order=asc",
+			inputBody: string(jsonUsername),
+			inputUser: username,
+			mockBehavior: func(r *mockService.MockIBroadcasts, u api.SUsername) {
+				r.EXPECT().GetArchBroadcasts(u, ascFilter).Return(page, nil)
+			},
+			expectedStatusCode:   201,
+			expectedResponseBody: string(jsonPage) + "\n",
 		},
 		{
 			name:                 "Username field is empty",
+			query:                "?order=desc",
 			inputBody:            `{}`,
 			inputUser:            api.SUsername{},
 			mockBehavior:         func(r *mockService.MockIBroadcasts, username api.SUsername) {},
 			expectedStatusCode:   400,
 			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgUsernameEmpty + `"}` + "\n",
 		},
+		{
+			name:                 "Invalid order",
+			query:                "?order=up",
+			inputBody:            string(jsonUsername),
+			inputUser:            username,
+			mockBehavior:         func(r *mockService.MockIBroadcasts, username api.SUsername) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidOrder + `"}` + "\n",
+		},
 		{
 			name:      "Service failure",
+			query:     "?order=desc",
 			inputBody: string(jsonUsername),
 			inputUser: username,
 			mockBehavior: func(r *mockService.MockIBroadcasts, u api.SUsername) {
-				r.EXPECT().GetArchBroadcasts(u).Return(broadcasts, errors.New("error"))
+				r.EXPECT().GetArchBroadcasts(u, filter).Return(page, errors.New("error"))
 			},
 			expectedStatusCode:   500,
 			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetArchBroadcasts + `"}` + "\n",
@@ -194,11 +362,11 @@ func TestRoute_PostUserGetBroadcastArch(t *testing.T) {
 			// Init Endpoint
 			r := chi.NewRouter()
 			path := "/broadcasts/arch"
-			r.Post(path, handler.PostUserGetBroadcastArch)
+			r.Mount("/", api.Handler(&handler))
 
 			// Create Request
 			w := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(test.inputBody))
+			req := httptest.NewRequest(http.MethodPost, path+test.query, bytes.NewBufferString(test.inputBody))
 
 			// Make Request
 			r.ServeHTTP(w, req)
@@ -268,31 +436,64 @@ func TestRoute_PostBroadcasts(t *testing.T) {
 		StreamKey:   &streamKey,
 	}
 
-	broadcastWithoutStreamKey := models.PostBroadcast{
+	broadcastWithoutStartTime := models.PostBroadcast{
 		Description: &desc,
 		Name:        &name,
 		Owner:       &owner,
-		StartTime:   &sb,
+		StreamKey:   &streamKey,
 	}
 
-	broadcastWithoutStartTime := models.PostBroadcast{
+	eb := sb.Add(-time.Hour)
+	broadcastEndBeforeStart := models.PostBroadcast{
 		Description: &desc,
 		Name:        &name,
 		Owner:       &owner,
+		StartTime:   &sb,
+		EndTime:     &eb,
 		StreamKey:   &streamKey,
 	}
 
 	jsonBroadcastWithoutName, _ := json.Marshal(broadcastWithoutName)
 	jsonBroadcastWithoutDesc, _ := json.Marshal(broadcastWithoutDesc)
 	jsonBroadcastWithoutOwner, _ := json.Marshal(broadcastWithoutOwner)
-	jsonBroadcastWithoutStreamKey, _ := json.Marshal(broadcastWithoutStreamKey)
 	jsonBroadcastWithoutStartTime, _ := json.Marshal(broadcastWithoutStartTime)
+	jsonBroadcastEndBeforeStart, _ := json.Marshal(broadcastEndBeforeStart)
+
+	hidden := "hidden"
+	broadcastInvalidVisibility := models.PostBroadcast{
+		Description: &desc,
+		Name:        &name,
+		Owner:       &owner,
+		StartTime:   &sb,
+		Visibility:  &hidden,
+	}
+	jsonBroadcastInvalidVisibility, _ := json.Marshal(broadcastInvalidVisibility)
+
+	tags := []string{"demo"}
+	broadcastWithTags := broadcast
+	broadcastWithTags.Tags = &tags
+	jsonBroadcastWithTags, _ := json.Marshal(broadcastWithTags)
+
+	invalidTags := []string{"Demo Day"}
+	broadcastInvalidTags := broadcast
+	broadcastInvalidTags.Tags = &invalidTags
+	jsonBroadcastInvalidTags, _ := json.Marshal(broadcastInvalidTags)
 
 	jsonBroadcast, _ := json.Marshal(broadcast)
 	jsonResBroadcast, _ := json.Marshal(resBroadcast)
 
+	admin := "admin"
+	conflictId := uuid.New()
+	conflictEnd := sb.Add(time.Hour)
+	conflict := &models.ConflictError{Conflicts: []api.SConflictItem{
+		{Id: &conflictId, Name: &name, Owner: &owner, StartTime: &sb, EndTime: &conflictEnd},
+	}}
+	conflictCode, conflictMsg := 409, models.MsgScheduleConflict
+	jsonConflict, _ := json.Marshal(api.SConflict{Code: &conflictCode, Message: &conflictMsg, Conflicts: &conflict.Conflicts})
+
 	tests := []struct {
 		name                 string
+		query                string
 		inputBody            string
 		inputBroadcast       models.PostBroadcast
 		mockBehavior         mockBehavior
@@ -304,7 +505,7 @@ func TestRoute_PostBroadcasts(t *testing.T) {
 			inputBody:      string(jsonBroadcast),
 			inputBroadcast: broadcast,
 			mockBehavior: func(r *mockService.MockIBroadcasts, i models.PostBroadcast) {
-				r.EXPECT().CreateBroadcast(i).Return(resBroadcast, nil)
+				r.EXPECT().CreateBroadcast(i, nil, false).Return(resBroadcast, nil)
 			},
 			expectedStatusCode:   200,
 			expectedResponseBody: string(jsonResBroadcast) + "\n",
@@ -314,11 +515,61 @@ func TestRoute_PostBroadcasts(t *testing.T) {
 			inputBody:      string(jsonBroadcast),
 			inputBroadcast: broadcast,
 			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PostBroadcast) {
-				r.EXPECT().CreateBroadcast(b).Return(resBroadcast, errors.New("error"))
+				r.EXPECT().CreateBroadcast(b, nil, false).Return(resBroadcast, errors.New("error"))
 			},
 			expectedStatusCode:   500,
 			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceCreateBroadcast + `"}` + "\n",
 		},
+		{
+			name:           "Unknown tag",
+			inputBody:      string(jsonBroadcastWithTags),
+			inputBroadcast: broadcastWithTags,
+			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PostBroadcast) {
+				r.EXPECT().CreateBroadcast(b, nil, false).Return(models.Broadcasts{}, models.ErrUnknownTag)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgUnknownTag + `"}` + "\n",
+		},
+		{
+			name:           "Schedule conflict",
+			inputBody:      string(jsonBroadcast),
+			inputBroadcast: broadcast,
+			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PostBroadcast) {
+				r.EXPECT().CreateBroadcast(b, nil, false).Return(models.Broadcasts{}, conflict)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: string(jsonConflict) + "\n",
+		},
+		{
+			name:           "Forced by admin",
+			query:          "?username=admin&force=true",
+			inputBody:      string(jsonBroadcast),
+			inputBroadcast: broadcast,
+			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PostBroadcast) {
+				r.EXPECT().CreateBroadcast(b, &admin, true).Return(resBroadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonResBroadcast) + "\n",
+		},
+		{
+			name:           "Forced by not an admin",
+			query:          "?username=admin&force=true",
+			inputBody:      string(jsonBroadcast),
+			inputBroadcast: broadcast,
+			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PostBroadcast) {
+				r.EXPECT().CreateBroadcast(b, &admin, true).Return(models.Broadcasts{}, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name:                 "Invalid tag",
+			inputBody:            string(jsonBroadcastInvalidTags),
+			inputBroadcast:       broadcastInvalidTags,
+			mockBehavior:         func(r *mockService.MockIBroadcasts, b models.PostBroadcast) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidTag + `"}` + "\n",
+		},
 		{
 			name:                 "Name field is empty",
 			inputBody:            string(jsonBroadcastWithoutName),
@@ -343,14 +594,6 @@ func TestRoute_PostBroadcasts(t *testing.T) {
 			expectedStatusCode:   400,
 			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgOwnerEmpty + `"}` + "\n",
 		},
-		{
-			name:                 "StreamKey field is empty",
-			inputBody:            string(jsonBroadcastWithoutStreamKey),
-			inputBroadcast:       broadcastWithoutStreamKey,
-			mockBehavior:         func(r *mockService.MockIBroadcasts, b models.PostBroadcast) {},
-			expectedStatusCode:   400,
-			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgStreamKeyEmpty + `"}` + "\n",
-		},
 		{
 			name:                 "StartBroadcast field is empty",
 			inputBody:            string(jsonBroadcastWithoutStartTime),
@@ -359,6 +602,22 @@ func TestRoute_PostBroadcasts(t *testing.T) {
 			expectedStatusCode:   400,
 			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgStartTimeEmpty + `"}` + "\n",
 		},
+		{
+			name:                 "Invalid visibility",
+			inputBody:            string(jsonBroadcastInvalidVisibility),
+			inputBroadcast:       broadcastInvalidVisibility,
+			mockBehavior:         func(r *mockService.MockIBroadcasts, b models.PostBroadcast) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidVisibility + `"}` + "\n",
+		},
+		{
+			name:                 "EndTime before StartTime",
+			inputBody:            string(jsonBroadcastEndBeforeStart),
+			inputBroadcast:       broadcastEndBeforeStart,
+			mockBehavior:         func(r *mockService.MockIBroadcasts, b models.PostBroadcast) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgEndTimeBeforeStart + `"}` + "\n",
+		},
 	}
 
 	for _, test := range tests {
@@ -376,11 +635,11 @@ func TestRoute_PostBroadcasts(t *testing.T) {
 			// Init Endpoint
 			r := chi.NewRouter()
 			path := "/broadcasts"
-			r.Post(path, handler.PostBroadcasts)
+			r.Mount("/", api.Handler(&handler))
 
 			// Create Request
 			w := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(test.inputBody))
+			req := httptest.NewRequest(http.MethodPost, path+test.query, bytes.NewBufferString(test.inputBody))
 
 			// Make Request
 			r.ServeHTTP(w, req)
@@ -398,10 +657,12 @@ func TestRoute_DeleteBroadcast(t *testing.T) {
 
 	params := uuid.New()
 	id := api.SIdentifier{Id: &params}
+	admin := "admin"
 
 	jsonId, _ := json.Marshal(id)
 	tests := []struct {
 		name                 string
+		query                string
 		mockBehavior         mockBehavior
 		expectedStatusCode   int
 		expectedResponseBody string
@@ -409,15 +670,57 @@ func TestRoute_DeleteBroadcast(t *testing.T) {
 		{
 			name: "Ok",
 			mockBehavior: func(r *mockService.MockIBroadcasts, i uuid.UUID) {
-				r.EXPECT().DeleteBroadcast(i).Return(id, nil)
+				r.EXPECT().DeleteBroadcast(i, models.ScopeThis, nil).Return(id, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonId) + "\n",
+		},
+		{
+			name:  "Ok following",
+			query: "?scope=following",
+			mockBehavior: func(r *mockService.MockIBroadcasts, i uuid.UUID) {
+				r.EXPECT().DeleteBroadcast(i, models.ScopeFollowing, nil).Return(id, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonId) + "\n",
+		},
+		{
+			name:  "Ok with username",
+			query: "?username=admin",
+			mockBehavior: func(r *mockService.MockIBroadcasts, i uuid.UUID) {
+				r.EXPECT().DeleteBroadcast(i, models.ScopeThis, &admin).Return(id, nil)
 			},
 			expectedStatusCode:   200,
 			expectedResponseBody: string(jsonId) + "\n",
 		},
+		{
+			name:                 "Invalid scope",
+			query:                "?scope=some",
+			mockBehavior:         func(r *mockService.MockIBroadcasts, i uuid.UUID) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidScope + `"}` + "\n",
+		},
+		{
+			name: "Not owner",
+			mockBehavior: func(r *mockService.MockIBroadcasts, i uuid.UUID) {
+				r.EXPECT().DeleteBroadcast(i, models.ScopeThis, nil).Return(api.SIdentifier{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name:  "Broadcast not found",
+			query: "?username=admin",
+			mockBehavior: func(r *mockService.MockIBroadcasts, i uuid.UUID) {
+				r.EXPECT().DeleteBroadcast(i, models.ScopeThis, &admin).Return(api.SIdentifier{}, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
 		{
 			name: "Service failure",
 			mockBehavior: func(r *mockService.MockIBroadcasts, i uuid.UUID) {
-				r.EXPECT().DeleteBroadcast(i).Return(id, errors.New("error"))
+				r.EXPECT().DeleteBroadcast(i, models.ScopeThis, nil).Return(id, errors.New("error"))
 			},
 			expectedStatusCode:   500,
 			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceDeleteBroadcast + `"}` + "\n",
@@ -439,15 +742,11 @@ func TestRoute_DeleteBroadcast(t *testing.T) {
 			// Init Endpoint
 			r := chi.NewRouter()
 			path := "/broadcasts/" + params.String()
-			r.Delete("/broadcasts/{id}", func(w http.ResponseWriter, r *http.Request) {
-				ID := chi.URLParam(r, "id")
-				uuID, _ := uuid.Parse(ID)
-				handler.DeleteBroadcast(w, r, uuID)
-			})
+			r.Mount("/", api.Handler(&handler))
 
 			// Create Request
 			w := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodDelete, path, nil)
+			req := httptest.NewRequest(http.MethodDelete, path+test.query, nil)
 
 			// Make Request
 			r.ServeHTTP(w, req)
@@ -460,6 +759,179 @@ func TestRoute_DeleteBroadcast(t *testing.T) {
 
 }
 
+func TestRoute_PatchBroadcast(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIBroadcasts, id uuid.UUID)
+
+	id := uuid.New()
+	name := "test"
+	owner := "test"
+	version := 2
+
+	broadcast := models.Broadcasts{
+		SIdentifier: api.SIdentifier{Id: &id},
+		SBroadcast:  api.SBroadcast{Name: &name, Owner: &owner},
+	}
+
+	jsonBroadcast, _ := json.Marshal(broadcast)
+
+	tests := []struct {
+		name                 string
+		query                string
+		ifMatch              string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"name":"test"}`,
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().PatchBroadcast(id, models.PatchBroadcast{Name: &name}, nil, nil, false).Return(broadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:      "Ok remove end time",
+			query:     "?username=test",
+			ifMatch:   `"2"`,
+			inputBody: `{"end_time":null}`,
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().PatchBroadcast(id, models.PatchBroadcast{ClearEndTime: true}, &owner, &version, false).
+					Return(broadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:      "Not owner",
+			inputBody: `{"name":"test"}`,
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().PatchBroadcast(id, models.PatchBroadcast{Name: &name}, nil, nil, false).
+					Return(models.Broadcasts{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name:      "Schedule conflict",
+			inputBody: `{"name":"test"}`,
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().PatchBroadcast(id, models.PatchBroadcast{Name: &name}, nil, nil, false).
+					Return(models.Broadcasts{}, &models.ConflictError{Conflicts: []api.SConflictItem{}})
+			},
+			expectedStatusCode: 409,
+			expectedResponseBody: `{"code":` + "409" + `,"conflicts":[],"message":"` + models.MsgScheduleConflict + `"}` +
+				"\n",
+		},
+		{
+			name:                 "Invalid json",
+			inputBody:            `{"name":`,
+			mockBehavior:         func(r *mockService.MockIBroadcasts, id uuid.UUID) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidPatch + `"}` + "\n",
+		},
+		{
+			name:                 "Read-only field",
+			inputBody:            `{"owner":"other"}`,
+			mockBehavior:         func(r *mockService.MockIBroadcasts, id uuid.UUID) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidPatch + `: owner"}` + "\n",
+		},
+		{
+			name:                 "Required field removed",
+			inputBody:            `{"name":null}`,
+			mockBehavior:         func(r *mockService.MockIBroadcasts, id uuid.UUID) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidPatch + `: name can not be removed"}` + "\n",
+		},
+		{
+			name:                 "Empty patch",
+			inputBody:            `{}`,
+			mockBehavior:         func(r *mockService.MockIBroadcasts, id uuid.UUID) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgEmptyPatch + `"}` + "\n",
+		},
+		{
+			name:      "End time before start",
+			inputBody: `{"name":"test"}`,
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().PatchBroadcast(id, models.PatchBroadcast{Name: &name}, nil, nil, false).
+					Return(models.Broadcasts{}, models.ErrEndTimeBeforeStart)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgEndTimeBeforeStart + `"}` + "\n",
+		},
+		{
+			name:      "Broadcast not found",
+			inputBody: `{"name":"test"}`,
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().PatchBroadcast(id, models.PatchBroadcast{Name: &name}, nil, nil, false).
+					Return(models.Broadcasts{}, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
+		{
+			name:      "Version mismatch",
+			ifMatch:   `"2"`,
+			inputBody: `{"name":"test"}`,
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().PatchBroadcast(id, models.PatchBroadcast{Name: &name}, nil, &version, false).
+					Return(broadcast, models.ErrVersionMismatch)
+			},
+			expectedStatusCode:   412,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: `{"name":"test"}`,
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().PatchBroadcast(id, models.PatchBroadcast{Name: &name}, nil, nil, false).
+					Return(models.Broadcasts{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServicePatchBroadcast + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIBroadcasts := mockService.NewMockIBroadcasts(c)
+			test.mockBehavior(mockIBroadcasts, id)
+
+			services := &service.Service{IBroadcasts: mockIBroadcasts}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			path := "/broadcasts/" + id.String() + test.query
+			req := httptest.NewRequest(http.MethodPatch, path, bytes.NewBufferString(test.inputBody))
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+			if len(test.ifMatch) > 0 {
+				req.Header.Set("If-Match", test.ifMatch)
+			}
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
 func TestRoute_PutBroadcast(t *testing.T) {
 	//Init Test Table
 	type mockBehavior func(r *mockService.MockIBroadcasts, b models.PutBroadcast)
@@ -510,13 +982,6 @@ func TestRoute_PutBroadcast(t *testing.T) {
 		StartTime:   &sb,
 		StreamKey:   &streamKey,
 	}
-	putBroadcastWithoutStreamKey := models.PutBroadcast{
-		Description: &desc,
-		Id:          &id,
-		Name:        &name,
-		Owner:       &owner,
-		StartTime:   &sb,
-	}
 	putBroadcastWithoutStartTime := models.PutBroadcast{
 		Description: &desc,
 		Id:          &id,
@@ -538,6 +1003,8 @@ func TestRoute_PutBroadcast(t *testing.T) {
 		SLifeCycle: api.SLifeCycle{Life: &life},
 	}
 
+	version := 3
+
 	jsonPutBroadcast, _ := json.Marshal(putBroadcast)
 	jsonBroadcast, _ := json.Marshal(broadcast)
 
@@ -545,11 +1012,12 @@ func TestRoute_PutBroadcast(t *testing.T) {
 	jsonPutBroadcastWithoutName, _ := json.Marshal(putBroadcastWithoutName)
 	jsonPutBroadcastWithoutDesc, _ := json.Marshal(putBroadcastWithoutDesc)
 	jsonPutBroadcastWithoutOwner, _ := json.Marshal(putBroadcastWithoutOwner)
-	jsonPutBroadcastWithoutStreamKey, _ := json.Marshal(putBroadcastWithoutStreamKey)
 	jsonPutBroadcastWithoutStartTime, _ := json.Marshal(putBroadcastWithoutStartTime)
 
 	tests := []struct {
 		name                 string
+		query                string
+		ifMatch              string
 		inputBody            string
 		inputBroadcast       models.PutBroadcast
 		mockBehavior         mockBehavior
@@ -561,17 +1029,123 @@ func TestRoute_PutBroadcast(t *testing.T) {
 			inputBody:      string(jsonPutBroadcast),
 			inputBroadcast: putBroadcast,
 			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {
-				r.EXPECT().ChangeBroadcast(b).Return(broadcast, nil)
+				r.EXPECT().ChangeBroadcast(b, models.ScopeThis, nil, nil, false).Return(broadcast, nil)
 			},
 			expectedStatusCode:   200,
 			expectedResponseBody: string(jsonBroadcast) + "\n",
 		},
+		{
+			name:           "Ok all",
+			query:          "?scope=all",
+			inputBody:      string(jsonPutBroadcast),
+			inputBroadcast: putBroadcast,
+			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {
+				r.EXPECT().ChangeBroadcast(b, models.ScopeAll, nil, nil, false).Return(broadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:           "Forced by admin",
+			query:          "?username=" + owner + "&force=true",
+			inputBody:      string(jsonPutBroadcast),
+			inputBroadcast: putBroadcast,
+			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {
+				r.EXPECT().ChangeBroadcast(b, models.ScopeThis, &owner, nil, true).Return(broadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:           "Schedule conflict",
+			inputBody:      string(jsonPutBroadcast),
+			inputBroadcast: putBroadcast,
+			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {
+				r.EXPECT().ChangeBroadcast(b, models.ScopeThis, nil, nil, false).
+					Return(models.Broadcasts{}, &models.ConflictError{Conflicts: []api.SConflictItem{}})
+			},
+			expectedStatusCode: 409,
+			expectedResponseBody: `{"code":` + "409" + `,"conflicts":[],"message":"` + models.MsgScheduleConflict + `"}` +
+				"\n",
+		},
+		{
+			name:           "Ok with username",
+			query:          "?username=" + owner,
+			inputBody:      string(jsonPutBroadcast),
+			inputBroadcast: putBroadcast,
+			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {
+				r.EXPECT().ChangeBroadcast(b, models.ScopeThis, &owner, nil, false).Return(broadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:           "Ok with If-Match",
+			ifMatch:        `"3"`,
+			inputBody:      string(jsonPutBroadcast),
+			inputBroadcast: putBroadcast,
+			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {
+				r.EXPECT().ChangeBroadcast(b, models.ScopeThis, nil, &version, false).Return(broadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:                 "Invalid If-Match",
+			ifMatch:              "some",
+			inputBody:            string(jsonPutBroadcast),
+			inputBroadcast:       putBroadcast,
+			mockBehavior:         func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidIfMatch + `"}` + "\n",
+		},
+		{
+			name:           "Version mismatch",
+			ifMatch:        `"3"`,
+			inputBody:      string(jsonPutBroadcast),
+			inputBroadcast: putBroadcast,
+			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {
+				r.EXPECT().ChangeBroadcast(b, models.ScopeThis, nil, &version, false).Return(broadcast, models.ErrVersionMismatch)
+			},
+			expectedStatusCode:   412,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:                 "Invalid scope",
+			query:                "?scope=some",
+			inputBody:            string(jsonPutBroadcast),
+			inputBroadcast:       putBroadcast,
+			mockBehavior:         func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidScope + `"}` + "\n",
+		},
+		{
+			name:           "Not owner",
+			inputBody:      string(jsonPutBroadcast),
+			inputBroadcast: putBroadcast,
+			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {
+				r.EXPECT().ChangeBroadcast(b, models.ScopeThis, nil, nil, false).Return(models.Broadcasts{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name:           "Broadcast not found",
+			inputBody:      string(jsonPutBroadcast),
+			inputBroadcast: putBroadcast,
+			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {
+				r.EXPECT().ChangeBroadcast(b, models.ScopeThis, nil, nil, false).
+					Return(models.Broadcasts{}, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
 		{
 			name:           "Service failure",
 			inputBody:      string(jsonPutBroadcast),
 			inputBroadcast: putBroadcast,
 			mockBehavior: func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {
-				r.EXPECT().ChangeBroadcast(b).Return(broadcast, errors.New("error"))
+				r.EXPECT().ChangeBroadcast(b, models.ScopeThis, nil, nil, false).Return(broadcast, errors.New("error"))
 			},
 			expectedStatusCode:   500,
 			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceChangeBroadcast + `"}` + "\n",
@@ -608,14 +1182,6 @@ func TestRoute_PutBroadcast(t *testing.T) {
 			expectedStatusCode:   400,
 			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgOwnerEmpty + `"}` + "\n",
 		},
-		{
-			name:                 "StreamKey field is empty",
-			inputBody:            string(jsonPutBroadcastWithoutStreamKey),
-			inputBroadcast:       putBroadcastWithoutStreamKey,
-			mockBehavior:         func(r *mockService.MockIBroadcasts, b models.PutBroadcast) {},
-			expectedStatusCode:   400,
-			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgStreamKeyEmpty + `"}` + "\n",
-		},
 		{
 			name:                 "StartBroadcast field is empty",
 			inputBody:            string(jsonPutBroadcastWithoutStartTime),
@@ -641,11 +1207,14 @@ func TestRoute_PutBroadcast(t *testing.T) {
 			// Init Endpoint
 			r := chi.NewRouter()
 			path := "/broadcasts"
-			r.Post(path, handler.PutBroadcast)
+			r.Mount("/", api.Handler(&handler))
 
 			// Create Request
 			w := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(test.inputBody))
+			req := httptest.NewRequest(http.MethodPut, path+test.query, bytes.NewBufferString(test.inputBody))
+			if len(test.ifMatch) > 0 {
+				req.Header.Set("If-Match", test.ifMatch)
+			}
 
 			// Make Request
 			r.ServeHTTP(w, req)
@@ -663,6 +1232,8 @@ func TestRoute_GetBroadcastById(t *testing.T) {
 	// Init Test Table
 	type mockBehavior func(r *mockService.MockIBroadcasts, id uuid.UUID)
 
+	viewer := "test"
+
 	id := uuid.New()
 	desc := "test"
 	previewUrl := "https://avatar/image/qwerty"
@@ -689,6 +1260,7 @@ func TestRoute_GetBroadcastById(t *testing.T) {
 
 	tests := []struct {
 		name                 string
+		query                string
 		mockBehavior         mockBehavior
 		expectedStatusCode   int
 		expectedResponseBody string
@@ -696,7 +1268,16 @@ func TestRoute_GetBroadcastById(t *testing.T) {
 		{
 			name: "Ok",
 			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
-				r.EXPECT().GetBroadcastById(id).Return(broadcast, nil)
+				r.EXPECT().GetBroadcastById(id, nil).Return(broadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:  "Ok with viewer",
+			query: "?username=" + viewer,
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().GetBroadcastById(id, &viewer).Return(broadcast, nil)
 			},
 			expectedStatusCode:   200,
 			expectedResponseBody: string(jsonBroadcast) + "\n",
@@ -704,11 +1285,20 @@ func TestRoute_GetBroadcastById(t *testing.T) {
 		{
 			name: "Service failure",
 			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
-				r.EXPECT().GetBroadcastById(id).Return(broadcast, errors.New("error"))
+				r.EXPECT().GetBroadcastById(id, nil).Return(broadcast, errors.New("error"))
 			},
 			expectedStatusCode:   500,
 			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetBroadcastById + `"}` + "\n",
 		},
+		{
+			name:  "No access",
+			query: "?username=" + viewer,
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().GetBroadcastById(id, &viewer).Return(models.Broadcasts{}, models.ErrNoAccess)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNoAccess + `"}` + "\n",
+		},
 	}
 
 	for _, test := range tests {
@@ -725,15 +1315,11 @@ func TestRoute_GetBroadcastById(t *testing.T) {
 
 			// Init Endpoint
 			route := chi.NewRouter()
-			route.Get("/broadcasts/{id}", func(w http.ResponseWriter, r *http.Request) {
-				iD := chi.URLParam(r, "id")
-				uuId, _ := uuid.Parse(iD)
-				handler.GetBroadcastById(w, r, uuId)
-			})
+			route.Mount("/", api.Handler(&handler))
 
 			// Create Request
 			w := httptest.NewRecorder()
-			path := "/broadcasts/" + id.String()
+			path := "/broadcasts/" + id.String() + test.query
 			req := httptest.NewRequest(http.MethodGet, path, nil)
 
 			// Make Request
@@ -746,3 +1332,191 @@ func TestRoute_GetBroadcastById(t *testing.T) {
 		})
 	}
 }
+
+func TestRoute_GetSeriesById(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIBroadcasts, id uuid.UUID)
+
+	id := uuid.New()
+	seriesId := uuid.New()
+	life := "created"
+	name := "test"
+	owner := "test"
+	date := time.Now()
+
+	broadcasts := []models.Broadcasts{
+		{
+			SIdentifier: api.SIdentifier{Id: &id},
+			SLifeCycle:  api.SLifeCycle{Life: &life},
+			SStartTime:  api.SStartTime{StartTime: &date},
+			SSeries:     api.SSeries{SeriesId: &seriesId},
+			SBroadcast: api.SBroadcast{
+				Name:  &name,
+				Owner: &owner,
+			},
+		},
+	}
+
+	jsonBroadcasts, _ := json.Marshal(broadcasts)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().GetSeriesById(id, nil).Return(broadcasts, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcasts) + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID) {
+				r.EXPECT().GetSeriesById(id, nil).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetSeriesById + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIBroadcasts := mockService.NewMockIBroadcasts(c)
+			test.mockBehavior(mockIBroadcasts, seriesId)
+
+			services := &service.Service{IBroadcasts: mockIBroadcasts}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			path := "/broadcasts/series/" + seriesId.String()
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_RotateStreamKey(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIBroadcasts, id uuid.UUID, user api.SUsername)
+
+	id := uuid.New()
+	life := "created"
+	name := "test"
+	owner := "test"
+	key := "qwerty"
+
+	user := api.SUsername{Username: &owner}
+	broadcast := models.Broadcasts{
+		SIdentifier: api.SIdentifier{Id: &id},
+		SLifeCycle:  api.SLifeCycle{Life: &life},
+		SBroadcast: api.SBroadcast{
+			Name:      &name,
+			Owner:     &owner,
+			StreamKey: &key,
+		},
+	}
+
+	jsonUser, _ := json.Marshal(user)
+	jsonBroadcast, _ := json.Marshal(broadcast)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID, user api.SUsername) {
+				r.EXPECT().RotateStreamKey(id, user).Return(broadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:                 "Username field is empty",
+			inputBody:            "{}",
+			mockBehavior:         func(r *mockService.MockIBroadcasts, id uuid.UUID, user api.SUsername) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgUsernameEmpty + `"}` + "\n",
+		},
+		{
+			name:      "Broadcast not found",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID, user api.SUsername) {
+				r.EXPECT().RotateStreamKey(id, user).Return(models.Broadcasts{}, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
+		{
+			name:      "Not owner",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID, user api.SUsername) {
+				r.EXPECT().RotateStreamKey(id, user).Return(models.Broadcasts{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIBroadcasts, id uuid.UUID, user api.SUsername) {
+				r.EXPECT().RotateStreamKey(id, user).Return(models.Broadcasts{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceRotateStreamKey + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIBroadcasts := mockService.NewMockIBroadcasts(c)
+			test.mockBehavior(mockIBroadcasts, id, user)
+
+			services := &service.Service{IBroadcasts: mockIBroadcasts}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			path := "/broadcasts/" + id.String() + "/stream_key"
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package route
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) CreateCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	var item models.PostCalendarFeed
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+
+	if err := item.Validate(); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	feed, err := c.service.ICalendar.CreateCalendarFeed(item)
+	if err != nil {
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceCreateCalendarFeed)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(feed)
+}
+
+func (c *Route) GetCalendarFeed(w http.ResponseWriter, _ *http.Request, token string) {
+	item, err := c.service.ICalendar.GetCalendar(token)
+	if err != nil {
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetCalendar)
+		return
+	}
+
+	if len(item) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", models.ContentTypeCalendar)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(item)
+}
@@ -0,0 +1,211 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func TestRoute_CreateCalendarFeed(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockICalendar, item models.PostCalendarFeed)
+
+	username := "test"
+	scopeUser := string(models.CalendarUser)
+	scopeAll := string(models.CalendarAll)
+	scopeInvalid := "some"
+	token := "qwerty"
+
+	postUser := models.PostCalendarFeed{Username: &username, Scope: &scopeUser}
+	postAll := models.PostCalendarFeed{Username: &username, Scope: &scopeAll}
+	postWithoutScope := models.PostCalendarFeed{Username: &username}
+	postWithoutUsername := models.PostCalendarFeed{Scope: &scopeAll}
+	postInvalidScope := models.PostCalendarFeed{Username: &username, Scope: &scopeInvalid}
+
+	feedUser := api.SCalendarFeed{Token: &token, Scope: &scopeUser}
+	feedAll := api.SCalendarFeed{Token: &token, Scope: &scopeAll}
+
+	jsonPostUser, _ := json.Marshal(postUser)
+	jsonPostAll, _ := json.Marshal(postAll)
+	jsonPostWithoutScope, _ := json.Marshal(postWithoutScope)
+	jsonPostWithoutUsername, _ := json.Marshal(postWithoutUsername)
+	jsonPostInvalidScope, _ := json.Marshal(postInvalidScope)
+	jsonFeedUser, _ := json.Marshal(feedUser)
+	jsonFeedAll, _ := json.Marshal(feedAll)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		inputFeed            models.PostCalendarFeed
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonPostAll),
+			inputFeed: postAll,
+			mockBehavior: func(r *mockService.MockICalendar, item models.PostCalendarFeed) {
+				r.EXPECT().CreateCalendarFeed(item).Return(feedAll, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonFeedAll) + "\n",
+		},
+		{
+			name:      "Scope defaults to user",
+			inputBody: string(jsonPostWithoutScope),
+			inputFeed: postUser,
+			mockBehavior: func(r *mockService.MockICalendar, item models.PostCalendarFeed) {
+				r.EXPECT().CreateCalendarFeed(item).Return(feedUser, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonFeedUser) + "\n",
+		},
+		{
+			name:                 "Username field is empty",
+			inputBody:            string(jsonPostWithoutUsername),
+			mockBehavior:         func(r *mockService.MockICalendar, item models.PostCalendarFeed) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgUsernameEmpty + `"}` + "\n",
+		},
+		{
+			name:                 "Invalid scope",
+			inputBody:            string(jsonPostInvalidScope),
+			mockBehavior:         func(r *mockService.MockICalendar, item models.PostCalendarFeed) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidCalendarScope + `"}` + "\n",
+		},
+		{
+			name:                 "Invalid JSON",
+			inputBody:            "{",
+			mockBehavior:         func(r *mockService.MockICalendar, item models.PostCalendarFeed) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidJson + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonPostUser),
+			inputFeed: postUser,
+			mockBehavior: func(r *mockService.MockICalendar, item models.PostCalendarFeed) {
+				r.EXPECT().CreateCalendarFeed(item).Return(api.SCalendarFeed{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceCreateCalendarFeed + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockICalendar := mockService.NewMockICalendar(c)
+			test.mockBehavior(mockICalendar, test.inputFeed)
+
+			services := &service.Service{ICalendar: mockICalendar}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/calendar/feeds", bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_GetCalendarFeed(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockICalendar, token string)
+
+	token := "qwerty"
+	ics := []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n")
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedContentType  string
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockICalendar, token string) {
+				r.EXPECT().GetCalendar(token).Return(ics, nil)
+			},
+			expectedStatusCode:   200,
+			expectedContentType:  models.ContentTypeCalendar,
+			expectedResponseBody: string(ics),
+		},
+		{
+			name: "Unknown token",
+			mockBehavior: func(r *mockService.MockICalendar, token string) {
+				r.EXPECT().GetCalendar(token).Return(nil, nil)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: "",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockICalendar, token string) {
+				r.EXPECT().GetCalendar(token).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetCalendar + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockICalendar := mockService.NewMockICalendar(c)
+			test.mockBehavior(mockICalendar, token)
+
+			services := &service.Service{ICalendar: mockICalendar}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/calendar/"+token+".ics", nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+			if test.expectedContentType != "" {
+				assert.Equal(t, w.Header().Get("Content-Type"), test.expectedContentType)
+			}
+		})
+	}
+}
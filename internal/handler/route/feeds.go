@@ -0,0 +1,38 @@
+package route
+
+import (
+	"net/http"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) GetArchiveAtom(w http.ResponseWriter, _ *http.Request, params api.GetArchiveAtomParams) {
+	c.archiveFeed(w, params.Owner, params.Tags, models.FeedAtom, models.ContentTypeAtom)
+}
+
+func (c *Route) GetArchiveRss(w http.ResponseWriter, _ *http.Request, params api.GetArchiveRssParams) {
+	c.archiveFeed(w, params.Owner, params.Tags, models.FeedRSS, models.ContentTypeRSS)
+}
+
+func (c *Route) archiveFeed(w http.ResponseWriter, owner, tags *string, format models.FeedFormat, contentType string) {
+	filter := models.FeedFilter{Owner: owner}
+	if tags != nil {
+		names, err := models.ParseTags(*tags)
+		if err != nil {
+			newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+			return
+		}
+		filter.Tags = names
+	}
+
+	item, err := c.service.IFeeds.GetArchiveFeed(filter, format)
+	if err != nil {
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetArchiveFeed)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(item)
+}
@@ -0,0 +1,178 @@
+package route
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func TestRoute_GetArchiveAtom(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIFeeds)
+
+	owner := "ivanov"
+	atom := []byte(`<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<feed xmlns="http://www.w3.org/2005/Atom"></feed>`)
+
+	tests := []struct {
+		name                 string
+		query                string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedContentType  string
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIFeeds) {
+				r.EXPECT().GetArchiveFeed(models.FeedFilter{}, models.FeedAtom).Return(atom, nil)
+			},
+			expectedStatusCode:   200,
+			expectedContentType:  models.ContentTypeAtom,
+			expectedResponseBody: string(atom),
+		},
+		{
+			name:  "Owner and tags",
+			query: "?owner=" + owner + "&tags=python,backend,python",
+			mockBehavior: func(r *mockService.MockIFeeds) {
+				filter := models.FeedFilter{Owner: &owner, Tags: []string{"backend", "python"}}
+				r.EXPECT().GetArchiveFeed(filter, models.FeedAtom).Return(atom, nil)
+			},
+			expectedStatusCode:   200,
+			expectedContentType:  models.ContentTypeAtom,
+			expectedResponseBody: string(atom),
+		},
+		{
+			name:                 "Invalid tag",
+			query:                "?tags=Go%20Lang",
+			mockBehavior:         func(r *mockService.MockIFeeds) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidTag + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIFeeds) {
+				r.EXPECT().GetArchiveFeed(models.FeedFilter{}, models.FeedAtom).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetArchiveFeed + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIFeeds := mockService.NewMockIFeeds(c)
+			test.mockBehavior(mockIFeeds)
+
+			services := &service.Service{IFeeds: mockIFeeds}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/feeds/archive.atom"+test.query, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+			if test.expectedContentType != "" {
+				assert.Equal(t, w.Header().Get("Content-Type"), test.expectedContentType)
+			}
+		})
+	}
+}
+
+func TestRoute_GetArchiveRss(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIFeeds)
+
+	tag := "python"
+	rss := []byte(`<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<rss version="2.0"></rss>`)
+
+	tests := []struct {
+		name                 string
+		query                string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedContentType  string
+		expectedResponseBody string
+	}{
+		{
+			name:  "Ok",
+			query: "?tags=" + tag,
+			mockBehavior: func(r *mockService.MockIFeeds) {
+				filter := models.FeedFilter{Tags: []string{tag}}
+				r.EXPECT().GetArchiveFeed(filter, models.FeedRSS).Return(rss, nil)
+			},
+			expectedStatusCode:   200,
+			expectedContentType:  models.ContentTypeRSS,
+			expectedResponseBody: string(rss),
+		},
+		{
+			name:                 "Invalid tag",
+			query:                "?tags=python,",
+			mockBehavior:         func(r *mockService.MockIFeeds) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidTag + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIFeeds) {
+				r.EXPECT().GetArchiveFeed(models.FeedFilter{}, models.FeedRSS).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetArchiveFeed + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIFeeds := mockService.NewMockIFeeds(c)
+			test.mockBehavior(mockIFeeds)
+
+			services := &service.Service{IFeeds: mockIFeeds}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/feeds/archive.rss"+test.query, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+			if test.expectedContentType != "" {
+				assert.Equal(t, w.Header().Get("Content-Type"), test.expectedContentType)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) GetBroadcastFiles(w http.ResponseWriter, _ *http.Request, id types.UUID, params api.GetBroadcastFilesParams) {
+	items, err := c.service.IFiles.GetFiles(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetFiles)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(items)
+}
+
+func (c *Route) PostBroadcastFile(w http.ResponseWriter, r *http.Request, id types.UUID, params api.PostBroadcastFileParams) {
+	maxSize, err := c.service.IFiles.UploadLimit(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceCreateFile)
+		return
+	}
+
+	body, ok := limitUpload(w, r, maxSize)
+	if !ok || !parseUpload(w, r, body, 10<<20) {
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgNoSuchFile)
+		return
+	}
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.MsgReadFile)
+		return
+	}
+
+	fileType, err := models.DetectFileType(header.Filename, data)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, fileType, models.MsgInvalidFileType)
+		return
+	}
+
+	size := int64(len(data))
+	item := models.File{Data: data}
+	item.BroadcastId = &id
+	item.Name = &header.Filename
+	item.Type = &fileType
+	item.Size = &size
+
+	info, err := c.service.IFiles.CreateFile(item, params.Username)
+	switch {
+	case errors.Is(err, models.ErrFileTooLarge):
+		newErrorResponse(w, http.StatusRequestEntityTooLarge, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceCreateFile)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(info)
+}
+
+func (c *Route) GetFileById(w http.ResponseWriter, _ *http.Request, id types.UUID, params api.GetFileByIdParams) {
+	item, err := c.service.IFiles.GetFileById(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetFileById)
+		return
+	}
+
+	if item.Id == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", *item.Type)
+	w.Header().Set("Content-Length", strconv.Itoa(len(item.Data)))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": *item.Name}))
+	_, _ = io.Copy(w, bytes.NewReader(item.Data))
+}
+
+func (c *Route) DeleteFileById(w http.ResponseWriter, _ *http.Request, id types.UUID, params api.DeleteFileByIdParams) {
+	item, err := c.service.IFiles.DeleteFile(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrFileNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceDeleteFile)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
@@ -0,0 +1,442 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func newFileRequest(t *testing.T, path, name string, data []byte) *http.Request {
+	body := new(bytes.Buffer)
+	mw := multipart.NewWriter(body)
+	if name != "" {
+		fw, err := mw.CreateFormFile("file", name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = fw.Write(data)
+	}
+	_ = mw.Close()
+
+	req := httptest.NewRequest(http.MethodPost, path, body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return req
+}
+
+func TestRoute_PostBroadcastFile(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIFiles, item models.File)
+
+	broadcastId := uuid.New()
+	fileId := uuid.New()
+	user := "owner"
+	maxSize := int64(1 << 20)
+	pdf := []byte("%PDF-1.4\n%test slides\n")
+	name := "slides.pdf"
+	fileType := "application/pdf"
+	size := int64(len(pdf))
+
+	item := models.File{Data: pdf}
+	item.BroadcastId = &broadcastId
+	item.Name = &name
+	item.Type = &fileType
+	item.Size = &size
+
+	info := item.FileInfo
+	info.Id = &fileId
+	jsonInfo, _ := json.Marshal(info)
+
+	tests := []struct {
+		name                 string
+		fileName             string
+		data                 []byte
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:     "Ok",
+			fileName: name,
+			data:     pdf,
+			mockBehavior: func(r *mockService.MockIFiles, item models.File) {
+				r.EXPECT().UploadLimit(broadcastId, &user).Return(maxSize, nil)
+				r.EXPECT().CreateFile(item, &user).Return(info, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonInfo) + "\n",
+		},
+		{
+			name: "No file",
+			mockBehavior: func(r *mockService.MockIFiles, item models.File) {
+				r.EXPECT().UploadLimit(broadcastId, &user).Return(maxSize, nil)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgNoSuchFile + `"}` + "\n",
+		},
+		{
+			name:     "Invalid file type",
+			fileName: "run.exe",
+			data:     []byte("MZ\x90\x00\x03\x00\x00\x00\x04\x00\x00\x00\xff\xff\x00\x00"),
+			mockBehavior: func(r *mockService.MockIFiles, item models.File) {
+				r.EXPECT().UploadLimit(broadcastId, &user).Return(maxSize, nil)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidFileType + `"}` + "\n",
+		},
+		{
+			name:     "Body is too large",
+			fileName: name,
+			data:     make([]byte, maxSize+multipartOverhead),
+			mockBehavior: func(r *mockService.MockIFiles, item models.File) {
+				r.EXPECT().UploadLimit(broadcastId, &user).Return(maxSize, nil)
+			},
+			expectedStatusCode:   413,
+			expectedResponseBody: `{"code":` + "413" + `,"message":"` + models.MsgFileTooLarge + `"}` + "\n",
+		},
+		{
+			name:     "File is too large",
+			fileName: name,
+			data:     pdf,
+			mockBehavior: func(r *mockService.MockIFiles, item models.File) {
+				r.EXPECT().UploadLimit(broadcastId, &user).Return(maxSize, nil)
+				r.EXPECT().CreateFile(item, &user).Return(models.FileInfo{}, models.ErrFileTooLarge)
+			},
+			expectedStatusCode:   413,
+			expectedResponseBody: `{"code":` + "413" + `,"message":"` + models.MsgFileTooLarge + `"}` + "\n",
+		},
+		{
+			name:     "Broadcast not found",
+			fileName: name,
+			data:     pdf,
+			mockBehavior: func(r *mockService.MockIFiles, item models.File) {
+				r.EXPECT().UploadLimit(broadcastId, &user).Return(int64(0), models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
+		{
+			name:     "Not owner",
+			fileName: name,
+			data:     pdf,
+			mockBehavior: func(r *mockService.MockIFiles, item models.File) {
+				r.EXPECT().UploadLimit(broadcastId, &user).Return(int64(0), models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name:     "Service failure",
+			fileName: name,
+			data:     pdf,
+			mockBehavior: func(r *mockService.MockIFiles, item models.File) {
+				r.EXPECT().UploadLimit(broadcastId, &user).Return(maxSize, nil)
+				r.EXPECT().CreateFile(item, &user).Return(models.FileInfo{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceCreateFile + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIFiles := mockService.NewMockIFiles(c)
+			test.mockBehavior(mockIFiles, item)
+
+			services := &service.Service{IFiles: mockIFiles}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := newFileRequest(t, "/broadcasts/"+broadcastId.String()+"/files?username="+user, test.fileName, test.data)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_GetBroadcastFiles(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIFiles, id uuid.UUID)
+
+	broadcastId := uuid.New()
+	fileId := uuid.New()
+	user := "viewer"
+	name := "slides.pdf"
+	fileType := "application/pdf"
+	size := int64(1024)
+
+	files := []models.FileInfo{
+		{
+			SIdentifier: api.SIdentifier{Id: &fileId},
+			SFileInfo:   api.SFileInfo{BroadcastId: &broadcastId, Name: &name, Type: &fileType, Size: &size},
+		},
+	}
+	jsonFiles, _ := json.Marshal(files)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIFiles, id uuid.UUID) {
+				r.EXPECT().GetFiles(id, &user).Return(files, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonFiles) + "\n",
+		},
+		{
+			name: "Broadcast not found",
+			mockBehavior: func(r *mockService.MockIFiles, id uuid.UUID) {
+				r.EXPECT().GetFiles(id, &user).Return(nil, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
+		{
+			name: "No access",
+			mockBehavior: func(r *mockService.MockIFiles, id uuid.UUID) {
+				r.EXPECT().GetFiles(id, &user).Return(nil, models.ErrNoAccess)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNoAccess + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIFiles, id uuid.UUID) {
+				r.EXPECT().GetFiles(id, &user).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetFiles + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIFiles := mockService.NewMockIFiles(c)
+			test.mockBehavior(mockIFiles, broadcastId)
+
+			services := &service.Service{IFiles: mockIFiles}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/broadcasts/"+broadcastId.String()+"/files?username="+user, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_GetFileById(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIFiles, id uuid.UUID)
+
+	broadcastId := uuid.New()
+	fileId := uuid.New()
+	user := "viewer"
+	data := []byte("%PDF-1.4\n")
+	name := "слайды.pdf"
+	fileType := "application/pdf"
+	size := int64(len(data))
+
+	file := models.File{Data: data}
+	file.Id = &fileId
+	file.BroadcastId = &broadcastId
+	file.Name = &name
+	file.Type = &fileType
+	file.Size = &size
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedDisposition  string
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIFiles, id uuid.UUID) {
+				r.EXPECT().GetFileById(id, &user).Return(file, nil)
+			},
+			expectedStatusCode:   200,
+			expectedDisposition:  "attachment; filename*=utf-8''%D1%81%D0%BB%D0%B0%D0%B9%D0%B4%D1%8B.pdf",
+			expectedResponseBody: string(data),
+		},
+		{
+			name: "File not found",
+			mockBehavior: func(r *mockService.MockIFiles, id uuid.UUID) {
+				r.EXPECT().GetFileById(id, &user).Return(models.File{}, nil)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: "",
+		},
+		{
+			name: "No access",
+			mockBehavior: func(r *mockService.MockIFiles, id uuid.UUID) {
+				r.EXPECT().GetFileById(id, &user).Return(models.File{}, models.ErrNoAccess)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNoAccess + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIFiles, id uuid.UUID) {
+				r.EXPECT().GetFileById(id, &user).Return(models.File{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetFileById + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIFiles := mockService.NewMockIFiles(c)
+			test.mockBehavior(mockIFiles, fileId)
+
+			services := &service.Service{IFiles: mockIFiles}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/files/"+fileId.String()+"?username="+user, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+			assert.Equal(t, w.Header().Get("Content-Disposition"), test.expectedDisposition)
+		})
+	}
+}
+
+func TestRoute_DeleteFileById(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIFiles, id uuid.UUID)
+
+	fileId := uuid.New()
+	user := "owner"
+	res := api.SIdentifier{Id: &fileId}
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIFiles, id uuid.UUID) {
+				r.EXPECT().DeleteFile(id, &user).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name: "File not found",
+			mockBehavior: func(r *mockService.MockIFiles, id uuid.UUID) {
+				r.EXPECT().DeleteFile(id, &user).Return(api.SIdentifier{}, models.ErrFileNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgFileNotFound + `"}` + "\n",
+		},
+		{
+			name: "Not owner",
+			mockBehavior: func(r *mockService.MockIFiles, id uuid.UUID) {
+				r.EXPECT().DeleteFile(id, &user).Return(api.SIdentifier{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIFiles, id uuid.UUID) {
+				r.EXPECT().DeleteFile(id, &user).Return(api.SIdentifier{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceDeleteFile + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIFiles := mockService.NewMockIFiles(c)
+			test.mockBehavior(mockIFiles, fileId)
+
+			services := &service.Service{IFiles: mockIFiles}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, "/files/"+fileId.String()+"?username="+user, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
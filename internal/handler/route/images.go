@@ -3,12 +3,14 @@ package route
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
 
 	"github.com/deepmap/oapi-codegen/pkg/types"
 
+	"github.com/alexm24/golang/internal/handler/api"
 	"github.com/alexm24/golang/internal/models"
 )
 
@@ -39,9 +41,16 @@ func (c *Route) GetImageById(w http.ResponseWriter, _ *http.Request, id types.UU
 	_, _ = io.Copy(w, buf)
 }
 
-func (c *Route) PutImageById(w http.ResponseWriter, _ *http.Request, id types.UUID) {
-	item, err := c.service.IImages.DelImageById(id)
-	if err != nil {
+func (c *Route) PutImageById(w http.ResponseWriter, _ *http.Request, id types.UUID, params api.PutImageByIdParams) {
+	item, err := c.service.IImages.DelImageById(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceDelImageById)
 		return
 	}
@@ -75,8 +84,20 @@ func (c *Route) PostImage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	item, err := c.service.IImages.CreateImage(id, fileBytes)
-	if err != nil {
+	var actor *string
+	if username := r.FormValue("username"); len(username) > 0 {
+		actor = &username
+	}
+
+	item, err := c.service.IImages.CreateImage(id, fileBytes, actor)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceCreateImage)
 		return
 	}
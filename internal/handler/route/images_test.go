@@ -101,11 +101,13 @@ func TestRoute_PutImageById(t *testing.T) {
 
 	params := uuid.New()
 	id := api.SIdentifier{Id: &params}
+	admin := "admin"
 
 	jsonId, _ := json.Marshal(id)
 
 	tests := []struct {
 		name                 string
+		query                string
 		mockBehavior         mockBehavior
 		expectedStatusCode   int
 		expectedResponseBody string
@@ -113,15 +115,41 @@ func TestRoute_PutImageById(t *testing.T) {
 		{
 			name: "Ok",
 			mockBehavior: func(r *mockService.MockIImages, i uuid.UUID) {
-				r.EXPECT().DelImageById(i).Return(id, nil)
+				r.EXPECT().DelImageById(i, nil).Return(id, nil)
 			},
 			expectedStatusCode:   200,
 			expectedResponseBody: string(jsonId) + "\n",
 		},
+		{
+			name:  "Ok with username",
+			query: "?username=admin",
+			mockBehavior: func(r *mockService.MockIImages, i uuid.UUID) {
+				r.EXPECT().DelImageById(i, &admin).Return(id, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonId) + "\n",
+		},
+		{
+			name: "Not owner",
+			mockBehavior: func(r *mockService.MockIImages, p uuid.UUID) {
+				r.EXPECT().DelImageById(p, nil).Return(api.SIdentifier{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name:  "Broadcast not found",
+			query: "?username=admin",
+			mockBehavior: func(r *mockService.MockIImages, p uuid.UUID) {
+				r.EXPECT().DelImageById(p, &admin).Return(api.SIdentifier{}, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
 		{
 			name: "Service failure",
 			mockBehavior: func(r *mockService.MockIImages, p uuid.UUID) {
-				r.EXPECT().DelImageById(p).Return(id, errors.New("error"))
+				r.EXPECT().DelImageById(p, nil).Return(id, errors.New("error"))
 			},
 			expectedStatusCode:   500,
 			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceDelImageById + `"}` + "\n",
@@ -142,16 +170,12 @@ func TestRoute_PutImageById(t *testing.T) {
 
 			// Init Endpoint
 			route := chi.NewRouter()
-			route.Put("/images/{id}", func(w http.ResponseWriter, r *http.Request) {
-				iD := chi.URLParam(r, "id")
-				uuID, _ := uuid.Parse(iD)
-				handler.PutImageById(w, r, uuID)
-			})
+			route.Mount("/", api.Handler(&handler))
 
 			// Create Request
 			w := httptest.NewRecorder()
 			path := "/images/" + params.String()
-			req := httptest.NewRequest(http.MethodPut, path, nil)
+			req := httptest.NewRequest(http.MethodPut, path+test.query, nil)
 
 			// Make Request
 			route.ServeHTTP(w, req)
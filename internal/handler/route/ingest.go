@@ -0,0 +1,81 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) IngestOnPublish(w http.ResponseWriter, r *http.Request) {
+	c.ingest(w, r, c.service.IIngest.Publish, models.ErrServiceIngestPublish)
+}
+
+func (c *Route) IngestOnPublishDone(w http.ResponseWriter, r *http.Request) {
+	c.ingest(w, r, c.service.IIngest.PublishDone, models.ErrServiceIngestPublishDone)
+}
+
+func (c *Route) IngestOnRecordDone(w http.ResponseWriter, r *http.Request) {
+	c.ingest(w, r, c.service.IIngest.RecordDone, models.ErrServiceIngestRecordDone)
+}
+
+// ingest answers with code 0 on success, SRS rejects any other code and
+// nginx-rtmp any status other than 2xx.
+func (c *Route) ingest(w http.ResponseWriter, r *http.Request, handle func(models.IngestEvent) error, errMsg string) {
+	cb, err := decodeIngestCallback(r)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+
+	e, err := models.NewIngestEvent(cb)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	err = handle(e)
+	switch {
+	case errors.Is(err, models.ErrStreamKeyUnknown),
+		errors.Is(err, models.ErrStreamKeyExpired),
+		errors.Is(err, models.ErrStreamKeyNotActive):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrRecordingOutside):
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), errMsg)
+		return
+	}
+
+	code := 0
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(api.SIngestResult{Code: &code})
+}
+
+func decodeIngestCallback(r *http.Request) (api.SIngestCallback, error) {
+	var cb api.SIngestCallback
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "application/json" {
+		err := json.NewDecoder(r.Body).Decode(&cb)
+		return cb, err
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return cb, err
+	}
+	for field, value := range map[string]**string{
+		"call": &cb.Call, "action": &cb.Action, "app": &cb.App,
+		"name": &cb.Name, "stream": &cb.Stream, "path": &cb.Path, "file": &cb.File,
+	} {
+		if v := r.PostForm.Get(field); len(v) > 0 {
+			*value = &v
+		}
+	}
+	return cb, nil
+}
@@ -0,0 +1,171 @@
+package route
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func TestRoute_IngestCallbacks(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIIngest)
+
+	const (
+		form = "application/x-www-form-urlencoded"
+		json = "application/json"
+	)
+
+	key := "qwerty"
+	path := "/var/rec/qwerty-1656662400.flv"
+	event := models.IngestEvent{StreamKey: key}
+	eventRecord := models.IngestEvent{StreamKey: key, Path: path}
+
+	tests := []struct {
+		name                 string
+		path                 string
+		contentType          string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:        "nginx-rtmp on_publish",
+			path:        "/ingest/on_publish",
+			contentType: form,
+			inputBody:   "call=publish&app=live&name=" + key,
+			mockBehavior: func(r *mockService.MockIIngest) {
+				r.EXPECT().Publish(event).Return(nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"code":0}` + "\n",
+		},
+		{
+			name:        "SRS on_publish",
+			path:        "/ingest/on_publish",
+			contentType: json,
+			inputBody:   `{"action":"on_publish","app":"live","stream":"` + key + `"}`,
+			mockBehavior: func(r *mockService.MockIIngest) {
+				r.EXPECT().Publish(event).Return(nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"code":0}` + "\n",
+		},
+		{
+			name:        "Unknown key",
+			path:        "/ingest/on_publish",
+			contentType: form,
+			inputBody:   "call=publish&app=live&name=" + key,
+			mockBehavior: func(r *mockService.MockIIngest) {
+				r.EXPECT().Publish(event).Return(models.ErrStreamKeyUnknown)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgStreamKeyUnknown + `"}` + "\n",
+		},
+		{
+			name:        "Expired key",
+			path:        "/ingest/on_publish",
+			contentType: form,
+			inputBody:   "call=publish&app=live&name=" + key,
+			mockBehavior: func(r *mockService.MockIIngest) {
+				r.EXPECT().Publish(event).Return(models.ErrStreamKeyExpired)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgStreamKeyExpired + `"}` + "\n",
+		},
+		{
+			name:                 "Key is empty",
+			path:                 "/ingest/on_publish",
+			contentType:          form,
+			inputBody:            "call=publish&app=live",
+			mockBehavior:         func(r *mockService.MockIIngest) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgStreamKeyEmpty + `"}` + "\n",
+		},
+		{
+			name:        "Service failure",
+			path:        "/ingest/on_publish",
+			contentType: form,
+			inputBody:   "call=publish&app=live&name=" + key,
+			mockBehavior: func(r *mockService.MockIIngest) {
+				r.EXPECT().Publish(event).Return(errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceIngestPublish + `"}` + "\n",
+		},
+		{
+			name:        "on_publish_done",
+			path:        "/ingest/on_publish_done",
+			contentType: form,
+			inputBody:   "call=publish_done&app=live&name=" + key,
+			mockBehavior: func(r *mockService.MockIIngest) {
+				r.EXPECT().PublishDone(event).Return(nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"code":0}` + "\n",
+		},
+		{
+			name:        "nginx-rtmp on_record_done",
+			path:        "/ingest/on_record_done",
+			contentType: form,
+			inputBody:   "call=record_done&app=live&name=" + key + "&path=" + path,
+			mockBehavior: func(r *mockService.MockIIngest) {
+				r.EXPECT().RecordDone(eventRecord).Return(nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"code":0}` + "\n",
+		},
+		{
+			name:        "SRS on_dvr",
+			path:        "/ingest/on_record_done",
+			contentType: json,
+			inputBody:   `{"action":"on_dvr","app":"live","stream":"` + key + `","file":"` + path + `"}`,
+			mockBehavior: func(r *mockService.MockIIngest) {
+				r.EXPECT().RecordDone(eventRecord).Return(nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"code":0}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIIngest := mockService.NewMockIIngest(c)
+			test.mockBehavior(mockIIngest)
+
+			services := &service.Service{IIngest: mockIIngest}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, test.path, bytes.NewBufferString(test.inputBody))
+			req.Header.Set("Content-Type", test.contentType)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
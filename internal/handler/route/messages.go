@@ -2,20 +2,35 @@ package route
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
 	"github.com/alexm24/golang/internal/models"
 )
 
-func (c *Route) GetMsgByChannel(w http.ResponseWriter, _ *http.Request, channel string) {
-	msg, err := c.service.IMessages.GetMessageByChannel(channel)
+func (c *Route) GetMsgByChannel(w http.ResponseWriter, r *http.Request, channel string, params api.GetMsgByChannelParams) {
+	filter, err := models.NewMessageFilter(channel, params.Before, params.After, params.Limit)
 	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	page, err := c.service.IMessages.GetMessageByChannel(filter, params.Username)
+	switch {
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetMessageByChannel)
 		return
 	}
 
+	setHistoryLinks(w, r, page.Prev, page.Next)
 	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(msg)
+	_ = json.NewEncoder(w).Encode(page.Items)
 }
 
 func (c *Route) PostMsgByChannel(w http.ResponseWriter, r *http.Request, channel string) {
@@ -31,7 +46,14 @@ func (c *Route) PostMsgByChannel(w http.ResponseWriter, r *http.Request, channel
 	}
 
 	msg, err := c.service.IMessages.CreateMsg(channel, msgBody)
-	if err != nil {
+	switch {
+	case errors.Is(err, models.ErrParentNotFound):
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceErrCreateMsg)
 		return
 	}
@@ -40,6 +62,89 @@ func (c *Route) PostMsgByChannel(w http.ResponseWriter, r *http.Request, channel
 	w.WriteHeader(http.StatusOK)
 }
 
+func (c *Route) EditMsg(w http.ResponseWriter, r *http.Request, channel string, id types.UUID, params api.EditMsgParams) {
+	var item models.EditMessage
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+
+	if err := item.Validate(); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	msg, err := c.service.IMessages.EditMsg(channel, id, item, params.Username)
+	switch {
+	case errors.Is(err, models.ErrMessageNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotAuthor), errors.Is(err, models.ErrEditWindowClosed):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceEditMsg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(msg)
+}
+
+func (c *Route) DeleteMsg(w http.ResponseWriter, _ *http.Request, channel string, id types.UUID, params api.DeleteMsgParams) {
+	item, err := c.service.IMessages.DeleteMsg(channel, id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrMessageNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotModerator):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceDeleteMsg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+func (c *Route) GetMsgEdits(w http.ResponseWriter, _ *http.Request, channel string, id types.UUID, params api.GetMsgEditsParams) {
+	items, err := c.service.IMessages.GetMsgEdits(channel, id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrMessageNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetMsgEdits)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(items)
+}
+
+func (c *Route) GetMsgReplies(w http.ResponseWriter, _ *http.Request, channel string, id types.UUID, params api.GetMsgRepliesParams) {
+	items, err := c.service.IMessages.GetMsgReplies(channel, id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrMessageNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetMsgReplies)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(items)
+}
+
 func (c *Route) PostReactionMsg(w http.ResponseWriter, r *http.Request, channel string) {
 	var item models.PostReactionMsg
 	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
@@ -53,7 +158,11 @@ func (c *Route) PostReactionMsg(w http.ResponseWriter, r *http.Request, channel
 	}
 
 	err := c.service.IMessages.CreateReaction(channel, item)
-	if err != nil {
+	switch {
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceCreateReaction)
 		return
 	}
@@ -74,7 +183,11 @@ func (c *Route) PatchReactionMsg(w http.ResponseWriter, r *http.Request, channel
 	}
 
 	err := c.service.IMessages.DeleteReaction(channel, item)
-	if err != nil {
+	switch {
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceDeleteReaction)
 		return
 	}
@@ -22,7 +22,7 @@ import (
 
 func TestRoute_GetMsgByChannel(t *testing.T) {
 	// Init Test Table
-	type mockBehavior func(r *mockService.MockIMessages, channel string)
+	type mockBehavior func(r *mockService.MockIMessages, filter models.MessageFilter)
 
 	id := uuid.New()
 	channel := "channel"
@@ -53,28 +53,82 @@ func TestRoute_GetMsgByChannel(t *testing.T) {
 
 	jsonArrayMsg, _ := json.Marshal(arrayMsg)
 
+	cursor := models.MessageCursor(arrayMsg[0])
+	before := cursor.Encode()
+	sortCursor := models.Cursor{Sort: models.SortName, Value: "a", Id: id}
+
 	tests := []struct {
 		name                 string
+		query                string
+		filter               models.MessageFilter
 		mockBehavior         mockBehavior
 		expectedStatusCode   int
+		expectedLink         string
 		expectedResponseBody string
 	}{
 		{
-			name: "Ok",
-			mockBehavior: func(r *mockService.MockIMessages, channel string) {
-				r.EXPECT().GetMessageByChannel(channel).Return(arrayMsg, nil)
+			name:   "Ok",
+			filter: models.MessageFilter{Channel: channel, Limit: models.DefaultPageLimit},
+			mockBehavior: func(r *mockService.MockIMessages, filter models.MessageFilter) {
+				r.EXPECT().GetMessageByChannel(filter, nil).
+					Return(models.MessagesPage{Items: arrayMsg, Prev: &cursor}, nil)
 			},
 			expectedStatusCode:   200,
+			expectedLink:         `</messages/` + channel + `?before=` + before + `>; rel="prev"`,
 			expectedResponseBody: string(jsonArrayMsg) + "\n",
 		},
 		{
-			name: "Service failure",
-			mockBehavior: func(r *mockService.MockIMessages, channel string) {
-				r.EXPECT().GetMessageByChannel(channel).Return(arrayMsg, errors.New("error"))
+			name:   "Older messages",
+			query:  "?limit=1&before=" + before,
+			filter: models.MessageFilter{Channel: channel, Before: &cursor, Limit: 1},
+			mockBehavior: func(r *mockService.MockIMessages, filter models.MessageFilter) {
+				r.EXPECT().GetMessageByChannel(filter, nil).
+					Return(models.MessagesPage{Items: arrayMsg, Prev: &cursor, Next: &cursor}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedLink: `</messages/` + channel + `?before=` + before + `&limit=1>; rel="prev", ` +
+				`</messages/` + channel + `?after=` + before + `&limit=1>; rel="next"`,
+			expectedResponseBody: string(jsonArrayMsg) + "\n",
+		},
+		{
+			name:                 "Invalid cursor",
+			query:                "?before=" + sortCursor.Encode(),
+			mockBehavior:         func(r *mockService.MockIMessages, filter models.MessageFilter) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidCursor + `"}` + "\n",
+		},
+		{
+			name:                 "Both cursors",
+			query:                "?before=" + before + "&after=" + before,
+			mockBehavior:         func(r *mockService.MockIMessages, filter models.MessageFilter) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgCursorConflict + `"}` + "\n",
+		},
+		{
+			name:                 "Invalid limit",
+			query:                "?limit=0",
+			mockBehavior:         func(r *mockService.MockIMessages, filter models.MessageFilter) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidLimit + `"}` + "\n",
+		},
+		{
+			name:   "Service failure",
+			filter: models.MessageFilter{Channel: channel, Limit: models.DefaultPageLimit},
+			mockBehavior: func(r *mockService.MockIMessages, filter models.MessageFilter) {
+				r.EXPECT().GetMessageByChannel(filter, nil).Return(models.MessagesPage{}, errors.New("error"))
 			},
 			expectedStatusCode:   500,
 			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetMessageByChannel + `"}` + "\n",
 		},
+		{
+			name:   "No access",
+			filter: models.MessageFilter{Channel: channel, Limit: models.DefaultPageLimit},
+			mockBehavior: func(r *mockService.MockIMessages, filter models.MessageFilter) {
+				r.EXPECT().GetMessageByChannel(filter, nil).Return(models.MessagesPage{}, models.ErrNoAccess)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNoAccess + `"}` + "\n",
+		},
 	}
 
 	for _, test := range tests {
@@ -84,32 +138,28 @@ func TestRoute_GetMsgByChannel(t *testing.T) {
 			defer c.Finish()
 
 			mockIMsg := mockService.NewMockIMessages(c)
-			test.mockBehavior(mockIMsg, channel)
+			test.mockBehavior(mockIMsg, test.filter)
 
 			services := &service.Service{IMessages: mockIMsg}
 			handler := Route{services}
 
 			// Init Endpoint
-			route := chi.NewRouter()
-			route.Get("/messages/{id}", func(w http.ResponseWriter, r *http.Request) {
-				iD := chi.URLParam(r, "id")
-				handler.GetMsgByChannel(w, r, iD)
-			})
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
 
 			// Create Request
 			w := httptest.NewRecorder()
-			path := "/messages/" + channel
-			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req := httptest.NewRequest(http.MethodGet, "/messages/"+channel+test.query, nil)
 
 			// Make Request
-			route.ServeHTTP(w, req)
+			r.ServeHTTP(w, req)
 
 			// Assert
 			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Header().Get("Link"), test.expectedLink)
 			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
 		})
 	}
-
 }
 
 func TestRoute_PostMsgByChannel(t *testing.T) {
@@ -136,6 +186,10 @@ func TestRoute_PostMsgByChannel(t *testing.T) {
 		Username:   &user,
 	}
 
+	parentId := uuid.New()
+	postReply := postMsg
+	postReply.ParentId = &parentId
+
 	postMsgWithoutUsername := models.PostMessage{
 		Avatar:     &avatar,
 		Fullname:   &fullname,
@@ -207,6 +261,7 @@ func TestRoute_PostMsgByChannel(t *testing.T) {
 	}
 
 	jsonPostMsg, _ := json.Marshal(postMsg)
+	jsonPostReply, _ := json.Marshal(postReply)
 	jsonPostMsgWithoutUsername, _ := json.Marshal(postMsgWithoutUsername)
 	jsonPostMsgWithoutFullname, _ := json.Marshal(postMsgWithoutFullname)
 	jsonPostMsgWithoutText, _ := json.Marshal(postMsgWithoutText)
@@ -245,6 +300,16 @@ func TestRoute_PostMsgByChannel(t *testing.T) {
 			expectedStatusCode:   500,
 			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceErrCreateMsg + `"}` + "\n",
 		},
+		{
+			name:      "Parent not found",
+			inputBody: string(jsonPostReply),
+			inputMsg:  postReply,
+			mockBehavior: func(r *mockService.MockIMessages, channel string, msg models.PostMessage) {
+				r.EXPECT().CreateMsg(channel, msg).Return(models.Messages{}, models.ErrParentNotFound)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgParentNotFound + `"}` + "\n",
+		},
 		{
 			name:                 "username field is empty",
 			inputBody:            string(jsonPostMsgWithoutUsername),
@@ -310,7 +375,7 @@ func TestRoute_PostMsgByChannel(t *testing.T) {
 			defer c.Finish()
 
 			mockIMsg := mockService.NewMockIMessages(c)
-			test.mockBehavior(mockIMsg, channel, postMsg)
+			test.mockBehavior(mockIMsg, channel, test.inputMsg)
 
 			services := &service.Service{IMessages: mockIMsg}
 			handler := Route{services}
@@ -564,3 +629,382 @@ func TestRoute_PatchReactionMsg(t *testing.T) {
 	}
 
 }
+
+func TestRoute_EditMsg(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIMessages, item models.EditMessage)
+
+	channel := uuid.New().String()
+	id := uuid.New()
+	user := "test"
+	text := "edited"
+	date := time.Now()
+	input := models.EditMessage{Text: &text}
+
+	msg := models.Messages{
+		SIdentifier: api.SIdentifier{Id: &id},
+		SUsername:   api.SUsername{Username: &user},
+		SMessage:    api.SMessage{Text: &text},
+		SEdited:     api.SEdited{EditedAt: &date},
+	}
+	jsonMsg, _ := json.Marshal(msg)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"text":"edited"}`,
+			mockBehavior: func(r *mockService.MockIMessages, item models.EditMessage) {
+				r.EXPECT().EditMsg(channel, id, item, &user).Return(msg, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonMsg) + "\n",
+		},
+		{
+			name:                 "Invalid json",
+			inputBody:            `{"text":`,
+			mockBehavior:         func(r *mockService.MockIMessages, item models.EditMessage) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidJson + `"}` + "\n",
+		},
+		{
+			name:                 "Empty text",
+			inputBody:            `{"text":" "}`,
+			mockBehavior:         func(r *mockService.MockIMessages, item models.EditMessage) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgTextEmpty + `"}` + "\n",
+		},
+		{
+			name:      "Message not found",
+			inputBody: `{"text":"edited"}`,
+			mockBehavior: func(r *mockService.MockIMessages, item models.EditMessage) {
+				r.EXPECT().EditMsg(channel, id, item, &user).Return(models.Messages{}, models.ErrMessageNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgMessageNotFound + `"}` + "\n",
+		},
+		{
+			name:      "Not author",
+			inputBody: `{"text":"edited"}`,
+			mockBehavior: func(r *mockService.MockIMessages, item models.EditMessage) {
+				r.EXPECT().EditMsg(channel, id, item, &user).Return(models.Messages{}, models.ErrNotAuthor)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAuthor + `"}` + "\n",
+		},
+		{
+			name:      "Edit window closed",
+			inputBody: `{"text":"edited"}`,
+			mockBehavior: func(r *mockService.MockIMessages, item models.EditMessage) {
+				r.EXPECT().EditMsg(channel, id, item, &user).Return(models.Messages{}, models.ErrEditWindowClosed)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgEditWindowClosed + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: `{"text":"edited"}`,
+			mockBehavior: func(r *mockService.MockIMessages, item models.EditMessage) {
+				r.EXPECT().EditMsg(channel, id, item, &user).Return(models.Messages{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceEditMsg + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIMsg := mockService.NewMockIMessages(c)
+			test.mockBehavior(mockIMsg, input)
+
+			services := &service.Service{IMessages: mockIMsg}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPatch,
+				"/messages/"+channel+"/"+id.String()+"?username="+user, bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_DeleteMsg(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIMessages, channel string, id uuid.UUID, actor *string)
+
+	channel := uuid.New().String()
+	id := uuid.New()
+	moderator := "ivanov"
+	res := api.SIdentifier{Id: &id}
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIMessages, channel string, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteMsg(channel, id, actor).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name: "Message not found",
+			mockBehavior: func(r *mockService.MockIMessages, channel string, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteMsg(channel, id, actor).Return(api.SIdentifier{}, models.ErrMessageNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgMessageNotFound + `"}` + "\n",
+		},
+		{
+			name: "Not moderator",
+			mockBehavior: func(r *mockService.MockIMessages, channel string, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteMsg(channel, id, actor).Return(api.SIdentifier{}, models.ErrNotModerator)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotModerator + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIMessages, channel string, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteMsg(channel, id, actor).Return(api.SIdentifier{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceDeleteMsg + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIMsg := mockService.NewMockIMessages(c)
+			test.mockBehavior(mockIMsg, channel, id, &moderator)
+
+			services := &service.Service{IMessages: mockIMsg}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete,
+				"/messages/"+channel+"/"+id.String()+"?username="+moderator, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_GetMsgEdits(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIMessages, channel string, id uuid.UUID)
+
+	channel := uuid.New().String()
+	id := uuid.New()
+	editId := uuid.New()
+	user := "test"
+	text := "original"
+	date := time.Now()
+
+	items := []models.MessageEdit{
+		{
+			SIdentifier:  api.SIdentifier{Id: &editId},
+			SMessageEdit: api.SMessageEdit{MessageId: &id, Text: &text, EditedBy: &user, EditedAt: &date},
+		},
+	}
+	jsonItems, _ := json.Marshal(items)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIMessages, channel string, id uuid.UUID) {
+				r.EXPECT().GetMsgEdits(channel, id, nil).Return(items, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItems) + "\n",
+		},
+		{
+			name: "Message not found",
+			mockBehavior: func(r *mockService.MockIMessages, channel string, id uuid.UUID) {
+				r.EXPECT().GetMsgEdits(channel, id, nil).Return(nil, models.ErrMessageNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgMessageNotFound + `"}` + "\n",
+		},
+		{
+			name: "No access",
+			mockBehavior: func(r *mockService.MockIMessages, channel string, id uuid.UUID) {
+				r.EXPECT().GetMsgEdits(channel, id, nil).Return(nil, models.ErrNoAccess)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNoAccess + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIMessages, channel string, id uuid.UUID) {
+				r.EXPECT().GetMsgEdits(channel, id, nil).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetMsgEdits + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIMsg := mockService.NewMockIMessages(c)
+			test.mockBehavior(mockIMsg, channel, id)
+
+			services := &service.Service{IMessages: mockIMsg}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/messages/"+channel+"/"+id.String()+"/edits", nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_GetMsgReplies(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIMessages, channel string, id uuid.UUID)
+
+	channel := uuid.New().String()
+	id := uuid.New()
+	replyId := uuid.New()
+	user := "test"
+	text := "reply"
+	date := time.Now()
+
+	items := []models.Messages{
+		{
+			SIdentifier: api.SIdentifier{Id: &replyId},
+			SUsername:   api.SUsername{Username: &user},
+			SMessage:    api.SMessage{Text: &text, Time: &date},
+			SParent:     api.SParent{ParentId: &id},
+		},
+	}
+	jsonItems, _ := json.Marshal(items)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIMessages, channel string, id uuid.UUID) {
+				r.EXPECT().GetMsgReplies(channel, id, nil).Return(items, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItems) + "\n",
+		},
+		{
+			name: "Message not found",
+			mockBehavior: func(r *mockService.MockIMessages, channel string, id uuid.UUID) {
+				r.EXPECT().GetMsgReplies(channel, id, nil).Return(nil, models.ErrMessageNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgMessageNotFound + `"}` + "\n",
+		},
+		{
+			name: "No access",
+			mockBehavior: func(r *mockService.MockIMessages, channel string, id uuid.UUID) {
+				r.EXPECT().GetMsgReplies(channel, id, nil).Return(nil, models.ErrNoAccess)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNoAccess + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIMessages, channel string, id uuid.UUID) {
+				r.EXPECT().GetMsgReplies(channel, id, nil).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetMsgReplies + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIMsg := mockService.NewMockIMessages(c)
+			test.mockBehavior(mockIMsg, channel, id)
+
+			services := &service.Service{IMessages: mockIMsg}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/messages/"+channel+"/"+id.String()+"/replies", nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
@@ -2,8 +2,10 @@ package route
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
+	"github.com/alexm24/golang/internal/handler/api"
 	"github.com/alexm24/golang/internal/models"
 )
 
@@ -19,18 +21,45 @@ func (c *Route) PostParticipantsByChannel(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	err := c.service.IParticipants.CreateParticipant(channel, item)
-	if err != nil {
+	registration, err := c.service.IParticipants.CreateParticipant(channel, item)
+	switch {
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceCreateParticipant)
 		return
 	}
 
 	w.WriteHeader(http.StatusOK)
+	if registration.Id != nil {
+		_ = json.NewEncoder(w).Encode(registration)
+	}
+}
+
+func (c *Route) DeleteParticipant(w http.ResponseWriter, _ *http.Request, channel string, username string) {
+	item, err := c.service.IParticipants.DeleteParticipant(channel, username)
+	switch {
+	case errors.Is(err, models.ErrNotRegistered):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceDeleteParticipant)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
 }
 
-func (c *Route) GetParticipantsByChannel(w http.ResponseWriter, _ *http.Request, channel string) {
-	items, err := c.service.IParticipants.GetParticipants(channel)
-	if err != nil {
+func (c *Route) GetParticipantsByChannel(
+	w http.ResponseWriter, _ *http.Request, channel string, params api.GetParticipantsByChannelParams) {
+	items, err := c.service.IParticipants.GetParticipants(channel, params.Username)
+	switch {
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetParticipants)
 		return
 	}
@@ -50,7 +50,7 @@ func TestRoute_GetParticipantsByChannel(t *testing.T) {
 			name:    "Ok",
 			channel: "test",
 			mockBehavior: func(r *mockService.MockIParticipants, channel string) {
-				r.EXPECT().GetParticipants(channel).Return(participants, nil)
+				r.EXPECT().GetParticipants(channel, nil).Return(participants, nil)
 			},
 			expectedStatusCode:   200,
 			expectedResponseBody: string(jsonParticipants) + "\n",
@@ -59,11 +59,20 @@ func TestRoute_GetParticipantsByChannel(t *testing.T) {
 			name:    "Service failure",
 			channel: "test",
 			mockBehavior: func(r *mockService.MockIParticipants, channel string) {
-				r.EXPECT().GetParticipants(channel).Return(participants, errors.New("error"))
+				r.EXPECT().GetParticipants(channel, nil).Return(participants, errors.New("error"))
 			},
 			expectedStatusCode:   500,
 			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetParticipants + `"}` + "\n",
 		},
+		{
+			name:    "No access",
+			channel: "test",
+			mockBehavior: func(r *mockService.MockIParticipants, channel string) {
+				r.EXPECT().GetParticipants(channel, nil).Return(nil, models.ErrNoAccess)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNoAccess + `"}` + "\n",
+		},
 	}
 
 	for _, test := range tests {
@@ -82,7 +91,7 @@ func TestRoute_GetParticipantsByChannel(t *testing.T) {
 			route := chi.NewRouter()
 			route.Get("/participants/{channel}", func(w http.ResponseWriter, r *http.Request) {
 				ch := chi.URLParam(r, "channel")
-				handler.GetParticipantsByChannel(w, r, ch)
+				handler.GetParticipantsByChannel(w, r, ch, api.GetParticipantsByChannelParams{})
 			})
 
 			// Create Request
@@ -127,7 +136,20 @@ func TestRoute_PostParticipantsByChannel(t *testing.T) {
 		Fullname: &fullname,
 	}
 
+	id := uuid.New()
+	status := string(models.RegistrationWaitlisted)
+	registration := models.Registration{
+		SRegistration: api.SRegistration{
+			Id:       &id,
+			Username: &username,
+			Email:    &email,
+			Fullname: &fullname,
+			Status:   &status,
+		},
+	}
+
 	jsonUser, _ := json.Marshal(user)
+	jsonRegistration, _ := json.Marshal(registration)
 	jsonUserWithoutFullname, _ := json.Marshal(userWithoutFullname)
 	jsonUserWithoutEmail, _ := json.Marshal(userWithoutEmail)
 	jsonWithoutUsername, _ := json.Marshal(userName)
@@ -147,18 +169,29 @@ func TestRoute_PostParticipantsByChannel(t *testing.T) {
 			inputBody: string(jsonUser),
 			inputUser: user,
 			mockBehavior: func(r *mockService.MockIParticipants, channel string, user models.PostParticipant) {
-				r.EXPECT().CreateParticipant(channel, user).Return(nil)
+				r.EXPECT().CreateParticipant(channel, user).Return(models.Registration{}, nil)
 			},
 			expectedStatusCode:   200,
 			expectedResponseBody: "",
 		},
+		{
+			name:      "Ok registration",
+			channel:   id.String(),
+			inputBody: string(jsonUser),
+			inputUser: user,
+			mockBehavior: func(r *mockService.MockIParticipants, channel string, user models.PostParticipant) {
+				r.EXPECT().CreateParticipant(channel, user).Return(registration, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRegistration) + "\n",
+		},
 		{
 			name:      "Service failure",
 			channel:   "test",
 			inputBody: string(jsonUser),
 			inputUser: user,
 			mockBehavior: func(r *mockService.MockIParticipants, channel string, user models.PostParticipant) {
-				r.EXPECT().CreateParticipant(channel, user).Return(errors.New("error"))
+				r.EXPECT().CreateParticipant(channel, user).Return(models.Registration{}, errors.New("error"))
 			},
 			expectedStatusCode:   500,
 			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceCreateParticipant + `"}` + "\n",
@@ -224,5 +257,91 @@ func TestRoute_PostParticipantsByChannel(t *testing.T) {
 			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
 		})
 	}
+}
+
+func TestRoute_DeleteParticipant(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIParticipants, channel, username string)
+
+	id := uuid.New()
+	username := "test"
+	status := string(models.RegistrationConfirmed)
+
+	registration := models.Registration{
+		SRegistration: api.SRegistration{Id: &id, Username: &username, Status: &status},
+	}
+
+	jsonRegistration, _ := json.Marshal(registration)
 
+	tests := []struct {
+		name                 string
+		channel              string
+		username             string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:     "Ok",
+			channel:  id.String(),
+			username: username,
+			mockBehavior: func(r *mockService.MockIParticipants, channel, username string) {
+				r.EXPECT().DeleteParticipant(channel, username).Return(registration, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRegistration) + "\n",
+		},
+		{
+			name:     "Not registered",
+			channel:  id.String(),
+			username: username,
+			mockBehavior: func(r *mockService.MockIParticipants, channel, username string) {
+				r.EXPECT().DeleteParticipant(channel, username).Return(models.Registration{}, models.ErrNotRegistered)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgNotRegistered + `"}` + "\n",
+		},
+		{
+			name:     "Service failure",
+			channel:  id.String(),
+			username: username,
+			mockBehavior: func(r *mockService.MockIParticipants, channel, username string) {
+				r.EXPECT().DeleteParticipant(channel, username).Return(models.Registration{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceDeleteParticipant + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIParticipants := mockService.NewMockIParticipants(c)
+			test.mockBehavior(mockIParticipants, test.channel, test.username)
+
+			services := &service.Service{IParticipants: mockIParticipants}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Delete("/participants/{channel}/{username}", func(w http.ResponseWriter, r *http.Request) {
+				handler.DeleteParticipant(w, r, chi.URLParam(r, "channel"), chi.URLParam(r, "username"))
+			})
+
+			// Create Request
+			w := httptest.NewRecorder()
+			path := "/participants/" + test.channel + "/" + test.username
+			req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
 }
@@ -0,0 +1,150 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) GetQuestions(w http.ResponseWriter, _ *http.Request, channel string, params api.GetQuestionsParams) {
+	states, err := models.ParseQuestionStates(params.State)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	items, err := c.service.IQuestions.GetQuestions(channel, states, params.Username)
+	switch {
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetQuestions)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(items)
+}
+
+func (c *Route) PatchQuestion(w http.ResponseWriter, r *http.Request, channel string, id types.UUID, params api.PatchQuestionParams) {
+	var item models.PatchQuestion
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+
+	if err := item.Validate(); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	question, err := c.service.IQuestions.PatchQuestion(channel, id, item, params.Username)
+	switch {
+	case errors.Is(err, models.ErrQuestionNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotChatModerator), errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServicePatchQuestion)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(question)
+}
+
+func (c *Route) VoteQuestion(w http.ResponseWriter, _ *http.Request, channel string, id types.UUID, params api.VoteQuestionParams) {
+	if params.Username == nil {
+		newErrorResponse(w, http.StatusBadRequest, models.MsgUsernameEmpty, models.MsgUsernameEmpty)
+		return
+	}
+
+	question, err := c.service.IQuestions.VoteQuestion(channel, id, *params.Username)
+	switch {
+	case errors.Is(err, models.ErrQuestionNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrQuestionNotApproved):
+		newErrorResponse(w, http.StatusConflict, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceVoteQuestion)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(question)
+}
+
+func (c *Route) UnvoteQuestion(w http.ResponseWriter, _ *http.Request, channel string, id types.UUID, params api.UnvoteQuestionParams) {
+	if params.Username == nil {
+		newErrorResponse(w, http.StatusBadRequest, models.MsgUsernameEmpty, models.MsgUsernameEmpty)
+		return
+	}
+
+	question, err := c.service.IQuestions.UnvoteQuestion(channel, id, *params.Username)
+	switch {
+	case errors.Is(err, models.ErrQuestionNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceUnvoteQuestion)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(question)
+}
+
+func (c *Route) PinQuestion(w http.ResponseWriter, _ *http.Request, channel string, id types.UUID, params api.PinQuestionParams) {
+	question, err := c.service.IQuestions.PinQuestion(channel, id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrQuestionNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotChatModerator), errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrQuestionDismissed):
+		newErrorResponse(w, http.StatusConflict, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServicePinQuestion)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(question)
+}
+
+func (c *Route) UnpinQuestion(w http.ResponseWriter, _ *http.Request, channel string, id types.UUID, params api.UnpinQuestionParams) {
+	question, err := c.service.IQuestions.UnpinQuestion(channel, id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrQuestionNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotChatModerator), errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceUnpinQuestion)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(question)
+}
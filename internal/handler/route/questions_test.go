@@ -0,0 +1,454 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func newQuestion(state models.QuestionState, votes int, pinned bool) models.Question {
+	id := uuid.New()
+	user := "petrov"
+	text := "When will the recording be available?"
+	date := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	isQuestion := true
+	s := string(state)
+
+	var item models.Question
+	item.Id = &id
+	item.Username = &user
+	item.Text = &text
+	item.Time = &date
+	item.IsQuestion = &isQuestion
+	item.QuestionState = &s
+	item.Votes = &votes
+	item.Pinned = &pinned
+	return item
+}
+
+func TestRoute_GetQuestions(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIQuestions, channel string, states []models.QuestionState)
+
+	channel := uuid.New().String()
+	items := []models.Question{
+		newQuestion(models.QuestionApproved, 2, true),
+		newQuestion(models.QuestionApproved, 5, false),
+	}
+	jsonItems, _ := json.Marshal(items)
+
+	tests := []struct {
+		name                 string
+		query                string
+		states               []models.QuestionState
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:   "Ok",
+			query:  "?state=approved,answered",
+			states: []models.QuestionState{models.QuestionApproved, models.QuestionAnswered},
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, states []models.QuestionState) {
+				r.EXPECT().GetQuestions(channel, states, nil).Return(items, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItems) + "\n",
+		},
+		{
+			name: "All states",
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, states []models.QuestionState) {
+				r.EXPECT().GetQuestions(channel, states, nil).Return(items, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItems) + "\n",
+		},
+		{
+			name:                 "Invalid state",
+			query:                "?state=approved,hidden",
+			mockBehavior:         func(r *mockService.MockIQuestions, channel string, states []models.QuestionState) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidQuestionState + `"}` + "\n",
+		},
+		{
+			name: "No access",
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, states []models.QuestionState) {
+				r.EXPECT().GetQuestions(channel, states, nil).Return(nil, models.ErrNoAccess)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNoAccess + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, states []models.QuestionState) {
+				r.EXPECT().GetQuestions(channel, states, nil).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetQuestions + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIQuestions := mockService.NewMockIQuestions(c)
+			test.mockBehavior(mockIQuestions, channel, test.states)
+
+			services := &service.Service{IQuestions: mockIQuestions}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/questions/"+channel+test.query, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_PatchQuestion(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIQuestions, item models.PatchQuestion)
+
+	channel := uuid.New().String()
+	moderator := "ivanov"
+	res := newQuestion(models.QuestionAnswered, 5, false)
+	id := *res.Id
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"state":"answered"}`,
+			mockBehavior: func(r *mockService.MockIQuestions, item models.PatchQuestion) {
+				r.EXPECT().PatchQuestion(channel, id, item, &moderator).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name:                 "Invalid json",
+			inputBody:            `{"state":`,
+			mockBehavior:         func(r *mockService.MockIQuestions, item models.PatchQuestion) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidJson + `"}` + "\n",
+		},
+		{
+			name:                 "Invalid state",
+			inputBody:            `{"state":"hidden"}`,
+			mockBehavior:         func(r *mockService.MockIQuestions, item models.PatchQuestion) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidQuestionState + `"}` + "\n",
+		},
+		{
+			name:      "Question not found",
+			inputBody: `{"state":"answered"}`,
+			mockBehavior: func(r *mockService.MockIQuestions, item models.PatchQuestion) {
+				r.EXPECT().PatchQuestion(channel, id, item, &moderator).Return(models.Question{}, models.ErrQuestionNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgQuestionNotFound + `"}` + "\n",
+		},
+		{
+			name:      "Not moderator",
+			inputBody: `{"state":"answered"}`,
+			mockBehavior: func(r *mockService.MockIQuestions, item models.PatchQuestion) {
+				r.EXPECT().PatchQuestion(channel, id, item, &moderator).Return(models.Question{}, models.ErrNotChatModerator)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotChatModerator + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: `{"state":"answered"}`,
+			mockBehavior: func(r *mockService.MockIQuestions, item models.PatchQuestion) {
+				r.EXPECT().PatchQuestion(channel, id, item, &moderator).Return(models.Question{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServicePatchQuestion + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			state := string(models.QuestionAnswered)
+			mockIQuestions := mockService.NewMockIQuestions(c)
+			test.mockBehavior(mockIQuestions, models.PatchQuestion{State: &state})
+
+			services := &service.Service{IQuestions: mockIQuestions}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPatch,
+				"/questions/"+channel+"/"+id.String()+"?username="+moderator, bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_VoteQuestion(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor string)
+
+	channel := uuid.New().String()
+	voter := "sidorov"
+	res := newQuestion(models.QuestionApproved, 6, false)
+	id := *res.Id
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		method               string
+		query                string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:   "Vote",
+			method: http.MethodPost,
+			query:  "?username=" + voter,
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor string) {
+				r.EXPECT().VoteQuestion(channel, id, actor).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name:   "Unvote",
+			method: http.MethodDelete,
+			query:  "?username=" + voter,
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor string) {
+				r.EXPECT().UnvoteQuestion(channel, id, actor).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name:                 "Username empty",
+			method:               http.MethodPost,
+			mockBehavior:         func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgUsernameEmpty + `"}` + "\n",
+		},
+		{
+			name:   "Not approved",
+			method: http.MethodPost,
+			query:  "?username=" + voter,
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor string) {
+				r.EXPECT().VoteQuestion(channel, id, actor).Return(models.Question{}, models.ErrQuestionNotApproved)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"code":` + "409" + `,"message":"` + models.MsgQuestionNotApproved + `"}` + "\n",
+		},
+		{
+			name:   "Question not found",
+			method: http.MethodDelete,
+			query:  "?username=" + voter,
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor string) {
+				r.EXPECT().UnvoteQuestion(channel, id, actor).Return(models.Question{}, models.ErrQuestionNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgQuestionNotFound + `"}` + "\n",
+		},
+		{
+			name:   "No access",
+			method: http.MethodPost,
+			query:  "?username=" + voter,
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor string) {
+				r.EXPECT().VoteQuestion(channel, id, actor).Return(models.Question{}, models.ErrNoAccess)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNoAccess + `"}` + "\n",
+		},
+		{
+			name:   "Service failure",
+			method: http.MethodPost,
+			query:  "?username=" + voter,
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor string) {
+				r.EXPECT().VoteQuestion(channel, id, actor).Return(models.Question{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceVoteQuestion + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIQuestions := mockService.NewMockIQuestions(c)
+			test.mockBehavior(mockIQuestions, channel, id, voter)
+
+			services := &service.Service{IQuestions: mockIQuestions}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, "/questions/"+channel+"/"+id.String()+"/vote"+test.query, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_PinQuestion(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor *string)
+
+	channel := uuid.New().String()
+	moderator := "ivanov"
+	res := newQuestion(models.QuestionApproved, 5, true)
+	id := *res.Id
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		method               string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:   "Pin",
+			method: http.MethodPut,
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor *string) {
+				r.EXPECT().PinQuestion(channel, id, actor).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name:   "Unpin",
+			method: http.MethodDelete,
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor *string) {
+				r.EXPECT().UnpinQuestion(channel, id, actor).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name:   "Dismissed",
+			method: http.MethodPut,
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor *string) {
+				r.EXPECT().PinQuestion(channel, id, actor).Return(models.Question{}, models.ErrQuestionDismissed)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"code":` + "409" + `,"message":"` + models.MsgQuestionDismissed + `"}` + "\n",
+		},
+		{
+			name:   "Not moderator",
+			method: http.MethodPut,
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor *string) {
+				r.EXPECT().PinQuestion(channel, id, actor).Return(models.Question{}, models.ErrNotChatModerator)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotChatModerator + `"}` + "\n",
+		},
+		{
+			name:   "Question not found",
+			method: http.MethodDelete,
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor *string) {
+				r.EXPECT().UnpinQuestion(channel, id, actor).Return(models.Question{}, models.ErrQuestionNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgQuestionNotFound + `"}` + "\n",
+		},
+		{
+			name:   "Service failure",
+			method: http.MethodDelete,
+			mockBehavior: func(r *mockService.MockIQuestions, channel string, id uuid.UUID, actor *string) {
+				r.EXPECT().UnpinQuestion(channel, id, actor).Return(models.Question{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceUnpinQuestion + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIQuestions := mockService.NewMockIQuestions(c)
+			test.mockBehavior(mockIQuestions, channel, id, &moderator)
+
+			services := &service.Service{IQuestions: mockIQuestions}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, "/questions/"+channel+"/"+id.String()+"/pin?username="+moderator, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
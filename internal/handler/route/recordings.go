@@ -0,0 +1,186 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) GetBroadcastRecordings(
+	w http.ResponseWriter, _ *http.Request, id types.UUID, params api.GetBroadcastRecordingsParams) {
+	items, err := c.service.IRecordings.GetRecordings(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetRecordings)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(items)
+}
+
+func (c *Route) PostBroadcastRecording(
+	w http.ResponseWriter, r *http.Request, id types.UUID, params api.PostBroadcastRecordingParams) {
+	var input models.PostRecording
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	item, err := c.service.IRecordings.CreateRecording(id, input, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceCreateRecording)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+func (c *Route) UploadBroadcastRecording(
+	w http.ResponseWriter, r *http.Request, id types.UUID, params api.UploadBroadcastRecordingParams) {
+	maxSize, err := c.service.IRecordings.UploadLimit(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceUploadRecording)
+		return
+	}
+
+	body, ok := limitUpload(w, r, maxSize)
+	if !ok || !parseUpload(w, r, body, 32<<20) {
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgNoSuchFile)
+		return
+	}
+	defer file.Close()
+
+	upload := models.RecordingUpload{Name: header.Filename, Content: file}
+	if value := r.FormValue("duration"); len(value) > 0 {
+		duration, err := strconv.Atoi(value)
+		if err != nil {
+			newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidRecordingMeta)
+			return
+		}
+		upload.Duration = &duration
+	}
+
+	if err = upload.Validate(); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	item, err := c.service.IRecordings.UploadRecording(id, upload, params.Username)
+	switch {
+	case errors.Is(err, models.ErrFileTooLarge):
+		newErrorResponse(w, http.StatusRequestEntityTooLarge, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceUploadRecording)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+func (c *Route) DeleteRecording(w http.ResponseWriter, _ *http.Request, id types.UUID, params api.DeleteRecordingParams) {
+	item, err := c.service.IRecordings.DeleteRecording(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrRecordingNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceDeleteRecording)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+// PlayRecording serves stored files with range requests so players can seek.
+func (c *Route) PlayRecording(w http.ResponseWriter, r *http.Request, id types.UUID, params api.PlayRecordingParams) {
+	item, err := c.service.IRecordings.PlayRecording(id, params.Expires, params.Signature)
+	switch {
+	case errors.Is(err, models.ErrInvalidRecordingLink), errors.Is(err, models.ErrRecordingLinkExpired),
+		errors.Is(err, models.ErrRecordingOutside):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrRecordingNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServicePlayRecording)
+		return
+	}
+
+	if item.Content == nil {
+		http.Redirect(w, r, item.Url, http.StatusFound)
+		return
+	}
+	defer item.Content.Close()
+
+	w.Header().Set("Content-Type", item.ContentType)
+	http.ServeContent(w, r, item.Name, item.ModTime, item.Content)
+}
+
+// WatchRecording redirects the stable link of feeds to a freshly signed play url.
+func (c *Route) WatchRecording(w http.ResponseWriter, r *http.Request, id types.UUID, params api.WatchRecordingParams) {
+	playUrl, err := c.service.IRecordings.WatchRecording(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrRecordingNotFound), errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceWatchRecording)
+		return
+	}
+
+	http.Redirect(w, r, playUrl, http.StatusFound)
+}
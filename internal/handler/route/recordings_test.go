@@ -0,0 +1,715 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+type seekCloser struct {
+	*bytes.Reader
+}
+
+func (seekCloser) Close() error {
+	return nil
+}
+
+func newRecording(broadcastId uuid.UUID) models.Recording {
+	id := uuid.New()
+	duration := 3600
+	size := int64(734003200)
+	format := "mp4"
+	playUrl := "https://vp.ru/api/v1/recordings/" + id.String() + "/play?expires=1683000000&signature=ab12"
+	expires := time.Date(2023, 5, 2, 4, 0, 0, 0, time.UTC)
+
+	var item models.Recording
+	item.Id = &id
+	item.BroadcastId = &broadcastId
+	item.Duration = &duration
+	item.Size = &size
+	item.Format = &format
+	item.PlayUrl = &playUrl
+	item.ExpiresAt = &expires
+	return item
+}
+
+func TestRoute_GetBroadcastRecordings(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIRecordings, id uuid.UUID, viewer *string)
+
+	viewer := "petrov"
+	broadcastId := uuid.New()
+	items := []models.Recording{newRecording(broadcastId)}
+	jsonItems, _ := json.Marshal(items)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, viewer *string) {
+				r.EXPECT().GetRecordings(id, viewer).Return(items, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItems) + "\n",
+		},
+		{
+			name: "Broadcast not found",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, viewer *string) {
+				r.EXPECT().GetRecordings(id, viewer).Return(nil, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
+		{
+			name: "No access",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, viewer *string) {
+				r.EXPECT().GetRecordings(id, viewer).Return(nil, models.ErrNoAccess)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNoAccess + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, viewer *string) {
+				r.EXPECT().GetRecordings(id, viewer).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetRecordings + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIRecordings := mockService.NewMockIRecordings(c)
+			test.mockBehavior(mockIRecordings, broadcastId, &viewer)
+
+			services := &service.Service{IRecordings: mockIRecordings}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet,
+				"/broadcasts/"+broadcastId.String()+"/recordings?username="+viewer, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_PostBroadcastRecording(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIRecordings, id uuid.UUID, item models.PostRecording, actor *string)
+
+	owner := "ivanov"
+	broadcastId := uuid.New()
+	url := "https://video.vp.ru/town-hall.mp4"
+	duration := 3600
+
+	input := models.PostRecording{Url: &url, Duration: &duration}
+	jsonInput, _ := json.Marshal(input)
+
+	res := newRecording(broadcastId)
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, item models.PostRecording, actor *string) {
+				r.EXPECT().CreateRecording(id, item, actor).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name:                 "Invalid json",
+			inputBody:            `{"url":`,
+			mockBehavior:         func(r *mockService.MockIRecordings, id uuid.UUID, item models.PostRecording, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidJson + `"}` + "\n",
+		},
+		{
+			name:                 "Invalid url",
+			inputBody:            `{"url":"/var/rec/town-hall.mp4"}`,
+			mockBehavior:         func(r *mockService.MockIRecordings, id uuid.UUID, item models.PostRecording, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidRecordingUrl + `"}` + "\n",
+		},
+		{
+			name:                 "Negative duration",
+			inputBody:            `{"url":"https://video.vp.ru/town-hall.mp4","duration":-1}`,
+			mockBehavior:         func(r *mockService.MockIRecordings, id uuid.UUID, item models.PostRecording, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidRecordingMeta + `"}` + "\n",
+		},
+		{
+			name:      "Not owner",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, item models.PostRecording, actor *string) {
+				r.EXPECT().CreateRecording(id, item, actor).Return(models.Recording{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name:      "Broadcast not found",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, item models.PostRecording, actor *string) {
+				r.EXPECT().CreateRecording(id, item, actor).Return(models.Recording{}, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, item models.PostRecording, actor *string) {
+				r.EXPECT().CreateRecording(id, item, actor).Return(models.Recording{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceCreateRecording + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIRecordings := mockService.NewMockIRecordings(c)
+			test.mockBehavior(mockIRecordings, broadcastId, input, &owner)
+
+			services := &service.Service{IRecordings: mockIRecordings}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost,
+				"/broadcasts/"+broadcastId.String()+"/recordings?username="+owner, bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func newRecordingRequest(t *testing.T, path, name string, data []byte, duration string) *http.Request {
+	body := new(bytes.Buffer)
+	mw := multipart.NewWriter(body)
+	if name != "" {
+		fw, err := mw.CreateFormFile("file", name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = fw.Write(data)
+	}
+	if duration != "" {
+		_ = mw.WriteField("duration", duration)
+	}
+	_ = mw.Close()
+
+	req := httptest.NewRequest(http.MethodPost, path, body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return req
+}
+
+func TestRoute_UploadBroadcastRecording(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIRecordings, id uuid.UUID, actor *string)
+
+	owner := "ivanov"
+	broadcastId := uuid.New()
+	data := []byte("\x00\x00\x00\x18ftypmp42")
+	maxSize := int64(1 << 10)
+
+	res := newRecording(broadcastId)
+	jsonRes, _ := json.Marshal(res)
+
+	upload := func(err error) func(types.UUID, models.RecordingUpload, *string) (models.Recording, error) {
+		return func(_ types.UUID, item models.RecordingUpload, _ *string) (models.Recording, error) {
+			content, _ := ioutil.ReadAll(item.Content)
+			assert.Equal(t, item.Name, "town-hall.mp4")
+			assert.Equal(t, *item.Duration, 3600)
+			assert.Equal(t, content, data)
+			if err != nil {
+				return models.Recording{}, err
+			}
+			return res, nil
+		}
+	}
+
+	tests := []struct {
+		name                 string
+		fileName             string
+		duration             string
+		data                 []byte
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:     "Ok",
+			fileName: "town-hall.mp4",
+			duration: "3600",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().UploadLimit(id, actor).Return(maxSize, nil)
+				r.EXPECT().UploadRecording(id, gomock.Any(), actor).DoAndReturn(upload(nil))
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name: "No file",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().UploadLimit(id, actor).Return(maxSize, nil)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgNoSuchFile + `"}` + "\n",
+		},
+		{
+			name:     "Invalid format",
+			fileName: "town-hall.pdf",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().UploadLimit(id, actor).Return(maxSize, nil)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidRecording + `"}` + "\n",
+		},
+		{
+			name:     "Invalid duration",
+			fileName: "town-hall.mp4",
+			duration: "1h",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().UploadLimit(id, actor).Return(maxSize, nil)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidRecordingMeta + `"}` + "\n",
+		},
+		{
+			name:     "Body is too large",
+			fileName: "town-hall.mp4",
+			duration: "3600",
+			data:     make([]byte, maxSize+multipartOverhead),
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().UploadLimit(id, actor).Return(maxSize, nil)
+			},
+			expectedStatusCode:   413,
+			expectedResponseBody: `{"code":` + "413" + `,"message":"` + models.MsgFileTooLarge + `"}` + "\n",
+		},
+		{
+			name:     "Too large",
+			fileName: "town-hall.mp4",
+			duration: "3600",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().UploadLimit(id, actor).Return(maxSize, nil)
+				r.EXPECT().UploadRecording(id, gomock.Any(), actor).DoAndReturn(upload(models.ErrFileTooLarge))
+			},
+			expectedStatusCode:   413,
+			expectedResponseBody: `{"code":` + "413" + `,"message":"` + models.MsgFileTooLarge + `"}` + "\n",
+		},
+		{
+			name:     "Not owner",
+			fileName: "town-hall.mp4",
+			duration: "3600",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().UploadLimit(id, actor).Return(int64(0), models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name:     "Broadcast not found",
+			fileName: "town-hall.mp4",
+			duration: "3600",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().UploadLimit(id, actor).Return(int64(0), models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
+		{
+			name:     "Service failure",
+			fileName: "town-hall.mp4",
+			duration: "3600",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().UploadLimit(id, actor).Return(maxSize, nil)
+				r.EXPECT().UploadRecording(id, gomock.Any(), actor).DoAndReturn(upload(errors.New("error")))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceUploadRecording + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIRecordings := mockService.NewMockIRecordings(c)
+			test.mockBehavior(mockIRecordings, broadcastId, &owner)
+
+			services := &service.Service{IRecordings: mockIRecordings}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			body := test.data
+			if body == nil {
+				body = data
+			}
+			w := httptest.NewRecorder()
+			req := newRecordingRequest(t, "/broadcasts/"+broadcastId.String()+"/recordings/upload?username="+owner,
+				test.fileName, body, test.duration)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_DeleteRecording(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIRecordings, id uuid.UUID, actor *string)
+
+	owner := "ivanov"
+	id := uuid.New()
+	res := api.SIdentifier{Id: &id}
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteRecording(id, actor).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name: "Recording not found",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteRecording(id, actor).Return(api.SIdentifier{}, models.ErrRecordingNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgRecordingNotFound + `"}` + "\n",
+		},
+		{
+			name: "Not owner",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteRecording(id, actor).Return(api.SIdentifier{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteRecording(id, actor).Return(api.SIdentifier{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceDeleteRecording + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIRecordings := mockService.NewMockIRecordings(c)
+			test.mockBehavior(mockIRecordings, id, &owner)
+
+			services := &service.Service{IRecordings: mockIRecordings}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, "/recordings/"+id.String()+"?username="+owner, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_PlayRecording(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIRecordings, id uuid.UUID)
+
+	id := uuid.New()
+	expires := int64(1683000000)
+	signature := "ab12"
+	data := []byte("\x00\x00\x00\x18ftypmp42")
+	modTime := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	url := "https://video.vp.ru/town-hall.mp4"
+
+	file := func() models.RecordingMedia {
+		return models.RecordingMedia{Name: "town-hall.mp4", ContentType: "video/mp4", ModTime: modTime,
+			Content: seekCloser{bytes.NewReader(data)}}
+	}
+
+	tests := []struct {
+		name                 string
+		rangeHeader          string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedHeader       string
+		expectedHeaderValue  string
+		expectedResponseBody string
+	}{
+		{
+			name: "File",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID) {
+				r.EXPECT().PlayRecording(id, expires, signature).Return(file(), nil)
+			},
+			expectedStatusCode:   200,
+			expectedHeader:       "Content-Type",
+			expectedHeaderValue:  "video/mp4",
+			expectedResponseBody: string(data),
+		},
+		{
+			name:        "Range",
+			rangeHeader: "bytes=4-7",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID) {
+				r.EXPECT().PlayRecording(id, expires, signature).Return(file(), nil)
+			},
+			expectedStatusCode:   206,
+			expectedHeader:       "Content-Range",
+			expectedHeaderValue:  "bytes 4-7/12",
+			expectedResponseBody: "ftyp",
+		},
+		{
+			name: "Url",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID) {
+				r.EXPECT().PlayRecording(id, expires, signature).Return(models.RecordingMedia{Url: url}, nil)
+			},
+			expectedStatusCode:   302,
+			expectedHeader:       "Location",
+			expectedHeaderValue:  url,
+			expectedResponseBody: `<a href="` + url + `">Found</a>.` + "\n\n",
+		},
+		{
+			name: "Invalid signature",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID) {
+				r.EXPECT().PlayRecording(id, expires, signature).Return(models.RecordingMedia{}, models.ErrInvalidRecordingLink)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgInvalidRecordingLink + `"}` + "\n",
+		},
+		{
+			name: "Expired",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID) {
+				r.EXPECT().PlayRecording(id, expires, signature).Return(models.RecordingMedia{}, models.ErrRecordingLinkExpired)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgRecordingLinkExpired + `"}` + "\n",
+		},
+		{
+			name: "Recording not found",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID) {
+				r.EXPECT().PlayRecording(id, expires, signature).Return(models.RecordingMedia{}, models.ErrRecordingNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgRecordingNotFound + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID) {
+				r.EXPECT().PlayRecording(id, expires, signature).Return(models.RecordingMedia{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServicePlayRecording + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIRecordings := mockService.NewMockIRecordings(c)
+			test.mockBehavior(mockIRecordings, id)
+
+			services := &service.Service{IRecordings: mockIRecordings}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet,
+				"/recordings/"+id.String()+"/play?expires=1683000000&signature="+signature, nil)
+			if test.rangeHeader != "" {
+				req.Header.Set("Range", test.rangeHeader)
+			}
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+			if test.expectedHeader != "" {
+				assert.Equal(t, w.Header().Get(test.expectedHeader), test.expectedHeaderValue)
+			}
+		})
+	}
+}
+
+func TestRoute_WatchRecording(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIRecordings, id uuid.UUID)
+
+	id := uuid.New()
+	username := "test"
+	playUrl := "https://video.vp.ru/api/recordings/" + id.String() + "/play?expires=1683000000&signature=ab12"
+
+	tests := []struct {
+		name                 string
+		query                string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID) {
+				r.EXPECT().WatchRecording(id, nil).Return(playUrl, nil)
+			},
+			expectedStatusCode:   302,
+			expectedResponseBody: `<a href="` + strings.ReplaceAll(playUrl, "&", "&amp;") + `">Found</a>.` + "\n\n",
+		},
+		{
+			name:  "No access",
+			query: "?username=" + username,
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID) {
+				r.EXPECT().WatchRecording(id, &username).Return("", models.ErrNoAccess)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNoAccess + `"}` + "\n",
+		},
+		{
+			name: "Recording not found",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID) {
+				r.EXPECT().WatchRecording(id, nil).Return("", models.ErrRecordingNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgRecordingNotFound + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIRecordings, id uuid.UUID) {
+				r.EXPECT().WatchRecording(id, nil).Return("", errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceWatchRecording + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIRecordings := mockService.NewMockIRecordings(c)
+			test.mockBehavior(mockIRecordings, id)
+
+			services := &service.Service{IRecordings: mockIRecordings}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/recordings/"+id.String()+"/watch"+test.query, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+			if test.expectedStatusCode == http.StatusFound {
+				assert.Equal(t, w.Header().Get("Location"), playUrl)
+			}
+		})
+	}
+}
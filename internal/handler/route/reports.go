@@ -0,0 +1,92 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) GetBroadcastReport(
+	w http.ResponseWriter, _ *http.Request, id types.UUID, params api.GetBroadcastReportParams) {
+	format, err := models.ParseReportFormat(params.Format)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	item, err := c.service.IReports.GetReport(id, params.Username, format)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetReport)
+		return
+	}
+
+	w.Header().Set("Content-Type", item.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(item.Data)))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": item.Name}))
+	_, _ = io.Copy(w, bytes.NewReader(item.Data))
+}
+
+func (c *Route) PutBroadcastReportMail(
+	w http.ResponseWriter, r *http.Request, id types.UUID, params api.PutBroadcastReportMailParams) {
+	var input models.PutReportMail
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	item, err := c.service.IReports.SaveReportMail(id, params.Username, input)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceSaveReportMail)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+func (c *Route) DeleteBroadcastReportMail(
+	w http.ResponseWriter, _ *http.Request, id types.UUID, params api.DeleteBroadcastReportMailParams) {
+	item, err := c.service.IReports.DeleteReportMail(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound), errors.Is(err, models.ErrReportMailNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceDeleteReportMail)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
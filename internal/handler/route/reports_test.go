@@ -0,0 +1,314 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func TestRoute_GetBroadcastReport(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIReports, id uuid.UUID, actor *string)
+
+	id := uuid.New()
+	actor := "owner"
+
+	csvFile := models.ReportFile{
+		Name:        "report-" + id.String() + ".csv",
+		ContentType: models.ContentTypeCSV,
+		Data:        []byte("Statistics\nMessages,10\n"),
+	}
+	xlsxFile := models.ReportFile{
+		Name:        "report-" + id.String() + ".xlsx",
+		ContentType: models.ContentTypeXLSX,
+		Data:        []byte("PK"),
+	}
+
+	tests := []struct {
+		name                 string
+		query                string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedContentType  string
+		expectedDisposition  string
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok csv",
+			mockBehavior: func(r *mockService.MockIReports, id uuid.UUID, actor *string) {
+				r.EXPECT().GetReport(id, actor, models.ReportCSV).Return(csvFile, nil)
+			},
+			expectedStatusCode:   200,
+			expectedContentType:  models.ContentTypeCSV,
+			expectedDisposition:  "attachment; filename=report-" + id.String() + ".csv",
+			expectedResponseBody: string(csvFile.Data),
+		},
+		{
+			name:  "Ok xlsx",
+			query: "&format=xlsx",
+			mockBehavior: func(r *mockService.MockIReports, id uuid.UUID, actor *string) {
+				r.EXPECT().GetReport(id, actor, models.ReportXLSX).Return(xlsxFile, nil)
+			},
+			expectedStatusCode:   200,
+			expectedContentType:  models.ContentTypeXLSX,
+			expectedDisposition:  "attachment; filename=report-" + id.String() + ".xlsx",
+			expectedResponseBody: string(xlsxFile.Data),
+		},
+		{
+			name:                 "Invalid format",
+			query:                "&format=pdf",
+			mockBehavior:         func(r *mockService.MockIReports, id uuid.UUID, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidReportFormat + `"}` + "\n",
+		},
+		{
+			name: "Not found",
+			mockBehavior: func(r *mockService.MockIReports, id uuid.UUID, actor *string) {
+				r.EXPECT().GetReport(id, actor, models.ReportCSV).Return(models.ReportFile{}, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.ErrBroadcastNotFound.Error() + `"}` + "\n",
+		},
+		{
+			name: "Not owner",
+			mockBehavior: func(r *mockService.MockIReports, id uuid.UUID, actor *string) {
+				r.EXPECT().GetReport(id, actor, models.ReportCSV).Return(models.ReportFile{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.ErrNotOwner.Error() + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIReports, id uuid.UUID, actor *string) {
+				r.EXPECT().GetReport(id, actor, models.ReportCSV).Return(models.ReportFile{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetReport + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIReports := mockService.NewMockIReports(c)
+			test.mockBehavior(mockIReports, id, &actor)
+
+			services := &service.Service{IReports: mockIReports}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/broadcasts/"+id.String()+"/report?username="+actor+test.query, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+			assert.Equal(t, w.Header().Get("Content-Type"), test.expectedContentType)
+			assert.Equal(t, w.Header().Get("Content-Disposition"), test.expectedDisposition)
+		})
+	}
+}
+
+func TestRoute_PutBroadcastReportMail(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIReports, id uuid.UUID, actor *string, item models.PutReportMail)
+
+	id := uuid.New()
+	actor := "owner"
+	email := "owner@vp.ru"
+	createdAt := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	input := models.PutReportMail{Email: &email}
+	jsonInput, _ := json.Marshal(input)
+
+	res := models.ReportMail{BroadcastId: id}
+	res.Email = &email
+	res.CreatedAt = &createdAt
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIReports, id uuid.UUID, actor *string, item models.PutReportMail) {
+				r.EXPECT().SaveReportMail(id, actor, item).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name:                 "Invalid json",
+			inputBody:            `{"email":`,
+			mockBehavior:         func(r *mockService.MockIReports, id uuid.UUID, actor *string, item models.PutReportMail) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidJson + `"}` + "\n",
+		},
+		{
+			name:                 "Empty email",
+			inputBody:            `{"email":" "}`,
+			mockBehavior:         func(r *mockService.MockIReports, id uuid.UUID, actor *string, item models.PutReportMail) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgEmailEmpty + `"}` + "\n",
+		},
+		{
+			name:      "Not owner",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIReports, id uuid.UUID, actor *string, item models.PutReportMail) {
+				r.EXPECT().SaveReportMail(id, actor, item).Return(models.ReportMail{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.ErrNotOwner.Error() + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIReports, id uuid.UUID, actor *string, item models.PutReportMail) {
+				r.EXPECT().SaveReportMail(id, actor, item).Return(models.ReportMail{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceSaveReportMail + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIReports := mockService.NewMockIReports(c)
+			test.mockBehavior(mockIReports, id, &actor, input)
+
+			services := &service.Service{IReports: mockIReports}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, "/broadcasts/"+id.String()+"/report/mail?username="+actor,
+				bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_DeleteBroadcastReportMail(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIReports, id uuid.UUID, actor *string)
+
+	id := uuid.New()
+	actor := "owner"
+	email := "owner@vp.ru"
+
+	res := models.ReportMail{BroadcastId: id}
+	res.Email = &email
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIReports, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteReportMail(id, actor).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name: "Report mail not found",
+			mockBehavior: func(r *mockService.MockIReports, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteReportMail(id, actor).Return(models.ReportMail{}, models.ErrReportMailNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgReportMailNotFound + `"}` + "\n",
+		},
+		{
+			name: "Not owner",
+			mockBehavior: func(r *mockService.MockIReports, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteReportMail(id, actor).Return(models.ReportMail{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.ErrNotOwner.Error() + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIReports, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteReportMail(id, actor).Return(models.ReportMail{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceDeleteReportMail + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIReports := mockService.NewMockIReports(c)
+			test.mockBehavior(mockIReports, id, &actor)
+
+			services := &service.Service{IReports: mockIReports}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, "/broadcasts/"+id.String()+"/report/mail?username="+actor, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
@@ -2,8 +2,15 @@ package route
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
 )
 
 type Error struct {
@@ -16,3 +23,107 @@ func newErrorResponse(w http.ResponseWriter, code int, errMsg, msg string) {
 	w.WriteHeader(code)
 	_ = json.NewEncoder(w).Encode(Error{Code: int32(code), Message: msg})
 }
+
+func newConflictResponse(w http.ResponseWriter, err *models.ConflictError) {
+	log.Println(err.Error())
+	code, msg := http.StatusConflict, err.Error()
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(api.SConflict{Code: &code, Message: &msg, Conflicts: &err.Conflicts})
+}
+
+// multipartOverhead leaves room for the boundaries and part headers around an
+// uploaded file of the maximum size.
+const multipartOverhead = 1 << 20
+
+// limitedBody counts the bytes read through http.MaxBytesReader, which reads one
+// byte past its limit before failing, to tell an oversized body from a broken one.
+type limitedBody struct {
+	io.ReadCloser
+	read, limit int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.read += int64(n)
+	return n, err
+}
+
+func (b *limitedBody) tooLarge() bool {
+	return b.read > b.limit
+}
+
+// limitUpload caps the body of a multipart upload of a file up to maxSize bytes,
+// a larger Content-Length is answered with 413 before anything is read.
+func limitUpload(w http.ResponseWriter, r *http.Request, maxSize int64) (*limitedBody, bool) {
+	limit := maxSize + multipartOverhead
+	if r.ContentLength > limit {
+		newErrorResponse(w, http.StatusRequestEntityTooLarge, models.MsgFileTooLarge, models.MsgFileTooLarge)
+		return nil, false
+	}
+	body := &limitedBody{ReadCloser: r.Body, limit: limit}
+	r.Body = http.MaxBytesReader(w, body, limit)
+	return body, true
+}
+
+// parseUpload parses the multipart form of a body capped by limitUpload.
+func parseUpload(w http.ResponseWriter, r *http.Request, body *limitedBody, maxMemory int64) bool {
+	err := r.ParseMultipartForm(maxMemory)
+	switch {
+	case err != nil && body.tooLarge():
+		newErrorResponse(w, http.StatusRequestEntityTooLarge, err.Error(), models.MsgFileTooLarge)
+		return false
+	case err != nil:
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgNoSuchFile)
+		return false
+	}
+	return true
+}
+
+func setPageHeaders(w http.ResponseWriter, r *http.Request, total int, next *models.Cursor) {
+	w.Header().Set("X-Total-Count", strconv.Itoa(total))
+	if next == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	query.Set("cursor", next.Encode())
+	link := *r.URL
+	link.RawQuery = query.Encode()
+	w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, link.RequestURI()))
+}
+
+// setHistoryLinks links to older messages with rel="prev" and to newer ones
+// with rel="next".
+func setHistoryLinks(w http.ResponseWriter, r *http.Request, prev, next *models.Cursor) {
+	links := make([]string, 0, 2)
+	for _, l := range []struct {
+		param, rel string
+		cursor     *models.Cursor
+	}{{"before", "prev", prev}, {"after", "next", next}} {
+		if l.cursor == nil {
+			continue
+		}
+		query := r.URL.Query()
+		query.Del("before")
+		query.Del("after")
+		query.Set(l.param, l.cursor.Encode())
+		link := *r.URL
+		link.RawQuery = query.Encode()
+		links = append(links, fmt.Sprintf(`<%s>; rel="%s"`, link.RequestURI(), l.rel))
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+}
+
+// setFacetsHeader sets the number of broadcasts per tag, e.g. demo=3, training=5.
+func setFacetsHeader(w http.ResponseWriter, facets []models.Facet) {
+	if len(facets) == 0 {
+		return
+	}
+	values := make([]string, 0, len(facets))
+	for _, f := range facets {
+		values = append(values, fmt.Sprintf("%s=%d", f.Tag, f.Count))
+	}
+	w.Header().Set("X-Facets", strings.Join(values, ", "))
+}
@@ -0,0 +1,82 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) GetBroadcastRevisions(
+	w http.ResponseWriter, _ *http.Request, id types.UUID, params api.GetBroadcastRevisionsParams) {
+	items, err := c.service.IRevisions.GetRevisions(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrBroadcastNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNoAccess):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetRevisions)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(items)
+}
+
+func (c *Route) RevertBroadcastRevision(w http.ResponseWriter, r *http.Request, id types.UUID, revision types.UUID,
+	params api.RevertBroadcastRevisionParams) {
+	version, err := models.ParseIfMatch(params.IfMatch)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	var user api.SUsername
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+	if user.Username == nil {
+		newErrorResponse(w, http.StatusBadRequest, models.MsgUsernameEmpty, models.MsgUsernameEmpty)
+		return
+	}
+
+	var conflict *models.ConflictError
+	force := params.Force != nil && *params.Force
+	item, err := c.service.IRevisions.RevertRevision(id, revision, user, version, force)
+	switch {
+	case errors.As(err, &conflict):
+		newConflictResponse(w, conflict)
+		return
+	case errors.Is(err, models.ErrBroadcastNotFound), errors.Is(err, models.ErrRevisionNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner), errors.Is(err, models.ErrNotAdmin):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrVersionMismatch):
+		w.Header().Set("ETag", models.ETag(item.Version))
+		w.WriteHeader(http.StatusPreconditionFailed)
+		_ = json.NewEncoder(w).Encode(item)
+		return
+	case errors.Is(err, models.ErrUnknownTag):
+		newErrorResponse(w, http.StatusConflict, err.Error(), models.MsgUnknownTag)
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceRevertRevision)
+		return
+	}
+
+	if item.Id != nil {
+		w.Header().Set("ETag", models.ETag(item.Version))
+	}
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
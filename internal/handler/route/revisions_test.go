@@ -0,0 +1,284 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func TestRoute_GetBroadcastRevisions(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIRevisions, id uuid.UUID)
+
+	id := uuid.New()
+	owner := "test"
+	revisions := []models.Revision{
+		{
+			Id:          uuid.New(),
+			BroadcastId: id,
+			Action:      models.RevisionUpdate,
+			Actor:       &owner,
+			Changes:     models.RevisionChanges{{Field: "name", Old: "old", New: "new"}},
+			CreatedAt:   time.Date(2022, time.July, 1, 8, 0, 0, 0, time.UTC),
+		},
+	}
+
+	jsonRevisions, _ := json.Marshal(revisions)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIRevisions, id uuid.UUID) {
+				r.EXPECT().GetRevisions(id, &owner).Return(revisions, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRevisions) + "\n",
+		},
+		{
+			name: "Broadcast not found",
+			mockBehavior: func(r *mockService.MockIRevisions, id uuid.UUID) {
+				r.EXPECT().GetRevisions(id, &owner).Return(nil, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
+		{
+			name: "No access",
+			mockBehavior: func(r *mockService.MockIRevisions, id uuid.UUID) {
+				r.EXPECT().GetRevisions(id, &owner).Return(nil, models.ErrNoAccess)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNoAccess + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIRevisions, id uuid.UUID) {
+				r.EXPECT().GetRevisions(id, &owner).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetRevisions + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIRevisions := mockService.NewMockIRevisions(c)
+			test.mockBehavior(mockIRevisions, id)
+
+			services := &service.Service{IRevisions: mockIRevisions}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			path := "/broadcasts/" + id.String() + "/revisions?username=" + owner
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_RevertBroadcastRevision(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIRevisions, id, revision uuid.UUID, user api.SUsername)
+
+	id := uuid.New()
+	revision := uuid.New()
+	name := "test"
+	owner := "test"
+
+	user := api.SUsername{Username: &owner}
+	broadcast := models.Broadcasts{
+		SIdentifier: api.SIdentifier{Id: &id},
+		SBroadcast:  api.SBroadcast{Name: &name, Owner: &owner},
+	}
+
+	jsonUser, _ := json.Marshal(user)
+	jsonBroadcast, _ := json.Marshal(broadcast)
+
+	version := 2
+	start := time.Now().Add(time.Hour)
+	end := start.Add(time.Hour)
+	conflictId := uuid.New()
+	conflict := &models.ConflictError{Conflicts: []api.SConflictItem{
+		{Id: &conflictId, Name: &name, Owner: &owner, StartTime: &start, EndTime: &end},
+	}}
+	conflictCode, conflictMsg := 409, models.MsgScheduleConflict
+	jsonConflict, _ := json.Marshal(api.SConflict{Code: &conflictCode, Message: &conflictMsg, Conflicts: &conflict.Conflicts})
+
+	tests := []struct {
+		name                 string
+		query                string
+		ifMatch              string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIRevisions, id, revision uuid.UUID, user api.SUsername) {
+				r.EXPECT().RevertRevision(id, revision, user, nil, false).Return(broadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:      "Ok with If-Match and force",
+			query:     "?force=true",
+			ifMatch:   `"2"`,
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIRevisions, id, revision uuid.UUID, user api.SUsername) {
+				r.EXPECT().RevertRevision(id, revision, user, &version, true).Return(broadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:                 "Invalid If-Match",
+			ifMatch:              "some",
+			inputBody:            string(jsonUser),
+			mockBehavior:         func(r *mockService.MockIRevisions, id, revision uuid.UUID, user api.SUsername) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidIfMatch + `"}` + "\n",
+		},
+		{
+			name:      "Version mismatch",
+			ifMatch:   `"2"`,
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIRevisions, id, revision uuid.UUID, user api.SUsername) {
+				r.EXPECT().RevertRevision(id, revision, user, &version, false).Return(broadcast, models.ErrVersionMismatch)
+			},
+			expectedStatusCode:   412,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:      "Schedule conflict",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIRevisions, id, revision uuid.UUID, user api.SUsername) {
+				r.EXPECT().RevertRevision(id, revision, user, nil, false).Return(models.Broadcasts{}, conflict)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: string(jsonConflict) + "\n",
+		},
+		{
+			name:      "Force by non-admin",
+			query:     "?force=true",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIRevisions, id, revision uuid.UUID, user api.SUsername) {
+				r.EXPECT().RevertRevision(id, revision, user, nil, true).Return(models.Broadcasts{}, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name:                 "Username field is empty",
+			inputBody:            "{}",
+			mockBehavior:         func(r *mockService.MockIRevisions, id, revision uuid.UUID, user api.SUsername) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgUsernameEmpty + `"}` + "\n",
+		},
+		{
+			name:      "Revision not found",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIRevisions, id, revision uuid.UUID, user api.SUsername) {
+				r.EXPECT().RevertRevision(id, revision, user, nil, false).Return(models.Broadcasts{}, models.ErrRevisionNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgRevisionNotFound + `"}` + "\n",
+		},
+		{
+			name:      "Broadcast not found",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIRevisions, id, revision uuid.UUID, user api.SUsername) {
+				r.EXPECT().RevertRevision(id, revision, user, nil, false).Return(models.Broadcasts{}, models.ErrBroadcastNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgBroadcastNotFound + `"}` + "\n",
+		},
+		{
+			name:      "Not owner",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIRevisions, id, revision uuid.UUID, user api.SUsername) {
+				r.EXPECT().RevertRevision(id, revision, user, nil, false).Return(models.Broadcasts{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIRevisions, id, revision uuid.UUID, user api.SUsername) {
+				r.EXPECT().RevertRevision(id, revision, user, nil, false).Return(models.Broadcasts{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceRevertRevision + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIRevisions := mockService.NewMockIRevisions(c)
+			test.mockBehavior(mockIRevisions, id, revision, user)
+
+			services := &service.Service{IRevisions: mockIRevisions}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			path := "/broadcasts/" + id.String() + "/revisions/" + revision.String() + "/revert" + test.query
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(test.inputBody))
+			if len(test.ifMatch) > 0 {
+				req.Header.Set("If-Match", test.ifMatch)
+			}
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package route
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) Search(w http.ResponseWriter, _ *http.Request, params api.SearchParams) {
+	q, err := models.NewSearchQuery(params)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	res, err := c.service.ISearch.Search(q)
+	if err != nil {
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceSearch)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(res)
+}
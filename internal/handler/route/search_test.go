@@ -0,0 +1,119 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func TestRoute_Search(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockISearch, q models.SearchQuery)
+
+	id := uuid.New()
+	name := "Town hall"
+	owner := "test"
+	life := "created"
+	date := time.Date(2022, time.July, 1, 8, 0, 0, 0, time.UTC)
+	var rank float32 = 0.6
+	headline := "<b>Town</b> hall. Quarterly results"
+	channel := id.String()
+	fullname := "test test"
+
+	user := "test"
+	query := models.SearchQuery{Text: "town", Username: &user, Limit: models.DefaultPageLimit}
+
+	res := models.Search{
+		Broadcasts: []api.SSearchBroadcast{
+			{Id: &id, Name: &name, Owner: &owner, Life: &life, StartTime: &date, Rank: &rank, Headline: &headline},
+		},
+		Messages: []api.SSearchMessage{
+			{Id: &id, Channel: &channel, Username: &user, Fullname: &fullname, Time: &date, Rank: &rank, Headline: &headline},
+		},
+	}
+
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		query                string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:  "Ok",
+			query: "?q=town&username=test",
+			mockBehavior: func(r *mockService.MockISearch, q models.SearchQuery) {
+				r.EXPECT().Search(q).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name:                 "Query is empty",
+			query:                "?q=%20&username=test",
+			mockBehavior:         func(r *mockService.MockISearch, q models.SearchQuery) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgQueryEmpty + `"}` + "\n",
+		},
+		{
+			name:                 "Invalid limit",
+			query:                "?q=town&limit=0",
+			mockBehavior:         func(r *mockService.MockISearch, q models.SearchQuery) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidLimit + `"}` + "\n",
+		},
+		{
+			name:  "Service failure",
+			query: "?q=town&username=test",
+			mockBehavior: func(r *mockService.MockISearch, q models.SearchQuery) {
+				r.EXPECT().Search(q).Return(models.Search{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceSearch + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockISearch := mockService.NewMockISearch(c)
+			test.mockBehavior(mockISearch, query)
+
+			services := &service.Service{ISearch: mockISearch}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/search"+test.query, nil)
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
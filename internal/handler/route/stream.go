@@ -2,6 +2,7 @@ package route
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/alexm24/golang/internal/handler/api"
@@ -15,6 +16,9 @@ func (c *Route) GetStreamByUsername(w http.ResponseWriter, _ *http.Request, user
 		return
 	}
 
+	if stream.Id != nil {
+		w.Header().Set("ETag", models.ETag(stream.Version))
+	}
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(stream)
 }
@@ -42,7 +46,41 @@ func (c *Route) PostStream(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(stream)
 }
 
-func (c *Route) PutStream(w http.ResponseWriter, r *http.Request) {
+func (c *Route) RotateUserStreamKey(w http.ResponseWriter, r *http.Request, username string) {
+	var user api.SUsername
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+	if user.Username == nil {
+		newErrorResponse(w, http.StatusBadRequest, models.MsgUsernameEmpty, models.MsgUsernameEmpty)
+		return
+	}
+
+	stream, err := c.service.IStream.RotateStreamKey(username, user)
+	switch {
+	case errors.Is(err, models.ErrStreamNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotOwner):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceRotateUserStreamKey)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(stream)
+}
+
+func (c *Route) PutStream(w http.ResponseWriter, r *http.Request, params api.PutStreamParams) {
+	version, err := models.ParseIfMatch(params.IfMatch)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
 	var item models.PutStream
 	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
 		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
@@ -54,12 +92,22 @@ func (c *Route) PutStream(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := c.service.IStream.ChangeDescByUsername(item)
-	if err != nil {
+	res, err := c.service.IStream.ChangeDescByUsername(item, version)
+	switch {
+	case errors.Is(err, models.ErrStreamNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrVersionMismatch):
+		w.Header().Set("ETag", models.ETag(res.Version))
+		w.WriteHeader(http.StatusPreconditionFailed)
+		_ = json.NewEncoder(w).Encode(res)
+		return
+	case err != nil:
 		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceChangeDescByUsername)
 		return
 	}
 
+	w.Header().Set("ETag", models.ETag(res.Version))
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(res)
 }
@@ -182,6 +182,107 @@ func TestRoute_GetStreamByUsername(t *testing.T) {
 	}
 }
 
+func TestRoute_RotateUserStreamKey(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIStream, username string, user api.SUsername)
+
+	owner := "test"
+	key := "qwerty"
+	id := uuid.New()
+
+	user := api.SUsername{Username: &owner}
+	stream := models.Stream{
+		SIdentifier:  api.SIdentifier{Id: &id},
+		SUsername:    user,
+		SDescription: api.SDescription{Description: &owner},
+		SStreamKey:   api.SStreamKey{StreamKey: &key},
+	}
+
+	jsonUser, _ := json.Marshal(user)
+	jsonStream, _ := json.Marshal(stream)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIStream, username string, user api.SUsername) {
+				r.EXPECT().RotateStreamKey(username, user).Return(stream, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonStream) + "\n",
+		},
+		{
+			name:                 "Username field is empty",
+			inputBody:            "{}",
+			mockBehavior:         func(r *mockService.MockIStream, username string, user api.SUsername) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgUsernameEmpty + `"}` + "\n",
+		},
+		{
+			name:      "Stream not found",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIStream, username string, user api.SUsername) {
+				r.EXPECT().RotateStreamKey(username, user).Return(models.Stream{}, models.ErrStreamNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgStreamNotFound + `"}` + "\n",
+		},
+		{
+			name:      "Not owner",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIStream, username string, user api.SUsername) {
+				r.EXPECT().RotateStreamKey(username, user).Return(models.Stream{}, models.ErrNotOwner)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotOwner + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockIStream, username string, user api.SUsername) {
+				r.EXPECT().RotateStreamKey(username, user).Return(models.Stream{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceRotateUserStreamKey + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIStream := mockService.NewMockIStream(c)
+			test.mockBehavior(mockIStream, owner, user)
+
+			services := &service.Service{IStream: mockIStream}
+			handler := Route{services}
+
+			// Init Endpoint
+			r := chi.NewRouter()
+			r.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/stream/"+owner+"/stream_key", bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
 func TestRoute_PutStream(t *testing.T) {
 	// Init Test Table
 	type mockBehavior func(r *mockService.MockIStream, item models.PutStream)
@@ -194,6 +295,7 @@ func TestRoute_PutStream(t *testing.T) {
 		SUsername:    api.SUsername{Username: &username},
 		SDescription: api.SDescription{Description: &username},
 	}
+	version := 2
 
 	putStream := models.PutStream{
 		Description: &username,
@@ -215,6 +317,7 @@ func TestRoute_PutStream(t *testing.T) {
 
 	tests := []struct {
 		name                 string
+		ifMatch              string
 		inputBody            string
 		inputPutStream       models.PutStream
 		mockBehavior         mockBehavior
@@ -226,17 +329,58 @@ func TestRoute_PutStream(t *testing.T) {
 			inputBody:      string(jsonPutStream),
 			inputPutStream: putStream,
 			mockBehavior: func(r *mockService.MockIStream, item models.PutStream) {
-				r.EXPECT().ChangeDescByUsername(item).Return(stream, nil)
+				r.EXPECT().ChangeDescByUsername(item, nil).Return(stream, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonStream) + "\n",
+		},
+		{
+			name:           "Ok with If-Match",
+			ifMatch:        `"2"`,
+			inputBody:      string(jsonPutStream),
+			inputPutStream: putStream,
+			mockBehavior: func(r *mockService.MockIStream, item models.PutStream) {
+				r.EXPECT().ChangeDescByUsername(item, &version).Return(stream, nil)
 			},
 			expectedStatusCode:   200,
 			expectedResponseBody: string(jsonStream) + "\n",
 		},
+		{
+			name:                 "Invalid If-Match",
+			ifMatch:              "some",
+			inputBody:            string(jsonPutStream),
+			inputPutStream:       putStream,
+			mockBehavior:         func(r *mockService.MockIStream, item models.PutStream) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidIfMatch + `"}` + "\n",
+		},
+		{
+			name:           "Version mismatch",
+			ifMatch:        `"2"`,
+			inputBody:      string(jsonPutStream),
+			inputPutStream: putStream,
+			mockBehavior: func(r *mockService.MockIStream, item models.PutStream) {
+				r.EXPECT().ChangeDescByUsername(item, &version).Return(stream, models.ErrVersionMismatch)
+			},
+			expectedStatusCode:   412,
+			expectedResponseBody: string(jsonStream) + "\n",
+		},
+		{
+			name:           "Stream not found",
+			inputBody:      string(jsonPutStream),
+			inputPutStream: putStream,
+			mockBehavior: func(r *mockService.MockIStream, item models.PutStream) {
+				r.EXPECT().ChangeDescByUsername(item, nil).Return(models.Stream{}, models.ErrStreamNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgStreamNotFound + `"}` + "\n",
+		},
 		{
 			name:           "Service failure",
 			inputBody:      string(jsonPutStream),
 			inputPutStream: putStream,
 			mockBehavior: func(r *mockService.MockIStream, item models.PutStream) {
-				r.EXPECT().ChangeDescByUsername(item).Return(stream, errors.New("error"))
+				r.EXPECT().ChangeDescByUsername(item, nil).Return(stream, errors.New("error"))
 			},
 			expectedStatusCode:   500,
 			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceChangeDescByUsername + `"}` + "\n",
@@ -274,11 +418,14 @@ func TestRoute_PutStream(t *testing.T) {
 			// Init Endpoint
 			route := chi.NewRouter()
 			path := "/stream"
-			route.Put(path, handler.PutStream)
+			route.Mount("/", api.Handler(&handler))
 
 			// Create Request
 			w := httptest.NewRecorder()
 			req := httptest.NewRequest(http.MethodPut, path, bytes.NewBufferString(test.inputBody))
+			if len(test.ifMatch) > 0 {
+				req.Header.Set("If-Match", test.ifMatch)
+			}
 
 			// Make Request
 			route.ServeHTTP(w, req)
@@ -0,0 +1,157 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) GetTags(w http.ResponseWriter, _ *http.Request) {
+	items, err := c.service.ITags.GetTags()
+	if err != nil {
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetTags)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(items)
+}
+
+func (c *Route) PostTag(w http.ResponseWriter, r *http.Request, params api.PostTagParams) {
+	var item models.Tag
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+
+	if err := item.Validate(); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	tag, err := c.service.ITags.SaveTag(item, params.Username)
+	switch {
+	case errors.Is(err, models.ErrCategoryNotFound):
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotAdmin):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceSaveTag)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(tag)
+}
+
+func (c *Route) PutTag(w http.ResponseWriter, r *http.Request, id types.UUID, params api.PutTagParams) {
+	var item models.Tag
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+
+	if err := item.Validate(); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	tag, err := c.service.ITags.ChangeTag(id, item, params.Username)
+	switch {
+	case errors.Is(err, models.ErrCategoryNotFound):
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrNotAdmin):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrTagNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceChangeTag)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(tag)
+}
+
+func (c *Route) DeleteTag(w http.ResponseWriter, _ *http.Request, id types.UUID, params api.DeleteTagParams) {
+	item, err := c.service.ITags.DeleteTag(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrNotAdmin):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrTagNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceDeleteTag)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+func (c *Route) GetCategories(w http.ResponseWriter, _ *http.Request) {
+	items, err := c.service.ITags.GetCategories()
+	if err != nil {
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetCategories)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(items)
+}
+
+func (c *Route) PostCategory(w http.ResponseWriter, r *http.Request, params api.PostCategoryParams) {
+	var item models.Category
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+
+	if err := item.Validate(); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	category, err := c.service.ITags.CreateCategory(item, params.Username)
+	switch {
+	case errors.Is(err, models.ErrNotAdmin):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceCreateCategory)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(category)
+}
+
+func (c *Route) DeleteCategory(w http.ResponseWriter, _ *http.Request, id types.UUID, params api.DeleteCategoryParams) {
+	item, err := c.service.ITags.DeleteCategory(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrNotAdmin):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrCategoryNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceDeleteCategory)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
@@ -0,0 +1,514 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func TestRoute_GetTags(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockITags)
+
+	id := uuid.New()
+	name := "demo"
+	category := "format"
+
+	items := []models.Tag{{Id: &id, Name: &name, Category: &category}}
+
+	jsonItems, _ := json.Marshal(items)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockITags) {
+				r.EXPECT().GetTags().Return(items, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItems) + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockITags) {
+				r.EXPECT().GetTags().Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetTags + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockITags := mockService.NewMockITags(c)
+			test.mockBehavior(mockITags)
+
+			services := &service.Service{ITags: mockITags}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/tags", nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_PostTag(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockITags, item models.Tag, actor *string)
+
+	id := uuid.New()
+	admin := "admin"
+	name := "demo"
+	invalid := "Demo Day"
+	category := "format"
+
+	item := models.Tag{Name: &name, Category: &category}
+	resItem := models.Tag{Id: &id, Name: &name, Category: &category}
+
+	jsonItem, _ := json.Marshal(item)
+	jsonResItem, _ := json.Marshal(resItem)
+	jsonInvalidItem, _ := json.Marshal(models.Tag{Name: &invalid})
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonItem),
+			mockBehavior: func(r *mockService.MockITags, item models.Tag, actor *string) {
+				r.EXPECT().SaveTag(item, actor).Return(resItem, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonResItem) + "\n",
+		},
+		{
+			name:                 "Name field is empty",
+			inputBody:            `{"category":"format"}`,
+			mockBehavior:         func(r *mockService.MockITags, item models.Tag, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgNameEmpty + `"}` + "\n",
+		},
+		{
+			name:                 "Invalid name",
+			inputBody:            string(jsonInvalidItem),
+			mockBehavior:         func(r *mockService.MockITags, item models.Tag, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidTag + `"}` + "\n",
+		},
+		{
+			name:      "Unknown category",
+			inputBody: string(jsonItem),
+			mockBehavior: func(r *mockService.MockITags, item models.Tag, actor *string) {
+				r.EXPECT().SaveTag(item, actor).Return(models.Tag{}, models.ErrCategoryNotFound)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgCategoryNotFound + `"}` + "\n",
+		},
+		{
+			name:      "Not an admin",
+			inputBody: string(jsonItem),
+			mockBehavior: func(r *mockService.MockITags, item models.Tag, actor *string) {
+				r.EXPECT().SaveTag(item, actor).Return(models.Tag{}, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonItem),
+			mockBehavior: func(r *mockService.MockITags, item models.Tag, actor *string) {
+				r.EXPECT().SaveTag(item, actor).Return(models.Tag{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceSaveTag + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockITags := mockService.NewMockITags(c)
+			test.mockBehavior(mockITags, item, &admin)
+
+			services := &service.Service{ITags: mockITags}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/tags?username="+admin, bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_PutTag(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockITags, id uuid.UUID, item models.Tag, actor *string)
+
+	id := uuid.New()
+	admin := "admin"
+	name := "training"
+
+	item := models.Tag{Name: &name}
+	resItem := models.Tag{Id: &id, Name: &name}
+
+	jsonItem, _ := json.Marshal(item)
+	jsonResItem, _ := json.Marshal(resItem)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockITags, id uuid.UUID, item models.Tag, actor *string) {
+				r.EXPECT().ChangeTag(id, item, actor).Return(resItem, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonResItem) + "\n",
+		},
+		{
+			name: "Tag not found",
+			mockBehavior: func(r *mockService.MockITags, id uuid.UUID, item models.Tag, actor *string) {
+				r.EXPECT().ChangeTag(id, item, actor).Return(models.Tag{}, models.ErrTagNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgTagNotFound + `"}` + "\n",
+		},
+		{
+			name: "Not an admin",
+			mockBehavior: func(r *mockService.MockITags, id uuid.UUID, item models.Tag, actor *string) {
+				r.EXPECT().ChangeTag(id, item, actor).Return(models.Tag{}, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockITags, id uuid.UUID, item models.Tag, actor *string) {
+				r.EXPECT().ChangeTag(id, item, actor).Return(models.Tag{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceChangeTag + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockITags := mockService.NewMockITags(c)
+			test.mockBehavior(mockITags, id, item, &admin)
+
+			services := &service.Service{ITags: mockITags}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			path := "/tags/" + id.String() + "?username=" + admin
+			req := httptest.NewRequest(http.MethodPut, path, bytes.NewBuffer(jsonItem))
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_DeleteTag(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockITags, id uuid.UUID, actor *string)
+
+	id := uuid.New()
+	admin := "admin"
+
+	jsonItem, _ := json.Marshal(api.SIdentifier{Id: &id})
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockITags, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteTag(id, actor).Return(api.SIdentifier{Id: &id}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItem) + "\n",
+		},
+		{
+			name: "Tag not found",
+			mockBehavior: func(r *mockService.MockITags, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteTag(id, actor).Return(api.SIdentifier{}, models.ErrTagNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgTagNotFound + `"}` + "\n",
+		},
+		{
+			name: "Not an admin",
+			mockBehavior: func(r *mockService.MockITags, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteTag(id, actor).Return(api.SIdentifier{}, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockITags, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteTag(id, actor).Return(api.SIdentifier{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceDeleteTag + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockITags := mockService.NewMockITags(c)
+			test.mockBehavior(mockITags, id, &admin)
+
+			services := &service.Service{ITags: mockITags}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, "/tags/"+id.String()+"?username="+admin, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_PostCategory(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockITags, item models.Category, actor *string)
+
+	id := uuid.New()
+	admin := "admin"
+	name := "format"
+
+	item := models.Category{Name: &name}
+	resItem := models.Category{Id: &id, Name: &name}
+
+	jsonItem, _ := json.Marshal(item)
+	jsonResItem, _ := json.Marshal(resItem)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonItem),
+			mockBehavior: func(r *mockService.MockITags, item models.Category, actor *string) {
+				r.EXPECT().CreateCategory(item, actor).Return(resItem, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonResItem) + "\n",
+		},
+		{
+			name:                 "Name field is empty",
+			inputBody:            `{"name":" "}`,
+			mockBehavior:         func(r *mockService.MockITags, item models.Category, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgNameEmpty + `"}` + "\n",
+		},
+		{
+			name:      "Not an admin",
+			inputBody: string(jsonItem),
+			mockBehavior: func(r *mockService.MockITags, item models.Category, actor *string) {
+				r.EXPECT().CreateCategory(item, actor).Return(models.Category{}, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonItem),
+			mockBehavior: func(r *mockService.MockITags, item models.Category, actor *string) {
+				r.EXPECT().CreateCategory(item, actor).Return(models.Category{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceCreateCategory + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockITags := mockService.NewMockITags(c)
+			test.mockBehavior(mockITags, item, &admin)
+
+			services := &service.Service{ITags: mockITags}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/categories?username="+admin, bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_DeleteCategory(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockITags, id uuid.UUID, actor *string)
+
+	id := uuid.New()
+	admin := "admin"
+
+	jsonItem, _ := json.Marshal(api.SIdentifier{Id: &id})
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockITags, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteCategory(id, actor).Return(api.SIdentifier{Id: &id}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItem) + "\n",
+		},
+		{
+			name: "Category not found",
+			mockBehavior: func(r *mockService.MockITags, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteCategory(id, actor).Return(api.SIdentifier{}, models.ErrCategoryNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgCategoryNotFound + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockITags, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteCategory(id, actor).Return(api.SIdentifier{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceDeleteCategory + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockITags := mockService.NewMockITags(c)
+			test.mockBehavior(mockITags, id, &admin)
+
+			services := &service.Service{ITags: mockITags}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, "/categories/"+id.String()+"?username="+admin, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func TestRoute_PostUserGetBroadcastTrash(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockITrash, user api.SUsername)
+
+	id := uuid.New()
+	name := "test"
+	admin := "admin"
+	deletedAt := time.Date(2022, time.July, 1, 8, 0, 0, 0, time.UTC)
+
+	user := api.SUsername{Username: &admin}
+	broadcasts := []models.Broadcasts{
+		{
+			SIdentifier: api.SIdentifier{Id: &id},
+			SBroadcast:  api.SBroadcast{Name: &name},
+			SDeleted:    api.SDeleted{DeletedAt: &deletedAt, DeletedBy: &admin},
+		},
+	}
+
+	jsonUser, _ := json.Marshal(user)
+	jsonBroadcasts, _ := json.Marshal(broadcasts)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockITrash, user api.SUsername) {
+				r.EXPECT().GetTrash(user).Return(broadcasts, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcasts) + "\n",
+		},
+		{
+			name:                 "Username field is empty",
+			inputBody:            "{}",
+			mockBehavior:         func(r *mockService.MockITrash, user api.SUsername) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgUsernameEmpty + `"}` + "\n",
+		},
+		{
+			name:      "Not admin",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockITrash, user api.SUsername) {
+				r.EXPECT().GetTrash(user).Return(nil, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockITrash, user api.SUsername) {
+				r.EXPECT().GetTrash(user).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetTrash + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockITrash := mockService.NewMockITrash(c)
+			test.mockBehavior(mockITrash, user)
+
+			services := &service.Service{ITrash: mockITrash}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/broadcasts/trash", bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_RestoreBroadcast(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockITrash, id uuid.UUID, user api.SUsername)
+
+	id := uuid.New()
+	name := "test"
+	admin := "admin"
+
+	user := api.SUsername{Username: &admin}
+	broadcast := models.Broadcasts{
+		SIdentifier: api.SIdentifier{Id: &id},
+		SBroadcast:  api.SBroadcast{Name: &name},
+	}
+
+	jsonUser, _ := json.Marshal(user)
+	jsonBroadcast, _ := json.Marshal(broadcast)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockITrash, id uuid.UUID, user api.SUsername) {
+				r.EXPECT().RestoreBroadcast(id, user).Return(broadcast, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonBroadcast) + "\n",
+		},
+		{
+			name:                 "Username field is empty",
+			inputBody:            "{}",
+			mockBehavior:         func(r *mockService.MockITrash, id uuid.UUID, user api.SUsername) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgUsernameEmpty + `"}` + "\n",
+		},
+		{
+			name:      "Not admin",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockITrash, id uuid.UUID, user api.SUsername) {
+				r.EXPECT().RestoreBroadcast(id, user).Return(models.Broadcasts{}, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name:      "Not in trash",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockITrash, id uuid.UUID, user api.SUsername) {
+				r.EXPECT().RestoreBroadcast(id, user).Return(models.Broadcasts{}, models.ErrNotInTrash)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgNotInTrash + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonUser),
+			mockBehavior: func(r *mockService.MockITrash, id uuid.UUID, user api.SUsername) {
+				r.EXPECT().RestoreBroadcast(id, user).Return(models.Broadcasts{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceRestoreBroadcast + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockITrash := mockService.NewMockITrash(c)
+			test.mockBehavior(mockITrash, id, user)
+
+			services := &service.Service{ITrash: mockITrash}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			path := "/broadcasts/" + id.String() + "/restore"
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+func (c *Route) GetWebhooks(w http.ResponseWriter, _ *http.Request, params api.GetWebhooksParams) {
+	items, err := c.service.IWebhooks.GetWebhooks(params.Username)
+	switch {
+	case errors.Is(err, models.ErrNotAdmin):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetWebhooks)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(items)
+}
+
+func (c *Route) PostWebhook(w http.ResponseWriter, r *http.Request, params api.PostWebhookParams) {
+	var item models.PostWebhook
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+
+	if err := item.Validate(); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	webhook, err := c.service.IWebhooks.CreateWebhook(item, params.Username)
+	switch {
+	case errors.Is(err, models.ErrNotAdmin):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceCreateWebhook)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(webhook)
+}
+
+func (c *Route) PutWebhook(w http.ResponseWriter, r *http.Request, id types.UUID, params api.PutWebhookParams) {
+	var item models.PostWebhook
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), models.MsgInvalidJson)
+		return
+	}
+
+	if err := item.Validate(); err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	webhook, err := c.service.IWebhooks.ChangeWebhook(id, item, params.Username)
+	switch {
+	case errors.Is(err, models.ErrNotAdmin):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrWebhookNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceChangeWebhook)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(webhook)
+}
+
+func (c *Route) DeleteWebhook(w http.ResponseWriter, _ *http.Request, id types.UUID, params api.DeleteWebhookParams) {
+	item, err := c.service.IWebhooks.DeleteWebhook(id, params.Username)
+	switch {
+	case errors.Is(err, models.ErrNotAdmin):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrWebhookNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceDeleteWebhook)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(item)
+}
+
+func (c *Route) GetWebhookDeliveries(
+	w http.ResponseWriter, _ *http.Request, id types.UUID, params api.GetWebhookDeliveriesParams) {
+	limit, err := models.ParseLimit(params.Limit)
+	if err != nil {
+		newErrorResponse(w, http.StatusBadRequest, err.Error(), err.Error())
+		return
+	}
+
+	items, err := c.service.IWebhooks.GetDeliveries(id, params.Username, limit)
+	switch {
+	case errors.Is(err, models.ErrNotAdmin):
+		newErrorResponse(w, http.StatusForbidden, err.Error(), err.Error())
+		return
+	case errors.Is(err, models.ErrWebhookNotFound):
+		newErrorResponse(w, http.StatusNotFound, err.Error(), err.Error())
+		return
+	case err != nil:
+		newErrorResponse(w, http.StatusInternalServerError, err.Error(), models.ErrServiceGetDeliveries)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(items)
+}
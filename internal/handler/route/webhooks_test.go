@@ -0,0 +1,505 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/service"
+	mockService "github.com/alexm24/golang/internal/service/mocks"
+)
+
+func TestRoute_GetWebhooks(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIWebhooks, actor *string)
+
+	admin := "admin"
+	id := uuid.New()
+	url := "https://lms.vp.ru/hooks/broadcasts"
+	active := true
+
+	item := models.Webhook{Events: models.EventList{models.EventBroadcastCreated}}
+	item.Id = &id
+	item.Url = &url
+	item.Active = &active
+	items := []models.Webhook{item}
+
+	jsonItems, _ := json.Marshal(items)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIWebhooks, actor *string) {
+				r.EXPECT().GetWebhooks(actor).Return(items, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItems) + "\n",
+		},
+		{
+			name: "Not admin",
+			mockBehavior: func(r *mockService.MockIWebhooks, actor *string) {
+				r.EXPECT().GetWebhooks(actor).Return(nil, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIWebhooks, actor *string) {
+				r.EXPECT().GetWebhooks(actor).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetWebhooks + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIWebhooks := mockService.NewMockIWebhooks(c)
+			test.mockBehavior(mockIWebhooks, &admin)
+
+			services := &service.Service{IWebhooks: mockIWebhooks}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/webhooks?username="+admin, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_PostWebhook(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIWebhooks, item models.PostWebhook, actor *string)
+
+	admin := "admin"
+	id := uuid.New()
+	url := "https://lms.vp.ru/hooks/broadcasts"
+	events := []string{models.EventBroadcastCreated, models.EventBroadcastEnded}
+	secret := "6f1c"
+	active := true
+
+	input := models.PostWebhook{Url: &url, Events: &events}
+	jsonInput, _ := json.Marshal(input)
+
+	res := models.Webhook{Events: models.EventList(events), Secret: &secret}
+	res.Id = &id
+	res.Url = &url
+	res.Active = &active
+	res.CreatedBy = &admin
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIWebhooks, item models.PostWebhook, actor *string) {
+				r.EXPECT().CreateWebhook(item, actor).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name:                 "Invalid json",
+			inputBody:            `{"url":`,
+			mockBehavior:         func(r *mockService.MockIWebhooks, item models.PostWebhook, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidJson + `"}` + "\n",
+		},
+		{
+			name:                 "Invalid url",
+			inputBody:            `{"url":"ftp://lms.vp.ru/hooks"}`,
+			mockBehavior:         func(r *mockService.MockIWebhooks, item models.PostWebhook, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidWebhookUrl + `"}` + "\n",
+		},
+		{
+			name:                 "Unknown event",
+			inputBody:            `{"url":"https://lms.vp.ru/hooks","events":["broadcast.deleted"]}`,
+			mockBehavior:         func(r *mockService.MockIWebhooks, item models.PostWebhook, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgUnknownEvent + `"}` + "\n",
+		},
+		{
+			name:      "Not admin",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIWebhooks, item models.PostWebhook, actor *string) {
+				r.EXPECT().CreateWebhook(item, actor).Return(models.Webhook{}, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIWebhooks, item models.PostWebhook, actor *string) {
+				r.EXPECT().CreateWebhook(item, actor).Return(models.Webhook{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceCreateWebhook + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIWebhooks := mockService.NewMockIWebhooks(c)
+			test.mockBehavior(mockIWebhooks, input, &admin)
+
+			services := &service.Service{IWebhooks: mockIWebhooks}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/webhooks?username="+admin, bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_PutWebhook(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIWebhooks, id uuid.UUID, item models.PostWebhook, actor *string)
+
+	admin := "admin"
+	id := uuid.New()
+	url := "https://hr.vp.ru/hooks"
+	active := false
+
+	input := models.PostWebhook{Url: &url, Active: &active}
+	jsonInput, _ := json.Marshal(input)
+
+	res := models.Webhook{Events: models.EventList{}}
+	res.Id = &id
+	res.Url = &url
+	res.Active = &active
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		inputBody            string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, item models.PostWebhook, actor *string) {
+				r.EXPECT().ChangeWebhook(id, item, actor).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name:                 "Empty url",
+			inputBody:            `{"active":false}`,
+			mockBehavior:         func(r *mockService.MockIWebhooks, id uuid.UUID, item models.PostWebhook, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidWebhookUrl + `"}` + "\n",
+		},
+		{
+			name:      "Not admin",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, item models.PostWebhook, actor *string) {
+				r.EXPECT().ChangeWebhook(id, item, actor).Return(models.Webhook{}, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name:      "Webhook not found",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, item models.PostWebhook, actor *string) {
+				r.EXPECT().ChangeWebhook(id, item, actor).Return(models.Webhook{}, models.ErrWebhookNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgWebhookNotFound + `"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: string(jsonInput),
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, item models.PostWebhook, actor *string) {
+				r.EXPECT().ChangeWebhook(id, item, actor).Return(models.Webhook{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceChangeWebhook + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIWebhooks := mockService.NewMockIWebhooks(c)
+			test.mockBehavior(mockIWebhooks, id, input, &admin)
+
+			services := &service.Service{IWebhooks: mockIWebhooks}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, "/webhooks/"+id.String()+"?username="+admin,
+				bytes.NewBufferString(test.inputBody))
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_DeleteWebhook(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIWebhooks, id uuid.UUID, actor *string)
+
+	admin := "admin"
+	id := uuid.New()
+	res := api.SIdentifier{Id: &id}
+	jsonRes, _ := json.Marshal(res)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteWebhook(id, actor).Return(res, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonRes) + "\n",
+		},
+		{
+			name: "Not admin",
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteWebhook(id, actor).Return(api.SIdentifier{}, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name: "Webhook not found",
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteWebhook(id, actor).Return(api.SIdentifier{}, models.ErrWebhookNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgWebhookNotFound + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, actor *string) {
+				r.EXPECT().DeleteWebhook(id, actor).Return(api.SIdentifier{}, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceDeleteWebhook + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIWebhooks := mockService.NewMockIWebhooks(c)
+			test.mockBehavior(mockIWebhooks, id, &admin)
+
+			services := &service.Service{IWebhooks: mockIWebhooks}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, "/webhooks/"+id.String()+"?username="+admin, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func TestRoute_GetWebhookDeliveries(t *testing.T) {
+	// Init Test Table
+	type mockBehavior func(r *mockService.MockIWebhooks, id uuid.UUID, actor *string)
+
+	admin := "admin"
+	id := uuid.New()
+	deliveryId := uuid.New()
+	event := models.EventBroadcastStarted
+	status := string(models.WebhookDelivered)
+	attempts := 2
+	first := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	second := first.Add(30 * time.Second)
+	failed := "connection refused"
+	code := 200
+	duration := 35
+
+	item := models.WebhookDelivery{History: models.AttemptList{
+		{Time: &first, Error: &failed, DurationMs: &duration},
+		{Time: &second, StatusCode: &code, DurationMs: &duration},
+	}}
+	item.Id = &deliveryId
+	item.Event = &event
+	item.Status = &status
+	item.Attempts = &attempts
+	item.DeliveredAt = &second
+	items := []models.WebhookDelivery{item}
+
+	jsonItems, _ := json.Marshal(items)
+
+	tests := []struct {
+		name                 string
+		query                string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, actor *string) {
+				r.EXPECT().GetDeliveries(id, actor, models.DefaultPageLimit).Return(items, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItems) + "\n",
+		},
+		{
+			name:  "Limit",
+			query: "&limit=5",
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, actor *string) {
+				r.EXPECT().GetDeliveries(id, actor, 5).Return(items, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: string(jsonItems) + "\n",
+		},
+		{
+			name:                 "Invalid limit",
+			query:                "&limit=0",
+			mockBehavior:         func(r *mockService.MockIWebhooks, id uuid.UUID, actor *string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"code":` + "400" + `,"message":"` + models.MsgInvalidLimit + `"}` + "\n",
+		},
+		{
+			name: "Not admin",
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, actor *string) {
+				r.EXPECT().GetDeliveries(id, actor, models.DefaultPageLimit).Return(nil, models.ErrNotAdmin)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"code":` + "403" + `,"message":"` + models.MsgNotAdmin + `"}` + "\n",
+		},
+		{
+			name: "Webhook not found",
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, actor *string) {
+				r.EXPECT().GetDeliveries(id, actor, models.DefaultPageLimit).Return(nil, models.ErrWebhookNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"code":` + "404" + `,"message":"` + models.MsgWebhookNotFound + `"}` + "\n",
+		},
+		{
+			name: "Service failure",
+			mockBehavior: func(r *mockService.MockIWebhooks, id uuid.UUID, actor *string) {
+				r.EXPECT().GetDeliveries(id, actor, models.DefaultPageLimit).Return(nil, errors.New("error"))
+			},
+			expectedStatusCode:   500,
+			expectedResponseBody: `{"code":` + "500" + `,"message":"` + models.ErrServiceGetDeliveries + `"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			mockIWebhooks := mockService.NewMockIWebhooks(c)
+			test.mockBehavior(mockIWebhooks, id, &admin)
+
+			services := &service.Service{IWebhooks: mockIWebhooks}
+			handler := Route{services}
+
+			// Init Endpoint
+			route := chi.NewRouter()
+			route.Mount("/", api.Handler(&handler))
+
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet,
+				"/webhooks/"+id.String()+"/deliveries?username="+admin+test.query, nil)
+
+			// Make Request
+			route.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+const (
+	DefaultBucket = 5 * time.Minute
+	// ViewersBucket is the resolution of tracked concurrent viewers.
+	ViewersBucket = time.Minute
+)
+
+var ErrInvalidBucket = errors.New(MsgInvalidBucket)
+
+// ParseBucket returns the default bucket for an empty value, buckets are whole minutes.
+func ParseBucket(s *string) (time.Duration, error) {
+	if s == nil || len(*s) == 0 {
+		return DefaultBucket, nil
+	}
+	d, err := time.ParseDuration(*s)
+	if err != nil || d < time.Minute || d > 24*time.Hour || d%time.Minute != 0 {
+		return 0, ErrInvalidBucket
+	}
+	return d, nil
+}
+
+type ReactionCount struct {
+	Type  string `json:"type" db:"type"`
+	Count int    `json:"count" db:"count"`
+}
+
+type Analytics struct {
+	Messages      int             `json:"messages" db:"messages"`
+	Questions     int             `json:"questions" db:"questions"`
+	Chatters      int             `json:"chatters" db:"chatters"`
+	Reactions     int             `json:"reactions" db:"reactions"`
+	ReactionTypes []ReactionCount `json:"reaction_types" db:"-"`
+	Confirmed     int             `json:"confirmed" db:"-"`
+	Waitlisted    int             `json:"waitlisted" db:"-"`
+	PeakViewers   int             `json:"peak_viewers" db:"-"`
+}
+
+type AnalyticsBucket struct {
+	Time      time.Time `json:"time" db:"time"`
+	Messages  int       `json:"messages" db:"messages"`
+	Questions int       `json:"questions" db:"questions"`
+	Reactions int       `json:"reactions" db:"reactions"`
+	Viewers   int       `json:"viewers" db:"-"`
+}
+
+// MergeViewers sets the peak of viewers per minute, given as unix time, on the
+// buckets of the given size. Buckets without messages are added for viewers.
+func MergeViewers(buckets []AnalyticsBucket, viewers map[int64]int, size time.Duration) []AnalyticsBucket {
+	step := int64(size / time.Second)
+	byTime := make(map[int64]int, len(buckets))
+	for n, b := range buckets {
+		byTime[b.Time.Unix()] = n
+	}
+
+	for minute, count := range viewers {
+		start := minute - minute%step
+		n, ok := byTime[start]
+		if !ok {
+			buckets = append(buckets, AnalyticsBucket{Time: time.Unix(start, 0).UTC()})
+			n = len(buckets) - 1
+			byTime[start] = n
+		}
+		if count > buckets[n].Viewers {
+			buckets[n].Viewers = count
+		}
+	}
+
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Time.Before(buckets[j].Time) })
+	return buckets
+}
@@ -1,7 +1,11 @@
 package models
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/alexm24/golang/internal/handler/api"
 )
@@ -9,20 +13,73 @@ import (
 type LifeCycleBroadcast int
 
 func (l LifeCycleBroadcast) String() string {
-	return [...]string{"created", "past"}[l]
+	return [...]string{"created", "live", "past"}[l]
 }
 
 const (
 	Created LifeCycleBroadcast = iota
+	OnAir
 	Past
 )
 
+func ParseLifeCycle(s string) (LifeCycleBroadcast, error) {
+	for _, l := range []LifeCycleBroadcast{Created, OnAir, Past} {
+		if l.String() == s {
+			return l, nil
+		}
+	}
+	return Created, errors.New(MsgInvalidLife)
+}
+
+var (
+	ErrBroadcastNotFound  = errors.New(MsgBroadcastNotFound)
+	ErrNotOwner           = errors.New(MsgNotOwner)
+	ErrNotAdmin           = errors.New(MsgNotAdmin)
+	ErrNotInTrash         = errors.New(MsgNotInTrash)
+	ErrInvalidPatch       = errors.New(MsgInvalidPatch)
+	ErrEmptyPatch         = errors.New(MsgEmptyPatch)
+	ErrEndTimeBeforeStart = errors.New(MsgEndTimeBeforeStart)
+	ErrInvalidCapacity    = errors.New(MsgInvalidCapacity)
+)
+
 type Broadcasts struct {
 	api.SIdentifier
 	api.SPreviewUrl
 	api.SBroadcast
 	api.SLifeCycle
 	api.SStartTime
+	api.SEndTime
+	api.SSeries
+	api.SDeleted
+	api.SCapacity
+	api.SVisibility
+	Tags       TagList     `db:"tags" json:"tags,omitempty"`
+	Recordings []Recording `db:"-" json:"recordings,omitempty"`
+	Version    int         `db:"version" json:"-"`
+}
+
+func (b *Broadcasts) IsOwner(username *string) bool {
+	return username != nil && b.Owner != nil && *b.Owner == *username
+}
+
+func (b *Broadcasts) IsPublic() bool {
+	return b.Visibility == nil || Visibility(*b.Visibility) == VisibilityPublic
+}
+
+// VisibleTo reports whether the viewer can see the broadcast, users on the
+// allowlist of invite-only broadcasts are not known here and get false.
+func (b *Broadcasts) VisibleTo(viewer *string, isAdmin bool) bool {
+	if b.IsPublic() || isAdmin || b.IsOwner(viewer) {
+		return true
+	}
+	return Visibility(*b.Visibility) == VisibilityInternal && viewer != nil
+}
+
+type LifeCycleEvent struct {
+	api.SIdentifier
+	api.SLifeCycle
+	api.SStartTime
+	api.SEndTime
 }
 
 type PutBroadcast api.PutBroadcastJSONBody
@@ -40,12 +97,113 @@ func (p *PutBroadcast) Validate() error {
 	if p.Owner == nil {
 		return errors.New(MsgOwnerEmpty)
 	}
-	if p.StreamKey == nil {
-		return errors.New(MsgStreamKeyEmpty)
-	}
 	if p.StartTime == nil {
 		return errors.New(MsgStartTimeEmpty)
 	}
+	if p.EndTime != nil && !p.EndTime.After(*p.StartTime) {
+		return errors.New(MsgEndTimeBeforeStart)
+	}
+	if p.Tags != nil {
+		return ValidateTags(*p.Tags)
+	}
+	return nil
+}
+
+// PatchBroadcast is a JSON Merge Patch (RFC 7396) of the editable fields,
+// nil fields are left unchanged.
+type PatchBroadcast struct {
+	Name        *string
+	Description *string
+	StartTime   *time.Time
+	EndTime     *time.Time
+	Capacity    *int
+	Visibility  *string
+	// ClearEndTime and ClearCapacity are set by null values
+	ClearEndTime  bool
+	ClearCapacity bool
+}
+
+var patchableFields = map[string]bool{
+	"name": true, "description": true, "start_time": true, "end_time": true, "capacity": true,
+	"visibility": true,
+}
+
+func ParsePatchBroadcast(data []byte) (PatchBroadcast, error) {
+	var p PatchBroadcast
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return p, ErrInvalidPatch
+	}
+
+	for name, value := range fields {
+		if !patchableFields[name] {
+			return p, fmt.Errorf("%w: %s", ErrInvalidPatch, name)
+		}
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			switch name {
+			case "end_time":
+				p.ClearEndTime = true
+			case "capacity":
+				p.ClearCapacity = true
+			default:
+				return p, fmt.Errorf("%w: %s can not be removed", ErrInvalidPatch, name)
+			}
+			continue
+		}
+
+		var err error
+		switch name {
+		case "name":
+			err = json.Unmarshal(value, &p.Name)
+		case "description":
+			err = json.Unmarshal(value, &p.Description)
+		case "start_time":
+			err = json.Unmarshal(value, &p.StartTime)
+		case "end_time":
+			err = json.Unmarshal(value, &p.EndTime)
+		case "capacity":
+			err = json.Unmarshal(value, &p.Capacity)
+		case "visibility":
+			err = json.Unmarshal(value, &p.Visibility)
+		}
+		if err != nil {
+			return p, fmt.Errorf("%w: %s", ErrInvalidPatch, name)
+		}
+	}
+	if p.IsEmpty() {
+		return p, ErrEmptyPatch
+	}
+	return p, nil
+}
+
+func (p *PatchBroadcast) IsEmpty() bool {
+	return p.Name == nil && p.Description == nil && p.StartTime == nil && p.EndTime == nil && p.Capacity == nil &&
+		p.Visibility == nil && !p.ClearEndTime && !p.ClearCapacity
+}
+
+// Validate checks the patched times against the current broadcast.
+func (p *PatchBroadcast) Validate(current Broadcasts) error {
+	if p.Capacity != nil && *p.Capacity < 1 {
+		return ErrInvalidCapacity
+	}
+	if p.Visibility != nil {
+		if _, err := ParseVisibility(p.Visibility); err != nil {
+			return err
+		}
+	}
+	start, end := current.StartTime, current.EndTime
+	if p.StartTime != nil {
+		start = p.StartTime
+	}
+	if p.EndTime != nil {
+		end = p.EndTime
+	}
+	if p.ClearEndTime {
+		end = nil
+	}
+	if start != nil && end != nil && !end.After(*start) {
+		return ErrEndTimeBeforeStart
+	}
 	return nil
 }
 
@@ -61,11 +219,27 @@ func (p *PostBroadcast) Validate() error {
 	if p.Owner == nil {
 		return errors.New(MsgOwnerEmpty)
 	}
-	if p.StreamKey == nil {
-		return errors.New(MsgStreamKeyEmpty)
-	}
 	if p.StartTime == nil {
 		return errors.New(MsgStartTimeEmpty)
 	}
+	if p.EndTime != nil && !p.EndTime.After(*p.StartTime) {
+		return errors.New(MsgEndTimeBeforeStart)
+	}
+	if p.Capacity != nil && *p.Capacity < 1 {
+		return ErrInvalidCapacity
+	}
+	if _, err := ParseVisibility(p.Visibility); err != nil {
+		return err
+	}
+	if p.Tags != nil {
+		if err := ValidateTags(*p.Tags); err != nil {
+			return err
+		}
+	}
+	if p.Rrule != nil {
+		if _, err := p.Occurrences(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
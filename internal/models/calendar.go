@@ -0,0 +1,134 @@
+package models
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+)
+
+type CalendarScope string
+
+const (
+	CalendarUser CalendarScope = "user"
+	CalendarAll  CalendarScope = "all"
+)
+
+const (
+	calendarProdId    = "-//VP//Broadcasts//RU"
+	calendarUidDomain = "broadcasts.vp"
+	calendarTime      = "20060102T150405Z"
+	calendarLineLen   = 75
+)
+
+type CalendarFeed struct {
+	Token    string        `db:"token"`
+	Username string        `db:"username"`
+	Scope    CalendarScope `db:"scope"`
+}
+
+type PostCalendarFeed api.CreateCalendarFeedJSONBody
+
+func (p *PostCalendarFeed) Validate() error {
+	if p.Username == nil || len(*p.Username) == 0 {
+		return errors.New(MsgUsernameEmpty)
+	}
+	if p.Scope == nil {
+		scope := string(CalendarUser)
+		p.Scope = &scope
+	}
+	switch CalendarScope(*p.Scope) {
+	case CalendarUser, CalendarAll:
+		return nil
+	}
+	return errors.New(MsgInvalidCalendarScope)
+}
+
+type CalendarEvent struct {
+	Id          types.UUID `db:"id"`
+	Name        string     `db:"name"`
+	Description string     `db:"description"`
+	StartTime   time.Time  `db:"start_time"`
+	EndTime     *time.Time `db:"end_time"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+	Sequence    int        `db:"sequence"`
+}
+
+type Calendar struct {
+	Name     string
+	WatchUrl string
+	Duration time.Duration
+	Events   []CalendarEvent
+}
+
+// ICS renders the calendar as RFC 5545 text. UID of an event is derived from
+// the broadcast id, so clients treat changed broadcasts as updates.
+func (c Calendar) ICS() []byte {
+	var buf bytes.Buffer
+	line := func(name, value string) {
+		writeFolded(&buf, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", calendarProdId)
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	line("X-WR-CALNAME", escapeText(c.Name))
+	for _, e := range c.Events {
+		end := e.StartTime.Add(c.Duration)
+		if e.EndTime != nil {
+			end = *e.EndTime
+		}
+		url := strings.TrimSuffix(c.WatchUrl, "/") + "/" + e.Id.String()
+
+		line("BEGIN", "VEVENT")
+		line("UID", fmt.Sprintf("%s@%s", e.Id, calendarUidDomain))
+		line("DTSTAMP", e.UpdatedAt.UTC().Format(calendarTime))
+		line("LAST-MODIFIED", e.UpdatedAt.UTC().Format(calendarTime))
+		line("SEQUENCE", fmt.Sprint(e.Sequence))
+		line("DTSTART", e.StartTime.UTC().Format(calendarTime))
+		line("DTEND", end.UTC().Format(calendarTime))
+		line("SUMMARY", escapeText(e.Name))
+		line("DESCRIPTION", escapeText(e.Description+"\n\n"+url))
+		line("URL", url)
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+
+	return buf.Bytes()
+}
+
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writeFolded splits content lines longer than 75 octets without breaking
+// multi-byte characters.
+func writeFolded(buf *bytes.Buffer, s string) {
+	limit := calendarLineLen
+	for len(s) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(s[i]) {
+			i--
+		}
+		buf.WriteString(s[:i])
+		buf.WriteString("\r\n ")
+		s = s[i:]
+		limit = calendarLineLen - 1
+	}
+	buf.WriteString(s)
+	buf.WriteString("\r\n")
+}
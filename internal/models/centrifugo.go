@@ -1,8 +1,10 @@
 package models
 
+import "fmt"
+
 type Params struct {
 	Channel string      `json:"channel"`
-	Data    interface{} `json:"data"`
+	Data    interface{} `json:"data,omitempty"`
 }
 
 type Centrifugo struct {
@@ -14,3 +16,24 @@ type ActionCentrifugo struct {
 	Type    string      `json:"type,omitempty"`
 	Payload interface{} `json:"payload"`
 }
+
+// PresenceStats counts connections and distinct users subscribed to a channel,
+// presence must be enabled for the channel namespace.
+type PresenceStats struct {
+	NumClients int `json:"num_clients"`
+	NumUsers   int `json:"num_users"`
+}
+
+type CentrifugoError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *CentrifugoError) Error() string {
+	return fmt.Sprintf("centrifugo error %d: %s", e.Code, e.Message)
+}
+
+type PresenceStatsReply struct {
+	Error  *CentrifugoError `json:"error"`
+	Result PresenceStats    `json:"result"`
+}
@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type HTTPServerConfig struct {
 	Port string `yaml:"port"`
 	Path string `yaml:"path"`
@@ -16,9 +18,65 @@ type RedisConfig struct {
 	Port string `yaml:"port"`
 }
 
+type SchedulerConfig struct {
+	Interval          time.Duration `yaml:"interval"`
+	BroadcastDuration time.Duration `yaml:"broadcast_duration"`
+}
+
+type CalendarConfig struct {
+	WatchUrl string `yaml:"watch_url"`
+}
+
+type FeedConfig struct {
+	Url   string `yaml:"url"`
+	Limit int    `yaml:"limit"`
+}
+
+type RecordingsConfig struct {
+	Dir     string        `yaml:"dir"`
+	PlayUrl string        `yaml:"play_url"`
+	Secret  string        `yaml:"secret"`
+	Ttl     time.Duration `yaml:"ttl"`
+	MaxSize int64         `yaml:"max_size"`
+}
+
+type IngestConfig struct {
+	EarlyPublish time.Duration `yaml:"early_publish"`
+}
+
+type FilesConfig struct {
+	MaxSize int64 `yaml:"max_size"`
+}
+
+// ChatConfig limits how long authors can edit their messages, a zero window
+// does not limit editing.
+type ChatConfig struct {
+	EditWindow time.Duration `yaml:"edit_window"`
+}
+
+type TrashConfig struct {
+	Interval  time.Duration `yaml:"interval"`
+	Retention time.Duration `yaml:"retention"`
+}
+
+type WebhooksConfig struct {
+	Interval    time.Duration `yaml:"interval"`
+	MaxAttempts int           `yaml:"max_attempts"`
+	Backoff     time.Duration `yaml:"backoff"`
+}
+
 type Config struct {
 	HTTPServerConfig `yaml:"http_server_config"`
 	CentrifugoConfig `yaml:"centrifugo_config"`
 	RedisConfig      `yaml:"redis_config"`
+	SchedulerConfig  `yaml:"scheduler_config"`
+	CalendarConfig   `yaml:"calendar_config"`
+	FeedConfig       `yaml:"feed_config"`
+	IngestConfig     `yaml:"ingest_config"`
+	RecordingsConfig `yaml:"recordings_config"`
+	FilesConfig      `yaml:"files_config"`
+	ChatConfig       `yaml:"chat_config"`
+	TrashConfig      `yaml:"trash_config"`
+	WebhooksConfig   `yaml:"webhooks_config"`
 	DBConfig         string `yaml:"db_config"`
 }
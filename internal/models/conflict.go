@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+)
+
+// Slot is the time a broadcast occupies its owner and stream key,
+// broadcasts without end time last the default duration.
+type Slot struct {
+	Id        *types.UUID
+	Owner     string
+	StreamKey *string
+	Start     time.Time
+	End       time.Time
+}
+
+func NewSlot(item Broadcasts, duration time.Duration) Slot {
+	s := Slot{Id: item.Id, StreamKey: item.StreamKey, Start: *item.StartTime, End: item.StartTime.Add(duration)}
+	if item.Owner != nil {
+		s.Owner = *item.Owner
+	}
+	if item.EndTime != nil {
+		s.End = *item.EndTime
+	}
+	return s
+}
+
+// ConflictError names the broadcasts overlapping the scheduled ones.
+type ConflictError struct {
+	Conflicts []api.SConflictItem
+}
+
+func NewConflictError(items []Broadcasts, duration time.Duration) *ConflictError {
+	e := &ConflictError{Conflicts: make([]api.SConflictItem, 0, len(items))}
+	for _, i := range items {
+		slot := NewSlot(i, duration)
+		e.Conflicts = append(e.Conflicts, api.SConflictItem{
+			Id:        i.Id,
+			Name:      i.Name,
+			Owner:     i.Owner,
+			StartTime: &slot.Start,
+			EndTime:   &slot.End,
+		})
+	}
+	return e
+}
+
+func (e *ConflictError) Error() string {
+	return MsgScheduleConflict
+}
+
+// Slots returns the slots of the new broadcast starting at each of starts.
+func (p *PostBroadcast) Slots(starts []time.Time, duration time.Duration) []Slot {
+	var slots = make([]Slot, 0, len(starts))
+	for _, start := range starts {
+		end := start.Add(duration)
+		if p.EndTime != nil {
+			end = start.Add(p.EndTime.Sub(*p.StartTime))
+		}
+		slots = append(slots, Slot{Owner: *p.Owner, Start: start, End: end})
+	}
+	return slots
+}
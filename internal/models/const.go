@@ -26,30 +26,171 @@ const (
 	ErrServiceCreateImage          = "service failure CreateImage() in /images route"
 	ErrServiceCreateReaction       = "service failure CreateReaction() in /messages/{channel}/reaction"
 	ErrServiceDeleteReaction       = "service failure DeleteReaction() in /messages/{channel}/reaction"
+	ErrServiceGetSeriesById        = "service failure GetSeriesById() in /broadcasts/series/{id} route"
+	ErrServiceSearch               = "service failure Search() in /search route"
+	ErrServiceCreateCalendarFeed   = "service failure CreateCalendarFeed() in /calendar/feeds route"
+	ErrServiceGetCalendar          = "service failure GetCalendar() in /calendar/{token}.ics route"
+	ErrServiceGetArchiveFeed       = "service failure GetArchiveFeed() in /feeds/archive route"
+	ErrServiceRotateStreamKey      = "service failure RotateStreamKey() in /broadcasts/{id}/stream_key route"
+	ErrServiceRotateUserStreamKey  = "service failure RotateStreamKey() in /stream/{username}/stream_key route"
+	ErrServiceIngestPublish        = "service failure Publish() in /ingest/on_publish route"
+	ErrServiceIngestPublishDone    = "service failure PublishDone() in /ingest/on_publish_done route"
+	ErrServiceIngestRecordDone     = "service failure RecordDone() in /ingest/on_record_done route"
+	ErrServiceCreateFile           = "service failure CreateFile() in /broadcasts/{id}/files route"
+	ErrServiceGetFiles             = "service failure GetFiles() in /broadcasts/{id}/files route"
+	ErrServiceGetFileById          = "service failure GetFileById() in /files/{id} route"
+	ErrServiceDeleteFile           = "service failure DeleteFile() in /files/{id} route"
+	ErrServiceGetTrash             = "service failure GetTrash() in /broadcasts/trash route"
+	ErrServiceRestoreBroadcast     = "service failure RestoreBroadcast() in /broadcasts/{id}/restore route"
+	ErrServiceGetRevisions         = "service failure GetRevisions() in /broadcasts/{id}/revisions route"
+	ErrServiceRevertRevision       = "service failure RevertRevision() in /broadcasts/{id}/revisions/{revision}/revert route"
+	ErrServicePatchBroadcast       = "service failure PatchBroadcast() in /broadcasts/{id} route"
+	ErrServiceDeleteParticipant    = "service failure DeleteParticipant() in /participants/{channel}/{username} route"
+	ErrServiceGetChannelToken      = "service failure GetChannelToken() in /token/{channel} route"
+	ErrServiceGetAccess            = "service failure GetAccess() in /broadcasts/{id}/access route"
+	ErrServiceGrantAccess          = "service failure GrantAccess() in /broadcasts/{id}/access route"
+	ErrServiceRevokeAccess         = "service failure RevokeAccess() in /broadcasts/{id}/access/{user} route"
+	ErrServiceCreateInvite         = "service failure CreateInvite() in /broadcasts/{id}/invite route"
+	ErrServiceAcceptInvite         = "service failure AcceptInvite() in /invites/{token} route"
+	ErrServiceGetTags              = "service failure GetTags() in /tags route"
+	ErrServiceSaveTag              = "service failure SaveTag() in /tags route"
+	ErrServiceChangeTag            = "service failure ChangeTag() in /tags/{id} route"
+	ErrServiceDeleteTag            = "service failure DeleteTag() in /tags/{id} route"
+	ErrServiceGetCategories        = "service failure GetCategories() in /categories route"
+	ErrServiceCreateCategory       = "service failure CreateCategory() in /categories route"
+	ErrServiceDeleteCategory       = "service failure DeleteCategory() in /categories/{id} route"
+	ErrServiceGetAnalytics         = "service failure GetAnalytics() in /broadcasts/{id}/analytics route"
+	ErrServiceGetAnalyticsSeries   = "service failure GetAnalyticsSeries() in /broadcasts/{id}/analytics/series route"
+	ErrServiceGetReport            = "service failure GetReport() in /broadcasts/{id}/report route"
+	ErrServiceSaveReportMail       = "service failure SaveReportMail() in /broadcasts/{id}/report/mail route"
+	ErrServiceDeleteReportMail     = "service failure DeleteReportMail() in /broadcasts/{id}/report/mail route"
+	ErrServiceGetWebhooks          = "service failure GetWebhooks() in /webhooks route"
+	ErrServiceCreateWebhook        = "service failure CreateWebhook() in /webhooks route"
+	ErrServiceChangeWebhook        = "service failure ChangeWebhook() in /webhooks/{id} route"
+	ErrServiceDeleteWebhook        = "service failure DeleteWebhook() in /webhooks/{id} route"
+	ErrServiceGetDeliveries        = "service failure GetDeliveries() in /webhooks/{id}/deliveries route"
+	ErrServiceGetRecordings        = "service failure GetRecordings() in /broadcasts/{id}/recordings route"
+	ErrServiceCreateRecording      = "service failure CreateRecording() in /broadcasts/{id}/recordings route"
+	ErrServiceUploadRecording      = "service failure UploadRecording() in /broadcasts/{id}/recordings/upload route"
+	ErrServiceDeleteRecording      = "service failure DeleteRecording() in /recordings/{id} route"
+	ErrServicePlayRecording        = "service failure PlayRecording() in /recordings/{id}/play route"
+	ErrServiceWatchRecording       = "service failure WatchRecording() in /recordings/{id}/watch route"
+	ErrServiceEditMsg              = "service failure EditMsg() in /messages/{channel}/{id} route"
+	ErrServiceDeleteMsg            = "service failure DeleteMsg() in /messages/{channel}/{id} route"
+	ErrServiceGetMsgEdits          = "service failure GetMsgEdits() in /messages/{channel}/{id}/edits route"
+	ErrServiceGetMsgReplies        = "service failure GetMsgReplies() in /messages/{channel}/{id}/replies route"
+	ErrServiceGetQuestions         = "service failure GetQuestions() in /questions/{channel} route"
+	ErrServicePatchQuestion        = "service failure PatchQuestion() in /questions/{channel}/{id} route"
+	ErrServiceVoteQuestion         = "service failure VoteQuestion() in /questions/{channel}/{id}/vote route"
+	ErrServiceUnvoteQuestion       = "service failure UnvoteQuestion() in /questions/{channel}/{id}/vote route"
+	ErrServicePinQuestion          = "service failure PinQuestion() in /questions/{channel}/{id}/pin route"
+	ErrServiceUnpinQuestion        = "service failure UnpinQuestion() in /questions/{channel}/{id}/pin route"
 )
 
 const (
-	MsgNoSuchFile       = "No such file"
-	MsgInvalidFileType  = "Invalid file type"
-	MsgInvalidJson      = "Invalid JSON data format"
-	MsgFullnameEmpty    = "fullname field is empty"
-	MsgNameEmpty        = "name field is empty"
-	MsgUsernameEmpty    = "username empty"
-	MsgTextEmpty        = "text field is empty"
-	MsgAvatarEmpty      = "avatar field is empty"
-	MsgTimeEmpty        = "time field is empty"
-	MsgIsAnonEmpty      = "is_anon field is empty"
-	MsgIsQuestionEmpty  = "is_question field is empty"
-	MsgEmailEmpty       = "email field is empty"
-	MsgDescriptionEmpty = "description field is empty"
-	MsgOwnerEmpty       = "owner field is empty"
-	MsgStreamKeyEmpty   = "stream_key field is empty"
-	MsgStartTimeEmpty   = "start_time field is empty"
-	MsgIdEmpty          = "id field is empty"
-	MsgTypeEmpty        = "type field is empty"
+	MsgNoSuchFile           = "No such file"
+	MsgInvalidFileType      = "Invalid file type"
+	MsgInvalidJson          = "Invalid JSON data format"
+	MsgFullnameEmpty        = "fullname field is empty"
+	MsgNameEmpty            = "name field is empty"
+	MsgUsernameEmpty        = "username empty"
+	MsgTextEmpty            = "text field is empty"
+	MsgAvatarEmpty          = "avatar field is empty"
+	MsgTimeEmpty            = "time field is empty"
+	MsgIsAnonEmpty          = "is_anon field is empty"
+	MsgIsQuestionEmpty      = "is_question field is empty"
+	MsgEmailEmpty           = "email field is empty"
+	MsgDescriptionEmpty     = "description field is empty"
+	MsgOwnerEmpty           = "owner field is empty"
+	MsgStartTimeEmpty       = "start_time field is empty"
+	MsgEndTimeBeforeStart   = "end_time must be after start_time"
+	MsgIdEmpty              = "id field is empty"
+	MsgTypeEmpty            = "type field is empty"
+	MsgInvalidCursor        = "invalid cursor"
+	MsgCursorConflict       = "only one of before and after can be set"
+	MsgInvalidSort          = "sort must be one of start_time, name"
+	MsgInvalidOrder         = "order must be one of asc, desc"
+	MsgInvalidLimit         = "limit must be between 1 and 100"
+	MsgInvalidDateRange     = "to must be after from"
+	MsgInvalidLife          = "life must be one of created, live, past"
+	MsgQueryEmpty           = "q field is empty"
+	MsgInvalidScope         = "scope must be one of this, following, all"
+	MsgInvalidRRule         = "rrule field is invalid"
+	MsgSeriesEmpty          = "rrule has no occurrences"
+	MsgSeriesTooLong        = "rrule has too many occurrences"
+	MsgInvalidCalendarScope = "scope must be one of user, all"
+	MsgBroadcastNotFound    = "broadcast not found"
+	MsgNotOwner             = "user is not the owner of the broadcast"
+	MsgStreamKeyEmpty       = "stream key is empty"
+	MsgStreamKeyUnknown     = "unknown stream key"
+	MsgStreamKeyExpired     = "stream key has expired"
+	MsgStreamKeyNotActive   = "stream key is not active yet"
+	MsgFileTooLarge         = "file is too large"
+	MsgFileNotFound         = "file not found"
+	MsgReadFile             = "failed to read the file"
+	MsgNotAdmin             = "user is not an admin"
+	MsgNotInTrash           = "broadcast is not in the trash"
+	MsgRevisionNotFound     = "revision not found"
+	MsgStreamNotFound       = "stream not found"
+	MsgVersionMismatch      = "resource has been changed, reload it and retry"
+	MsgInvalidIfMatch       = "invalid If-Match header"
+	MsgInvalidPatch         = "invalid merge patch"
+	MsgEmptyPatch           = "merge patch has no fields to change"
+	MsgInvalidCapacity      = "capacity must be positive"
+	MsgNotRegistered        = "user is not registered"
+	MsgNoAccess             = "user has no access to the broadcast"
+	MsgAccessNotFound       = "user is not on the allowlist"
+	MsgInviteNotFound       = "invite not found"
+	MsgInvalidVisibility    = "visibility must be one of public, internal, invite-only"
+	MsgInvalidTag           = "tag must contain lowercase letters, digits and dashes"
+	MsgUnknownTag           = "unknown tag"
+	MsgTagNotFound          = "tag not found"
+	MsgCategoryNotFound     = "category not found"
+	MsgScheduleConflict     = "broadcast overlaps other broadcasts of the owner or stream key"
+	MsgInvalidBucket        = "bucket must be whole minutes from 1m to 24h"
+	MsgInvalidReportFormat  = "format must be one of csv, xlsx"
+	MsgReportMailNotFound   = "report mail not found"
+	MsgInvalidWebhookUrl    = "url must be an absolute http or https url"
+	MsgUnknownEvent         = "unknown event type"
+	MsgWebhookNotFound      = "webhook not found"
+	MsgRecordingNotFound    = "recording not found"
+	MsgRecordingOutside     = "recording must be inside the recordings dir"
+	MsgInvalidRecordingUrl  = "url must be an absolute http or https url"
+	MsgInvalidRecordingMeta = "duration and size must not be negative"
+	MsgInvalidRecordingLink = "invalid recording link"
+	MsgRecordingLinkExpired = "recording link has expired"
+	MsgInvalidRecording     = "recording must be one of mp4, m4v, webm, mkv, mov, flv, ts, m4a, mp3"
+	MsgMessageNotFound      = "message not found"
+	MsgNotAuthor            = "only the author can edit the message"
+	MsgEditWindowClosed     = "message can no longer be edited"
+	MsgNotModerator         = "only the author or a moderator can delete the message"
+	MsgParentNotFound       = "parent message not found"
+	MsgQuestionNotFound     = "question not found"
+	MsgInvalidQuestionState = "state must be one of pending, approved, answered, dismissed"
+	MsgNotChatModerator     = "user is not a moderator of the channel"
+	MsgQuestionNotApproved  = "only approved questions can be voted for"
+	MsgQuestionDismissed    = "dismissed questions can not be pinned"
 )
 
 const (
 	ActionChatClear     = "ACTION_CHAT_CLEAR"
 	ActionChatReactions = "ACTION_CHAT_REACTIONS"
+	ActionBroadcastLife = "ACTION_BROADCAST_LIFE"
+	ActionFileShared    = "ACTION_FILE_SHARED"
+	ActionRegistrations = "ACTION_REGISTRATIONS"
+	ActionChatEdit      = "ACTION_CHAT_EDIT"
+	ActionChatDelete    = "ACTION_CHAT_DELETE"
+	ActionQuestionVotes = "ACTION_QUESTION_VOTES"
+	ActionQuestionState = "ACTION_QUESTION_STATE"
+	ActionQuestionPin   = "ACTION_QUESTION_PIN"
+)
+
+const ChannelBroadcasts = "broadcasts"
+
+const (
+	ContentTypeCalendar = "text/calendar; charset=utf-8"
+	ContentTypeAtom     = "application/atom+xml; charset=utf-8"
+	ContentTypeRSS      = "application/rss+xml; charset=utf-8"
+	ContentTypeCSV      = "text/csv; charset=utf-8"
+	ContentTypeXLSX     = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
 )
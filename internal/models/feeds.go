@@ -0,0 +1,277 @@
+package models
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+type FeedFormat string
+
+const (
+	FeedAtom FeedFormat = "atom"
+	FeedRSS  FeedFormat = "rss"
+)
+
+const (
+	feedTitle      = "Archive of broadcasts"
+	atomNamespace  = "http://www.w3.org/2005/Atom"
+	mediaNamespace = "http://search.yahoo.com/mrss/"
+	dcNamespace    = "http://purl.org/dc/elements/1.1/"
+)
+
+type FeedFilter struct {
+	Owner *string
+	Tags  []string
+	Limit int
+}
+
+func (f FeedFilter) Title() string {
+	title := feedTitle
+	if f.Owner != nil {
+		title += " of " + *f.Owner
+	}
+	if len(f.Tags) > 0 {
+		title += " tagged " + strings.Join(f.Tags, ", ")
+	}
+	return title
+}
+
+// Query returns the query string of the filter with a leading question mark,
+// empty when nothing is filtered.
+func (f FeedFilter) Query() string {
+	values := url.Values{}
+	if f.Owner != nil {
+		values.Set("owner", *f.Owner)
+	}
+	if len(f.Tags) > 0 {
+		values.Set("tags", strings.Join(f.Tags, ","))
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
+}
+
+// FeedEntry has the last recording of the broadcast, RecordingUrl is its
+// watch url which does not expire.
+type FeedEntry struct {
+	Id              types.UUID  `db:"id"`
+	Name            string      `db:"name"`
+	Description     string      `db:"description"`
+	Owner           string      `db:"owner"`
+	StartTime       time.Time   `db:"start_time"`
+	UpdatedAt       time.Time   `db:"updated_at"`
+	PreviewUrl      *string     `db:"previewurl"`
+	Tags            TagList     `db:"tags"`
+	RecordingId     *types.UUID `db:"recording_id"`
+	RecordingFormat *string     `db:"recording_format"`
+	RecordingUrl    string      `db:"-"`
+}
+
+// Feed is the archive of past broadcasts, Url resolves relative preview urls.
+type Feed struct {
+	Title    string
+	SelfUrl  string
+	Url      string
+	WatchUrl string
+	Entries  []FeedEntry
+}
+
+// Render returns the feed in the given format.
+func (f Feed) Render(format FeedFormat) ([]byte, error) {
+	if format == FeedRSS {
+		return f.RSS()
+	}
+	return f.Atom()
+}
+
+func (f Feed) watchUrl(e FeedEntry) string {
+	return strings.TrimSuffix(f.WatchUrl, "/") + "/" + e.Id.String()
+}
+
+func (f Feed) previewUrl(e FeedEntry) string {
+	if e.PreviewUrl == nil || len(*e.PreviewUrl) == 0 {
+		return ""
+	}
+	if strings.HasPrefix(*e.PreviewUrl, "http://") || strings.HasPrefix(*e.PreviewUrl, "https://") {
+		return *e.PreviewUrl
+	}
+	return strings.TrimSuffix(f.Url, "/") + "/" + strings.TrimPrefix(*e.PreviewUrl, "/")
+}
+
+// updated is the time of the latest change of the entries.
+func (f Feed) updated() time.Time {
+	var t time.Time
+	for _, e := range f.Entries {
+		if e.UpdatedAt.After(t) {
+			t = e.UpdatedAt
+		}
+	}
+	if t.IsZero() {
+		return time.Now()
+	}
+	return t
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	Media   string      `xml:"xmlns:media,attr"`
+	Id      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	Id         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     string         `xml:"author>name"`
+	Summary    string         `xml:"summary,omitempty"`
+	Links      []atomLink     `xml:"link"`
+	Categories []atomCategory `xml:"category"`
+	Thumbnail  *mediaThumb    `xml:"media:thumbnail"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type mediaThumb struct {
+	Url string `xml:"url,attr"`
+}
+
+// Atom renders the feed as RFC 4287 document, the preview image is a media
+// thumbnail and the recording is an enclosure link.
+func (f Feed) Atom() ([]byte, error) {
+	feed := atomFeed{
+		Xmlns:   atomNamespace,
+		Media:   mediaNamespace,
+		Id:      f.SelfUrl,
+		Title:   f.Title,
+		Updated: f.updated().UTC().Format(time.RFC3339),
+		Links:   []atomLink{{Rel: "self", Type: "application/atom+xml", Href: f.SelfUrl}},
+	}
+	for _, e := range f.Entries {
+		entry := atomEntry{
+			Id:        "urn:uuid:" + e.Id.String(),
+			Title:     e.Name,
+			Published: e.StartTime.UTC().Format(time.RFC3339),
+			Updated:   e.UpdatedAt.UTC().Format(time.RFC3339),
+			Author:    e.Owner,
+			Summary:   e.Description,
+			Links:     []atomLink{{Rel: "alternate", Type: "text/html", Href: f.watchUrl(e)}},
+		}
+		if len(e.RecordingUrl) > 0 {
+			entry.Links = append(entry.Links,
+				atomLink{Rel: "enclosure", Type: RecordingContentType(e.RecordingFormat), Href: e.RecordingUrl})
+		}
+		if u := f.previewUrl(e); len(u) > 0 {
+			entry.Thumbnail = &mediaThumb{u}
+		}
+		for _, tag := range e.Tags {
+			entry.Categories = append(entry.Categories, atomCategory{tag})
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	return marshalFeed(feed)
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Media   string     `xml:"xmlns:media,attr"`
+	Dc      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Self          atomLink  `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	Description string        `xml:"description,omitempty"`
+	Creator     string        `xml:"dc:creator"`
+	Guid        rssGuid       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Categories  []string      `xml:"category"`
+	Enclosure   *rssEnclosure `xml:"enclosure"`
+	Thumbnail   *mediaThumb   `xml:"media:thumbnail"`
+}
+
+type rssGuid struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	Url    string `xml:"url,attr"`
+	Length int    `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+// RSS renders the feed as RSS 2.0 document, enclosures have zero length as
+// the size of recordings is not always known.
+func (f Feed) RSS() ([]byte, error) {
+	feed := rssFeed{
+		Version: "2.0",
+		Atom:    atomNamespace,
+		Media:   mediaNamespace,
+		Dc:      dcNamespace,
+		Channel: rssChannel{
+			Title:         f.Title,
+			Link:          f.SelfUrl,
+			Description:   f.Title,
+			LastBuildDate: f.updated().UTC().Format(time.RFC1123Z),
+			Self:          atomLink{Rel: "self", Type: "application/rss+xml", Href: f.SelfUrl},
+		},
+	}
+	for _, e := range f.Entries {
+		item := rssItem{
+			Title:       e.Name,
+			Link:        f.watchUrl(e),
+			Description: e.Description,
+			Creator:     e.Owner,
+			Guid:        rssGuid{Value: "urn:uuid:" + e.Id.String()},
+			PubDate:     e.StartTime.UTC().Format(time.RFC1123Z),
+			Categories:  e.Tags,
+		}
+		if len(e.RecordingUrl) > 0 {
+			item.Enclosure = &rssEnclosure{Url: e.RecordingUrl, Type: RecordingContentType(e.RecordingFormat)}
+		}
+		if u := f.previewUrl(e); len(u) > 0 {
+			item.Thumbnail = &mediaThumb{u}
+		}
+		feed.Channel.Items = append(feed.Channel.Items, item)
+	}
+	return marshalFeed(feed)
+}
+
+func marshalFeed(v interface{}) ([]byte, error) {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
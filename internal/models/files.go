@@ -0,0 +1,65 @@
+package models
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/alexm24/golang/internal/handler/api"
+)
+
+var (
+	ErrFileTooLarge = errors.New(MsgFileTooLarge)
+	ErrFileNotFound = errors.New(MsgFileNotFound)
+)
+
+// fileTypes are slides, documents and images that can be shared with viewers.
+var fileTypes = map[string]string{
+	".pdf":  "application/pdf",
+	".zip":  "application/zip",
+	".doc":  "application/msword",
+	".xls":  "application/vnd.ms-excel",
+	".ppt":  "application/vnd.ms-powerpoint",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	".odt":  "application/vnd.oasis.opendocument.text",
+	".ods":  "application/vnd.oasis.opendocument.spreadsheet",
+	".odp":  "application/vnd.oasis.opendocument.presentation",
+	".gif":  "image/gif",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+	".csv":  "text/csv",
+	".txt":  "text/plain",
+}
+
+type FileInfo struct {
+	api.SIdentifier
+	api.SFileInfo
+}
+
+type File struct {
+	FileInfo
+	Data []byte `db:"file" json:"-"`
+}
+
+// DetectFileType sniffs the content and falls back to the extension for
+// containers such as docx or pptx which are detected as zip or octet-stream.
+func DetectFileType(name string, data []byte) (string, error) {
+	detected, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	switch detected {
+	case "application/zip", "application/octet-stream", "text/plain":
+		if byExt, ok := fileTypes[strings.ToLower(filepath.Ext(name))]; ok {
+			detected = byExt
+		}
+	}
+	for _, t := range fileTypes {
+		if t == detected {
+			return detected, nil
+		}
+	}
+	return detected, errors.New(MsgInvalidFileType)
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/alexm24/golang/internal/handler/api"
+)
+
+var (
+	ErrStreamKeyUnknown   = errors.New(MsgStreamKeyUnknown)
+	ErrStreamKeyExpired   = errors.New(MsgStreamKeyExpired)
+	ErrStreamKeyNotActive = errors.New(MsgStreamKeyNotActive)
+)
+
+type IngestEvent struct {
+	StreamKey string
+	Path      string
+}
+
+// NewIngestEvent accepts both nginx-rtmp (name, path) and SRS (stream, file) field names.
+func NewIngestEvent(cb api.SIngestCallback) (IngestEvent, error) {
+	var e IngestEvent
+	switch {
+	case cb.Name != nil:
+		e.StreamKey = *cb.Name
+	case cb.Stream != nil:
+		e.StreamKey = *cb.Stream
+	}
+	switch {
+	case cb.Path != nil:
+		e.Path = *cb.Path
+	case cb.File != nil:
+		e.Path = *cb.File
+	}
+
+	e.StreamKey = strings.TrimSpace(e.StreamKey)
+	if len(e.StreamKey) == 0 {
+		return e, errors.New(MsgStreamKeyEmpty)
+	}
+	return e, nil
+}
+
+// CheckPublish reports whether the broadcast accepts a stream at now: past
+// broadcasts are expired, upcoming ones open early before start_time.
+func (b *Broadcasts) CheckPublish(now time.Time, early time.Duration) error {
+	if b.Life != nil && *b.Life == Past.String() {
+		return ErrStreamKeyExpired
+	}
+	if b.StartTime != nil && now.Before(b.StartTime.Add(-early)) {
+		return ErrStreamKeyNotActive
+	}
+	return nil
+}
+
+// Ended reports whether the broadcast is over at now: after end_time or, without
+// it, after start_time plus the default duration, the same rule as the scheduler.
+func (b *Broadcasts) Ended(now time.Time, duration time.Duration) bool {
+	switch {
+	case b.EndTime != nil:
+		return !now.Before(*b.EndTime)
+	case b.StartTime != nil:
+		return !now.Before(b.StartTime.Add(duration))
+	}
+	return false
+}
@@ -2,16 +2,57 @@ package models
 
 import (
 	"errors"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
 
 	"github.com/alexm24/golang/internal/handler/api"
 )
 
+var (
+	ErrMessageNotFound  = errors.New(MsgMessageNotFound)
+	ErrNotAuthor        = errors.New(MsgNotAuthor)
+	ErrEditWindowClosed = errors.New(MsgEditWindowClosed)
+	ErrNotModerator     = errors.New(MsgNotModerator)
+	ErrParentNotFound   = errors.New(MsgParentNotFound)
+)
+
 type Messages struct {
 	api.SIdentifier
 	api.SFullname
 	api.SUsername
 	api.SMessage
-	Channel string `json:"-" db:"channel"`
+	api.SEdited
+	api.SParent
+	api.SReplyCount
+	Channel   string     `json:"-" db:"channel"`
+	CreatedAt *time.Time `json:"-" db:"created_at"`
+	// Hidden is set with Channel for questions shown to moderators only.
+	Hidden bool `json:"-" db:"hidden"`
+}
+
+// ThreadId is the message replies to the message are threaded under.
+func (m *Messages) ThreadId() *types.UUID {
+	if m.ParentId != nil {
+		return m.ParentId
+	}
+	return m.Id
+}
+
+func (m *Messages) IsAuthor(actor *string) bool {
+	return actor != nil && m.Username != nil && *m.Username == *actor
+}
+
+// Editable reports whether the message was stored within the edit window,
+// the time sent by the client is not trusted.
+func (m *Messages) Editable(window time.Duration, now time.Time) bool {
+	return window <= 0 || m.CreatedAt != nil && now.Sub(*m.CreatedAt) <= window
+}
+
+// Deleted reports whether only the tombstone of the message is left.
+func (m *Messages) Deleted() bool {
+	return m.DeletedAt != nil
 }
 
 type PostMessage api.PostMsgByChannelJSONBody
@@ -41,6 +82,21 @@ func (p *PostMessage) Validate() error {
 	return nil
 }
 
+type EditMessage api.EditMsgJSONBody
+
+func (e *EditMessage) Validate() error {
+	if e.Text == nil || len(strings.TrimSpace(*e.Text)) == 0 {
+		return errors.New(MsgTextEmpty)
+	}
+	return nil
+}
+
+// MessageEdit keeps the text the message had before the edit.
+type MessageEdit struct {
+	api.SIdentifier
+	api.SMessageEdit
+}
+
 type PostReactionMsg api.PostReactionMsgJSONBody
 
 func (p *PostReactionMsg) Validate() error {
@@ -67,3 +123,64 @@ func (p *PatchReactionMsg) Validate() error {
 	}
 	return nil
 }
+
+// SortTime is the sort of message cursors, messages are ordered by time and id.
+const SortTime = "time"
+
+// MessageFilter is a page of the channel history: the latest messages, the
+// ones older than Before or the ones newer than After, with questions which
+// are not public for moderators only.
+type MessageFilter struct {
+	Channel   string
+	Before    *Cursor
+	After     *Cursor
+	Limit     int
+	Moderator bool
+}
+
+func NewMessageFilter(channel string, before, after *string, limit *int) (MessageFilter, error) {
+	f := MessageFilter{Channel: channel}
+
+	var err error
+	if f.Limit, err = ParseLimit(limit); err != nil {
+		return f, err
+	}
+
+	if before != nil && after != nil {
+		return f, errors.New(MsgCursorConflict)
+	}
+	if f.Before, err = decodeMessageCursor(before); err != nil {
+		return f, err
+	}
+	if f.After, err = decodeMessageCursor(after); err != nil {
+		return f, err
+	}
+
+	return f, nil
+}
+
+func decodeMessageCursor(s *string) (*Cursor, error) {
+	if s == nil {
+		return nil, nil
+	}
+	c, err := DecodeCursor(*s)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = time.Parse(time.RFC3339Nano, c.Value); c.Sort != SortTime || err != nil {
+		return nil, errors.New(MsgInvalidCursor)
+	}
+	return &c, nil
+}
+
+func MessageCursor(item Messages) Cursor {
+	return Cursor{Sort: SortTime, Value: item.Time.Format(time.RFC3339Nano), Id: *item.Id}
+}
+
+// MessagesPage is ordered by time, Prev points to older messages and Next to
+// newer ones.
+type MessagesPage struct {
+	Items []Messages
+	Prev  *Cursor
+	Next  *Cursor
+}
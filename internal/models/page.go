@@ -0,0 +1,193 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+const (
+	SortStartTime = "start_time"
+	SortName      = "name"
+
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// Cursor keeps the sort and order of the page it was issued for, it is
+// rejected for any other.
+type Cursor struct {
+	Sort  string     `json:"s"`
+	Order string     `json:"o,omitempty"`
+	Value string     `json:"v"`
+	Id    types.UUID `json:"i"`
+}
+
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(s string) (Cursor, error) {
+	var c Cursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, errors.New(MsgInvalidCursor)
+	}
+	if err = json.Unmarshal(data, &c); err != nil {
+		return c, errors.New(MsgInvalidCursor)
+	}
+	return c, nil
+}
+
+type BroadcastFilter struct {
+	Owner  *string
+	From   *time.Time
+	To     *time.Time
+	Life   []LifeCycleBroadcast
+	Sort   string
+	Order  string
+	Cursor *Cursor
+	Limit  int
+	Viewer *string
+	Tags   []string
+}
+
+// ParseLimit returns the default page size for an empty limit.
+func ParseLimit(limit *int) (int, error) {
+	if limit == nil {
+		return DefaultPageLimit, nil
+	}
+	if *limit < 1 || *limit > MaxPageLimit {
+		return 0, errors.New(MsgInvalidLimit)
+	}
+	return *limit, nil
+}
+
+func NewBroadcastFilter(owner *string, from, to *time.Time, sort, order, cursor *string, limit *int) (BroadcastFilter, error) {
+	return newBroadcastFilter(owner, from, to, sort, order, cursor, limit, OrderAsc)
+}
+
+// NewArchFilter lists the archive newest first unless the order is given.
+func NewArchFilter(owner *string, from, to *time.Time, sort, order, cursor *string, limit *int) (BroadcastFilter, error) {
+	return newBroadcastFilter(owner, from, to, sort, order, cursor, limit, OrderDesc)
+}
+
+func newBroadcastFilter(owner *string, from, to *time.Time, sort, order, cursor *string, limit *int,
+	defaultOrder string) (BroadcastFilter, error) {
+	f := BroadcastFilter{
+		Owner: owner,
+		From:  from,
+		To:    to,
+		Sort:  SortStartTime,
+		Order: defaultOrder,
+		Limit: DefaultPageLimit,
+	}
+
+	if sort != nil {
+		switch *sort {
+		case SortStartTime, SortName:
+			f.Sort = *sort
+		default:
+			return f, errors.New(MsgInvalidSort)
+		}
+	}
+
+	if order != nil {
+		switch *order {
+		case OrderAsc, OrderDesc:
+			f.Order = *order
+		default:
+			return f, errors.New(MsgInvalidOrder)
+		}
+	}
+
+	var err error
+	if f.Limit, err = ParseLimit(limit); err != nil {
+		return f, err
+	}
+
+	if from != nil && to != nil && !to.After(*from) {
+		return f, errors.New(MsgInvalidDateRange)
+	}
+
+	if cursor != nil {
+		c, err := DecodeCursor(*cursor)
+		if err != nil {
+			return f, err
+		}
+		if c.Sort != f.Sort || c.Order != f.Order {
+			return f, errors.New(MsgInvalidCursor)
+		}
+		f.Cursor = &c
+	}
+
+	return f, nil
+}
+
+func (f *BroadcastFilter) SetLife(life string) error {
+	f.Life = nil
+	for _, l := range strings.Split(life, ",") {
+		item, err := ParseLifeCycle(strings.TrimSpace(l))
+		if err != nil {
+			return err
+		}
+		f.Life = append(f.Life, item)
+	}
+	return nil
+}
+
+func (f *BroadcastFilter) SetTags(tags string) error {
+	names, err := ParseTags(tags)
+	if err != nil {
+		return err
+	}
+	f.Tags = names
+	return nil
+}
+
+// NextCursor returns the cursor pointing after item in the filter sort order.
+func (f *BroadcastFilter) NextCursor(item Broadcasts) Cursor {
+	c := Cursor{Sort: f.Sort, Order: f.Order, Id: *item.Id}
+	switch f.Sort {
+	case SortName:
+		c.Value = *item.Name
+	default:
+		c.Value = item.StartTime.Format(time.RFC3339Nano)
+	}
+	return c
+}
+
+type BroadcastsPage struct {
+	Items      []Broadcasts
+	Total      int
+	NextCursor *Cursor
+	Facets     []Facet
+}
+
+// PageBody is the response body of a page, the total and the next cursor are
+// repeated in the X-Total-Count and Link headers.
+type PageBody struct {
+	Items      []Broadcasts `json:"items"`
+	Total      int          `json:"total"`
+	NextCursor *string      `json:"next_cursor,omitempty"`
+}
+
+func (p *BroadcastsPage) Body() PageBody {
+	body := PageBody{Items: p.Items, Total: p.Total}
+	if body.Items == nil {
+		body.Items = make([]Broadcasts, 0)
+	}
+	if p.NextCursor != nil {
+		next := p.NextCursor.Encode()
+		body.NextCursor = &next
+	}
+	return body
+}
@@ -3,9 +3,20 @@ package models
 import (
 	"errors"
 
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
 	"github.com/alexm24/golang/internal/handler/api"
 )
 
+type RegistrationStatus string
+
+const (
+	RegistrationConfirmed  RegistrationStatus = "confirmed"
+	RegistrationWaitlisted RegistrationStatus = "waitlisted"
+)
+
+var ErrNotRegistered = errors.New(MsgNotRegistered)
+
 type Participant struct {
 	api.SIdentifier
 	api.SUsername
@@ -27,3 +38,22 @@ func (u *PostParticipant) Validate() error {
 	}
 	return nil
 }
+
+type Registration struct {
+	api.SRegistration
+	BroadcastId types.UUID `db:"broadcast_id" json:"-"`
+}
+
+type RegistrationCounts struct {
+	Capacity   *int `json:"capacity,omitempty" db:"capacity"`
+	Confirmed  int  `json:"confirmed" db:"confirmed"`
+	Waitlisted int  `json:"waitlisted" db:"waitlisted"`
+}
+
+// Promotion is the mail to a user who took a seat from the waitlist.
+type Promotion struct {
+	Email    string
+	Fullname string
+	Name     string
+	Url      string
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/alexm24/golang/internal/handler/api"
+)
+
+type QuestionState string
+
+const (
+	QuestionPending   QuestionState = "pending"
+	QuestionApproved  QuestionState = "approved"
+	QuestionAnswered  QuestionState = "answered"
+	QuestionDismissed QuestionState = "dismissed"
+)
+
+const moderatorsSuffix = ".moderators"
+
+var (
+	ErrQuestionNotFound     = errors.New(MsgQuestionNotFound)
+	ErrInvalidQuestionState = errors.New(MsgInvalidQuestionState)
+	ErrNotChatModerator     = errors.New(MsgNotChatModerator)
+	ErrQuestionNotApproved  = errors.New(MsgQuestionNotApproved)
+	ErrQuestionDismissed    = errors.New(MsgQuestionDismissed)
+)
+
+// Closed questions are no longer pinned.
+func (s QuestionState) Closed() bool {
+	return s == QuestionAnswered || s == QuestionDismissed
+}
+
+// Public questions are shown to everyone, the others to moderators only.
+func (s QuestionState) Public() bool {
+	return s == QuestionApproved || s == QuestionAnswered
+}
+
+// ModeratorsChannel is the channel questions which are not public are
+// published to, only moderators of the chat may subscribe to it.
+func ModeratorsChannel(channel string) string {
+	return channel + moderatorsSuffix
+}
+
+// ChatOfModerators returns the chat channel of a moderators channel, ok is
+// false for other channels.
+func ChatOfModerators(channel string) (chat string, ok bool) {
+	if !strings.HasSuffix(channel, moderatorsSuffix) {
+		return channel, false
+	}
+	return strings.TrimSuffix(channel, moderatorsSuffix), true
+}
+
+// PublicStates keeps the public ones of the states, nil stands for all of them.
+func PublicStates(states []QuestionState) []QuestionState {
+	if states == nil {
+		return []QuestionState{QuestionApproved, QuestionAnswered}
+	}
+	var public = make([]QuestionState, 0, len(states))
+	for _, s := range states {
+		if s.Public() {
+			public = append(public, s)
+		}
+	}
+	return public
+}
+
+func ParseQuestionState(s string) (QuestionState, error) {
+	switch q := QuestionState(s); q {
+	case QuestionPending, QuestionApproved, QuestionAnswered, QuestionDismissed:
+		return q, nil
+	}
+	return QuestionPending, ErrInvalidQuestionState
+}
+
+// ParseQuestionStates parses comma separated states, nil stands for all of them.
+func ParseQuestionStates(s *string) ([]QuestionState, error) {
+	if s == nil || len(*s) == 0 {
+		return nil, nil
+	}
+	var states []QuestionState
+	for _, v := range strings.Split(*s, ",") {
+		state, err := ParseQuestionState(strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// Question is a message marked as a question, Pinned is the one being
+// answered now.
+type Question struct {
+	Messages
+	api.SQuestion
+}
+
+func (q *Question) InState(state QuestionState) bool {
+	return q.QuestionState != nil && QuestionState(*q.QuestionState) == state
+}
+
+func (q *Question) Public() bool {
+	return q.QuestionState != nil && QuestionState(*q.QuestionState).Public()
+}
+
+type PatchQuestion api.PatchQuestionJSONBody
+
+func (p *PatchQuestion) Validate() error {
+	if p.State == nil {
+		return ErrInvalidQuestionState
+	}
+	_, err := ParseQuestionState(*p.State)
+	return err
+}
@@ -0,0 +1,170 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+)
+
+// recordingTypes are containers of recordings that can be uploaded and played.
+var recordingTypes = map[string]string{
+	"mp4":  "video/mp4",
+	"m4v":  "video/mp4",
+	"webm": "video/webm",
+	"mkv":  "video/x-matroska",
+	"mov":  "video/quicktime",
+	"flv":  "video/x-flv",
+	"ts":   "video/mp2t",
+	"m4a":  "audio/mp4",
+	"mp3":  "audio/mpeg",
+}
+
+var (
+	ErrRecordingNotFound      = errors.New(MsgRecordingNotFound)
+	ErrRecordingOutside       = errors.New(MsgRecordingOutside)
+	ErrInvalidRecordingUrl    = errors.New(MsgInvalidRecordingUrl)
+	ErrInvalidRecordingFormat = errors.New(MsgInvalidRecording)
+	ErrInvalidRecordingMeta   = errors.New(MsgInvalidRecordingMeta)
+	ErrInvalidRecordingLink   = errors.New(MsgInvalidRecordingLink)
+	ErrRecordingLinkExpired   = errors.New(MsgRecordingLinkExpired)
+)
+
+// Recording is a file recorded by the media server or uploaded to the
+// storage, which has a path, or a video hosted elsewhere, which has an url.
+type Recording struct {
+	api.SIdentifier
+	api.SRecording
+	api.SPlayUrl
+	Path *string `db:"path" json:"-"`
+	Url  *string `db:"url" json:"-"`
+}
+
+// RecordingFormat returns the lower case extension of the file name or url path.
+func RecordingFormat(name string) string {
+	if u, err := url.Parse(name); err == nil && len(u.Scheme) > 0 {
+		name = u.Path
+	}
+	return strings.TrimPrefix(strings.ToLower(path.Ext(name)), ".")
+}
+
+// RecordingContentType falls back to octet-stream for unknown formats.
+func RecordingContentType(format *string) string {
+	if format != nil {
+		if t, ok := recordingTypes[*format]; ok {
+			return t
+		}
+	}
+	return "application/octet-stream"
+}
+
+type PostRecording api.PostBroadcastRecordingJSONBody
+
+func (p *PostRecording) Validate() error {
+	if p.Url == nil {
+		return ErrInvalidRecordingUrl
+	}
+	u, err := url.Parse(*p.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return ErrInvalidRecordingUrl
+	}
+	if (p.Duration != nil && *p.Duration < 0) || (p.Size != nil && *p.Size < 0) {
+		return ErrInvalidRecordingMeta
+	}
+	return nil
+}
+
+// Recording returns the linked recording, its format is taken from the url
+// when it is not set.
+func (p *PostRecording) Recording(broadcastId types.UUID, actor *string) Recording {
+	var item Recording
+	item.BroadcastId = &broadcastId
+	item.Url = p.Url
+	item.Duration = p.Duration
+	item.Size = p.Size
+	item.Format = p.Format
+	item.CreatedBy = actor
+	if item.Format == nil || len(*item.Format) == 0 {
+		if format := RecordingFormat(*p.Url); len(format) > 0 {
+			item.Format = &format
+		} else {
+			item.Format = nil
+		}
+	}
+	return item
+}
+
+// RecordingUpload is the file of a recording uploaded to the storage.
+type RecordingUpload struct {
+	Name     string
+	Duration *int
+	Content  io.Reader
+}
+
+func (u *RecordingUpload) Validate() error {
+	if _, ok := recordingTypes[RecordingFormat(u.Name)]; !ok {
+		return ErrInvalidRecordingFormat
+	}
+	if u.Duration != nil && *u.Duration < 0 {
+		return ErrInvalidRecordingMeta
+	}
+	return nil
+}
+
+// RecordingMedia is what a play url resolves to: either the content of the
+// stored file or the url the recording is hosted at.
+type RecordingMedia struct {
+	Name        string
+	ContentType string
+	ModTime     time.Time
+	Content     io.ReadSeekCloser
+	Url         string
+}
+
+// Sign sets the play url of the recording, valid until expires.
+func (r *Recording) Sign(playUrl, secret string, expires time.Time) {
+	link := RecordingPlayUrl(playUrl, secret, *r.Id, expires)
+	r.PlayUrl = &link
+	r.ExpiresAt = &expires
+}
+
+func RecordingPlayUrl(playUrl, secret string, id types.UUID, expires time.Time) string {
+	return fmt.Sprintf("%s/%s/play?expires=%d&signature=%s", strings.TrimSuffix(playUrl, "/"), id,
+		expires.Unix(), SignRecording(secret, id, expires.Unix()))
+}
+
+// RecordingWatchUrl is the stable link of the recording, it redirects to a
+// play url signed on request.
+func RecordingWatchUrl(playUrl string, id types.UUID) string {
+	return fmt.Sprintf("%s/%s/watch", strings.TrimSuffix(playUrl, "/"), id)
+}
+
+// SignRecording returns the hex HMAC-SHA256 of the recording id, a dot and
+// the unix time the link expires at.
+func SignRecording(secret string, id types.UUID, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(id.String() + "." + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func CheckRecordingSignature(secret string, id types.UUID, expires int64, signature string, now time.Time) error {
+	expected := SignRecording(secret, id, expires)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidRecordingLink
+	}
+	if now.Unix() > expires {
+		return ErrRecordingLinkExpired
+	}
+	return nil
+}
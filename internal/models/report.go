@@ -0,0 +1,239 @@
+package models
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/alexm24/golang/internal/handler/api"
+)
+
+type ReportFormat string
+
+const (
+	ReportCSV  ReportFormat = "csv"
+	ReportXLSX ReportFormat = "xlsx"
+)
+
+// csvBOM lets spreadsheet applications detect UTF-8 in CSV files.
+const csvBOM = "\ufeff"
+
+var (
+	ErrInvalidReportFormat = errors.New(MsgInvalidReportFormat)
+	ErrReportMailNotFound  = errors.New(MsgReportMailNotFound)
+)
+
+// ParseReportFormat returns csv for an empty value.
+func ParseReportFormat(s *string) (ReportFormat, error) {
+	if s == nil || len(*s) == 0 {
+		return ReportCSV, nil
+	}
+	switch format := ReportFormat(strings.ToLower(*s)); format {
+	case ReportCSV, ReportXLSX:
+		return format, nil
+	}
+	return "", ErrInvalidReportFormat
+}
+
+type ReportMail struct {
+	api.SReportMail
+	BroadcastId types.UUID `db:"broadcast_id" json:"-"`
+}
+
+type PutReportMail api.PutBroadcastReportMailJSONBody
+
+func (p *PutReportMail) Validate() error {
+	if p.Email == nil || len(strings.TrimSpace(*p.Email)) == 0 {
+		return errors.New(MsgEmailEmpty)
+	}
+	return nil
+}
+
+// ReportLetter is the mail with the report of a finished broadcast.
+type ReportLetter struct {
+	Email string
+	Name  string
+	File  ReportFile
+}
+
+type ReportFile struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+type Report struct {
+	Broadcast    Broadcasts
+	Participants []Participant
+	Questions    []Messages
+	Analytics    Analytics
+}
+
+type reportSheet struct {
+	Name string
+	Rows [][]interface{}
+}
+
+// File renders the report in the given format.
+func (r Report) File(format ReportFormat) (ReportFile, error) {
+	file := ReportFile{Name: fmt.Sprintf("report-%s.%s", r.Broadcast.Id, format)}
+
+	var err error
+	switch format {
+	case ReportXLSX:
+		file.ContentType = ContentTypeXLSX
+		file.Data, err = r.XLSX()
+	default:
+		file.ContentType = ContentTypeCSV
+		file.Data, err = r.CSV()
+	}
+	return file, err
+}
+
+// CSV renders the sheets of the report one after another, each one starts
+// with its name and is separated by an empty line.
+func (r Report) CSV() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(csvBOM)
+
+	w := csv.NewWriter(&buf)
+	for n, sheet := range r.sheets() {
+		if n > 0 {
+			if err := w.Write([]string{}); err != nil {
+				return nil, err
+			}
+		}
+		if err := w.Write([]string{sheet.Name}); err != nil {
+			return nil, err
+		}
+		for _, row := range sheet.Rows {
+			record := make([]string, len(row))
+			for i, v := range row {
+				if v != nil {
+					record[i] = fmt.Sprint(v)
+				}
+			}
+			if err := w.Write(record); err != nil {
+				return nil, err
+			}
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// XLSX renders every sheet of the report as a worksheet.
+func (r Report) XLSX() ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	for n, sheet := range r.sheets() {
+		if n == 0 {
+			if err := f.SetSheetName(f.GetSheetName(0), sheet.Name); err != nil {
+				return nil, err
+			}
+		} else if _, err := f.NewSheet(sheet.Name); err != nil {
+			return nil, err
+		}
+
+		for i, row := range sheet.Rows {
+			cell, err := excelize.CoordinatesToCellName(1, i+1)
+			if err != nil {
+				return nil, err
+			}
+			row := row
+			if err = f.SetSheetRow(sheet.Name, cell, &row); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (r Report) sheets() []reportSheet {
+	a := r.Analytics
+	stats := [][]interface{}{
+		{"Broadcast", stringValue(r.Broadcast.Name)},
+		{"Owner", stringValue(r.Broadcast.Owner)},
+		{"Start time", timeValue(r.Broadcast.StartTime)},
+		{"End time", timeValue(r.Broadcast.EndTime)},
+		{"Messages", a.Messages},
+		{"Questions", a.Questions},
+		{"Chatters", a.Chatters},
+		{"Reactions", a.Reactions},
+		{"Confirmed", a.Confirmed},
+		{"Waitlisted", a.Waitlisted},
+		{"Peak viewers", a.PeakViewers},
+	}
+	for _, reaction := range a.ReactionTypes {
+		stats = append(stats, []interface{}{"Reactions: " + reaction.Type, reaction.Count})
+	}
+
+	participants := [][]interface{}{{"Username", "Fullname", "Email"}}
+	for _, p := range r.Participants {
+		participants = append(participants,
+			[]interface{}{stringValue(p.Username), stringValue(p.Fullname), stringValue(p.Email)})
+	}
+
+	questions := [][]interface{}{{"Time", "Username", "Fullname", "Question", "Reactions"}}
+	for _, q := range r.Questions {
+		row := []interface{}{timeValue(q.Time), stringValue(q.Username), stringValue(q.Fullname),
+			stringValue(q.Text), reactionsValue(q.Reactions)}
+		// authors of anonymous questions are not exported
+		if q.IsAnon != nil && *q.IsAnon {
+			row[1], row[2] = nil, nil
+		}
+		questions = append(questions, row)
+	}
+
+	return []reportSheet{
+		{Name: "Statistics", Rows: stats},
+		{Name: "Participants", Rows: participants},
+		{Name: "Questions", Rows: questions},
+	}
+}
+
+// reactionsValue counts reactions of a message by type, e.g. "like: 2, heart: 1".
+func reactionsValue(s *string) interface{} {
+	if s == nil {
+		return nil
+	}
+
+	var reactions map[string]string
+	if err := json.Unmarshal([]byte(*s), &reactions); err != nil || len(reactions) == 0 {
+		return nil
+	}
+
+	counts := make(map[string]int)
+	for _, t := range reactions {
+		counts[t]++
+	}
+	names := make([]string, 0, len(counts))
+	for t := range counts {
+		names = append(names, t)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if counts[names[i]] != counts[names[j]] {
+			return counts[names[i]] > counts[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	values := make([]string, len(names))
+	for i, t := range names {
+		values[i] = fmt.Sprintf("%s: %d", t, counts[t])
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,139 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+type RevisionAction string
+
+const (
+	RevisionCreate  RevisionAction = "create"
+	RevisionUpdate  RevisionAction = "update"
+	RevisionDelete  RevisionAction = "delete"
+	RevisionRestore RevisionAction = "restore"
+	RevisionImage   RevisionAction = "image"
+	RevisionRevert  RevisionAction = "revert"
+)
+
+var ErrRevisionNotFound = errors.New(MsgRevisionNotFound)
+
+type Revision struct {
+	Id          types.UUID       `db:"id" json:"id"`
+	BroadcastId types.UUID       `db:"broadcast_id" json:"broadcast_id"`
+	Action      RevisionAction   `db:"action" json:"action"`
+	Actor       *string          `db:"actor" json:"actor,omitempty"`
+	Changes     RevisionChanges  `db:"changes" json:"changes"`
+	Snapshot    RevisionSnapshot `db:"snapshot" json:"-"`
+	CreatedAt   time.Time        `db:"created_at" json:"created_at"`
+}
+
+// RevisionChange holds old and new values of a field, values of secret
+// fields such as the stream key are not stored.
+type RevisionChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+type RevisionChanges []RevisionChange
+
+func (c RevisionChanges) Value() (driver.Value, error) {
+	if c == nil {
+		c = RevisionChanges{}
+	}
+	return json.Marshal(c)
+}
+
+func (c *RevisionChanges) Scan(src interface{}) error {
+	return scanJSON(src, c)
+}
+
+// RevisionSnapshot is the state of the broadcast after the change, used to revert it.
+type RevisionSnapshot Broadcasts
+
+func (s RevisionSnapshot) Value() (driver.Value, error) {
+	s.StreamKey = nil
+	return json.Marshal(s)
+}
+
+func (s *RevisionSnapshot) Scan(src interface{}) error {
+	return scanJSON(src, s)
+}
+
+func NewRevision(action RevisionAction, actor *string, before, after Broadcasts) Revision {
+	changes := DiffBroadcasts(before, after)
+	if action == RevisionImage {
+		changes = append(changes, RevisionChange{Field: "image"})
+	}
+	return Revision{
+		BroadcastId: *after.Id,
+		Action:      action,
+		Actor:       actor,
+		Changes:     changes,
+		Snapshot:    RevisionSnapshot(after),
+	}
+}
+
+// DiffBroadcasts returns the fields changed between two states of a broadcast.
+func DiffBroadcasts(before, after Broadcasts) RevisionChanges {
+	var changes = make(RevisionChanges, 0)
+	add := func(field string, old, new interface{}) {
+		if !reflect.DeepEqual(old, new) {
+			changes = append(changes, RevisionChange{Field: field, Old: old, New: new})
+		}
+	}
+
+	add("name", stringValue(before.Name), stringValue(after.Name))
+	add("description", stringValue(before.Description), stringValue(after.Description))
+	add("owner", stringValue(before.Owner), stringValue(after.Owner))
+	add("preview_url", stringValue(before.PreviewUrl), stringValue(after.PreviewUrl))
+	add("start_time", timeValue(before.StartTime), timeValue(after.StartTime))
+	add("end_time", timeValue(before.EndTime), timeValue(after.EndTime))
+	add("visibility", stringValue(before.Visibility), stringValue(after.Visibility))
+	add("tags", tagsValue(before.Tags), tagsValue(after.Tags))
+	if !reflect.DeepEqual(stringValue(before.StreamKey), stringValue(after.StreamKey)) {
+		changes = append(changes, RevisionChange{Field: "stream_key"})
+	}
+
+	return changes
+}
+
+func stringValue(s *string) interface{} {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+func tagsValue(t TagList) interface{} {
+	if len(t) == 0 {
+		return nil
+	}
+	return []string(t)
+}
+
+func timeValue(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func scanJSON(src interface{}, dst interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, dst)
+	case string:
+		return json.Unmarshal([]byte(v), dst)
+	case nil:
+		return nil
+	}
+	return fmt.Errorf("unsupported json type %T", src)
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/alexm24/golang/internal/handler/api"
+)
+
+type Search struct {
+	Broadcasts []api.SSearchBroadcast `json:"broadcasts"`
+	Messages   []api.SSearchMessage   `json:"messages"`
+}
+
+type SearchQuery struct {
+	Text     string
+	Username *string
+	IsAdmin  bool
+	Limit    int
+}
+
+func NewSearchQuery(params api.SearchParams) (SearchQuery, error) {
+	q := SearchQuery{
+		Text:     strings.TrimSpace(params.Q),
+		Username: params.Username,
+		Limit:    DefaultPageLimit,
+	}
+	if len(q.Text) == 0 {
+		return q, errors.New(MsgQueryEmpty)
+	}
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > MaxPageLimit {
+			return q, errors.New(MsgInvalidLimit)
+		}
+		q.Limit = *params.Limit
+	}
+	return q, nil
+}
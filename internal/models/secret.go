@@ -0,0 +1,15 @@
+package models
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// NewSecret returns 32 random bytes hex encoded, used for stream keys and feed tokens.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+type SeriesScope string
+
+const (
+	ScopeThis      SeriesScope = "this"
+	ScopeFollowing SeriesScope = "following"
+	ScopeAll       SeriesScope = "all"
+)
+
+const (
+	MaxSeriesOccurrences = 100
+	SeriesHorizon        = 365 * 24 * time.Hour
+)
+
+func ParseScope(scope *string) (SeriesScope, error) {
+	if scope == nil {
+		return ScopeThis, nil
+	}
+	switch s := SeriesScope(*scope); s {
+	case ScopeThis, ScopeFollowing, ScopeAll:
+		return s, nil
+	}
+	return ScopeThis, errors.New(MsgInvalidScope)
+}
+
+// Occurrences expands the rrule of the broadcast starting at its start_time.
+// Rules without COUNT or UNTIL are cut at SeriesHorizon or MaxSeriesOccurrences,
+// bounded rules longer than MaxSeriesOccurrences are rejected.
+func (p *PostBroadcast) Occurrences() ([]time.Time, error) {
+	rule := strings.TrimPrefix(strings.TrimSpace(*p.Rrule), "RRULE:")
+	opt, err := rrule.StrToROptionInLocation(rule, p.StartTime.Location())
+	if err != nil {
+		return nil, errors.New(MsgInvalidRRule)
+	}
+	opt.Dtstart = p.StartTime.Truncate(time.Second)
+
+	r, err := rrule.NewRRule(*opt)
+	if err != nil {
+		return nil, errors.New(MsgInvalidRRule)
+	}
+
+	bounded := opt.Count > 0 || !opt.Until.IsZero()
+	horizon := opt.Dtstart.Add(SeriesHorizon)
+
+	items := make([]time.Time, 0)
+	next := r.Iterator()
+	for t, ok := next(); ok && (bounded || !t.After(horizon)); t, ok = next() {
+		if len(items) == MaxSeriesOccurrences {
+			if bounded {
+				return nil, errors.New(MsgSeriesTooLong)
+			}
+			break
+		}
+		items = append(items, t)
+	}
+	if len(items) == 0 {
+		return nil, errors.New(MsgSeriesEmpty)
+	}
+	return items, nil
+}
@@ -6,10 +6,14 @@ import (
 	"github.com/alexm24/golang/internal/handler/api"
 )
 
+var ErrStreamNotFound = errors.New(MsgStreamNotFound)
+
 type Stream struct {
 	api.SIdentifier
 	api.SUsername
 	api.SDescription
+	api.SStreamKey
+	Version int `db:"version" json:"-"`
 }
 
 type PutStream api.PutStreamJSONBody
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/alexm24/golang/internal/handler/api"
+)
+
+var (
+	ErrInvalidTag       = errors.New(MsgInvalidTag)
+	ErrUnknownTag       = errors.New(MsgUnknownTag)
+	ErrTagNotFound      = errors.New(MsgTagNotFound)
+	ErrCategoryNotFound = errors.New(MsgCategoryNotFound)
+)
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// ValidateTags checks tag names, the vocabulary is checked when they are saved.
+func ValidateTags(names []string) error {
+	for _, name := range names {
+		if len(name) > 100 || !tagPattern.MatchString(name) {
+			return ErrInvalidTag
+		}
+	}
+	return nil
+}
+
+// ParseTags splits a comma separated list of tags, duplicates are dropped.
+func ParseTags(s string) ([]string, error) {
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	if err := ValidateTags(names); err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// TagList is the json array of tag names aggregated with the broadcast.
+type TagList []string
+
+func (l *TagList) Scan(src interface{}) error {
+	return scanJSON(src, l)
+}
+
+type Tag api.STag
+
+func (t *Tag) Validate() error {
+	if t.Name == nil {
+		return errors.New(MsgNameEmpty)
+	}
+	return ValidateTags([]string{*t.Name})
+}
+
+type Category api.SCategory
+
+func (c *Category) Validate() error {
+	if c.Name == nil || len(strings.TrimSpace(*c.Name)) == 0 {
+		return errors.New(MsgNameEmpty)
+	}
+	return nil
+}
+
+// Facet is the number of broadcasts matching the filter with the tag.
+type Facet struct {
+	Tag   string `db:"tag"`
+	Count int    `db:"count"`
+}
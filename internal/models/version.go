@@ -0,0 +1,31 @@
+package models
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrVersionMismatch = errors.New(MsgVersionMismatch)
+
+func ETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// ParseIfMatch returns the version expected by the client, nil when the
+// header is missing or matches any version.
+func ParseIfMatch(header *string) (*int, error) {
+	if header == nil {
+		return nil, nil
+	}
+	tag := strings.TrimSpace(*header)
+	if len(tag) == 0 || tag == "*" {
+		return nil, nil
+	}
+
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(tag, "W/"), `"`))
+	if err != nil {
+		return nil, errors.New(MsgInvalidIfMatch)
+	}
+	return &version, nil
+}
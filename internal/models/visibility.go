@@ -0,0 +1,36 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/alexm24/golang/internal/handler/api"
+)
+
+type Visibility string
+
+const (
+	VisibilityPublic     Visibility = "public"
+	VisibilityInternal   Visibility = "internal"
+	VisibilityInviteOnly Visibility = "invite-only"
+)
+
+var (
+	ErrNoAccess          = errors.New(MsgNoAccess)
+	ErrAccessNotFound    = errors.New(MsgAccessNotFound)
+	ErrInviteNotFound    = errors.New(MsgInviteNotFound)
+	ErrInvalidVisibility = errors.New(MsgInvalidVisibility)
+)
+
+// ParseVisibility returns public for an empty value.
+func ParseVisibility(s *string) (Visibility, error) {
+	if s == nil || len(*s) == 0 {
+		return VisibilityPublic, nil
+	}
+	switch v := Visibility(*s); v {
+	case VisibilityPublic, VisibilityInternal, VisibilityInviteOnly:
+		return v, nil
+	}
+	return VisibilityPublic, ErrInvalidVisibility
+}
+
+type Access api.SAccess
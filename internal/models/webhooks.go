@@ -0,0 +1,201 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
+
+	"github.com/alexm24/golang/internal/handler/api"
+)
+
+const (
+	EventBroadcastCreated    = "broadcast.created"
+	EventBroadcastChanged    = "broadcast.changed"
+	EventBroadcastStarted    = "broadcast.started"
+	EventBroadcastEnded      = "broadcast.ended"
+	EventRegistrationCreated = "registration.created"
+)
+
+var webhookEvents = map[string]bool{
+	EventBroadcastCreated:    true,
+	EventBroadcastChanged:    true,
+	EventBroadcastStarted:    true,
+	EventBroadcastEnded:      true,
+	EventRegistrationCreated: true,
+}
+
+type WebhookStatus string
+
+const (
+	WebhookPending   WebhookStatus = "pending"
+	WebhookDelivered WebhookStatus = "delivered"
+	WebhookFailed    WebhookStatus = "failed"
+)
+
+const (
+	HeaderWebhookEvent     = "X-Webhook-Event"
+	HeaderWebhookDelivery  = "X-Webhook-Delivery"
+	HeaderWebhookTimestamp = "X-Webhook-Timestamp"
+	HeaderWebhookSignature = "X-Webhook-Signature"
+)
+
+// maxWebhookBackoff bounds the delay between attempts.
+const maxWebhookBackoff = 24 * time.Hour
+
+var (
+	ErrInvalidWebhookUrl = errors.New(MsgInvalidWebhookUrl)
+	ErrUnknownEvent      = errors.New(MsgUnknownEvent)
+	ErrWebhookNotFound   = errors.New(MsgWebhookNotFound)
+)
+
+// EventList is the json array of event types a webhook is subscribed to,
+// an empty list subscribes to all events.
+type EventList []string
+
+func (l EventList) Value() (driver.Value, error) {
+	if l == nil {
+		l = EventList{}
+	}
+	return json.Marshal(l)
+}
+
+func (l *EventList) Scan(src interface{}) error {
+	return scanJSON(src, l)
+}
+
+type Webhook struct {
+	api.SIdentifier
+	api.SWebhook
+	Events EventList `db:"events" json:"events"`
+	Secret *string   `db:"secret" json:"secret,omitempty"`
+}
+
+type PostWebhook api.PostWebhookJSONBody
+
+func (p *PostWebhook) Validate() error {
+	if p.Url == nil {
+		return ErrInvalidWebhookUrl
+	}
+	u, err := url.Parse(*p.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return ErrInvalidWebhookUrl
+	}
+	if p.Events != nil {
+		for _, event := range *p.Events {
+			if !webhookEvents[event] {
+				return ErrUnknownEvent
+			}
+		}
+	}
+	return nil
+}
+
+// EventList returns the events of the webhook without duplicates.
+func (p *PostWebhook) EventList() EventList {
+	var events = EventList{}
+	if p.Events == nil {
+		return events
+	}
+	seen := make(map[string]bool)
+	for _, event := range *p.Events {
+		if !seen[event] {
+			seen[event] = true
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+type WebhookEvent struct {
+	Id   types.UUID  `json:"id"`
+	Type string      `json:"type"`
+	Time time.Time   `json:"time"`
+	Data interface{} `json:"data"`
+}
+
+func NewWebhookEvent(event string, data interface{}) WebhookEvent {
+	return WebhookEvent{Id: uuid.New(), Type: event, Time: time.Now().UTC(), Data: data}
+}
+
+// NewBroadcastEvent returns the event of the broadcast without its stream key.
+func NewBroadcastEvent(event string, item Broadcasts) WebhookEvent {
+	item.StreamKey = nil
+	return NewWebhookEvent(event, item)
+}
+
+// AttemptList is the json array of attempts aggregated with the delivery.
+type AttemptList []api.SWebhookAttempt
+
+func (l *AttemptList) Scan(src interface{}) error {
+	return scanJSON(src, l)
+}
+
+type WebhookDelivery struct {
+	api.SWebhookDelivery
+	History   AttemptList `db:"history" json:"history"`
+	WebhookId types.UUID  `db:"webhook_id" json:"-"`
+	Url       string      `db:"url" json:"-"`
+	Secret    string      `db:"secret" json:"-"`
+	Payload   []byte      `db:"payload" json:"-"`
+}
+
+type WebhookRequest struct {
+	Url     string
+	Headers map[string]string
+	Body    []byte
+}
+
+// Request returns the signed POST request of the delivery.
+func (d WebhookDelivery) Request(now time.Time) WebhookRequest {
+	timestamp := now.Unix()
+	headers := map[string]string{
+		"Content-Type":         "application/json",
+		HeaderWebhookDelivery:  d.Id.String(),
+		HeaderWebhookTimestamp: strconv.FormatInt(timestamp, 10),
+		HeaderWebhookSignature: SignWebhook(d.Secret, timestamp, d.Payload),
+	}
+	if d.Event != nil {
+		headers[HeaderWebhookEvent] = *d.Event
+	}
+	return WebhookRequest{Url: d.Url, Headers: headers, Body: d.Payload}
+}
+
+// SignWebhook returns sha256= followed by the hex HMAC-SHA256 of the unix
+// timestamp, a dot and the body, receivers reject old timestamps to prevent replays.
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookBackoff returns the delay after the given failed attempt, it doubles
+// with every attempt starting from base.
+func WebhookBackoff(base time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < maxWebhookBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxWebhookBackoff {
+		return maxWebhookBackoff
+	}
+	return delay
+}
+
+type registrationData struct {
+	BroadcastId types.UUID `json:"broadcast_id"`
+	api.SRegistration
+}
+
+func NewRegistrationEvent(item Registration) WebhookEvent {
+	return NewWebhookEvent(EventRegistrationCreated, registrationData{item.BroadcastId, item.SRegistration})
+}
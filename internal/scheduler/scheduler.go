@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+type Job func() error
+
+type Scheduler struct {
+	done chan struct{}
+	once sync.Once
+	wg   sync.WaitGroup
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{done: make(chan struct{})}
+}
+
+// Start runs job every interval in its own goroutine until Shutdown is called.
+func (s *Scheduler) Start(name string, interval time.Duration, job Job) {
+	if interval <= 0 {
+		log.Printf("scheduler job %s is disabled: interval %s", name, interval)
+		return
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.done:
+				return
+			case <-ticker.C:
+				if err := job(); err != nil {
+					log.Printf("error occurred in scheduler job %s: %s", name, err.Error())
+				}
+			}
+		}
+	}()
+}
+
+func (s *Scheduler) Shutdown(ctx context.Context) error {
+	s.once.Do(func() { close(s.done) })
+
+	stopped := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type AccessService struct {
+	accessPostgres     transport.IAccessPostgres
+	broadcastsPostgres transport.IBroadcastsPostgres
+	watchUrl           string
+}
+
+func NewAccessService(
+	accessPostgres transport.IAccessPostgres,
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	watchUrl string) *AccessService {
+	return &AccessService{accessPostgres, broadcastsPostgres, watchUrl}
+}
+
+func (a *AccessService) GetAccess(id types.UUID, actor *string) ([]models.Access, error) {
+	if _, err := a.manage(id, actor); err != nil {
+		return nil, err
+	}
+	return a.accessPostgres.GetAccess(id)
+}
+
+func (a *AccessService) GrantAccess(id types.UUID, username string, actor *string) (models.Access, error) {
+	if _, err := a.manage(id, actor); err != nil {
+		return models.Access{}, err
+	}
+	return a.accessPostgres.GrantAccess(id, username, actor)
+}
+
+func (a *AccessService) RevokeAccess(id types.UUID, username string, actor *string) (models.Access, error) {
+	if _, err := a.manage(id, actor); err != nil {
+		return models.Access{}, err
+	}
+
+	item, err := a.accessPostgres.RevokeAccess(id, username)
+	if err != nil {
+		return item, err
+	}
+	if item.Username == nil {
+		return item, models.ErrAccessNotFound
+	}
+	return item, nil
+}
+
+// CreateInvite issues a new invite token, links with the previous token stop working.
+func (a *AccessService) CreateInvite(id types.UUID, actor *string) (api.SInvite, error) {
+	if _, err := a.manage(id, actor); err != nil {
+		return api.SInvite{}, err
+	}
+
+	token, err := models.NewSecret()
+	if err != nil {
+		return api.SInvite{}, err
+	}
+
+	item, err := a.accessPostgres.CreateInvite(id, token)
+	if err != nil {
+		return api.SInvite{}, err
+	}
+	if item.Id == nil {
+		return api.SInvite{}, models.ErrBroadcastNotFound
+	}
+
+	url := strings.TrimSuffix(a.watchUrl, "/") + "/" + id.String() + "?invite=" + token
+	return api.SInvite{Token: &token, Url: &url}, nil
+}
+
+// AcceptInvite puts the user on the allowlist of the broadcast the token belongs to.
+func (a *AccessService) AcceptInvite(token string, username string) (models.Broadcasts, error) {
+	item, err := a.accessPostgres.GetBroadcastByInvite(token)
+	if err != nil {
+		return item, err
+	}
+	if item.Id == nil {
+		return item, models.ErrInviteNotFound
+	}
+
+	if _, err = a.accessPostgres.GrantAccess(*item.Id, username, nil); err != nil {
+		return models.Broadcasts{}, err
+	}
+
+	if !item.IsOwner(&username) {
+		item.StreamKey = nil
+	}
+	return item, nil
+}
+
+func (a *AccessService) manage(id types.UUID, actor *string) (models.Broadcasts, error) {
+	return manageBroadcast(a.broadcastsPostgres, id, actor)
+}
+
+// manageBroadcast returns the broadcast if the actor is its owner or an admin.
+func manageBroadcast(broadcastsPostgres transport.IBroadcastsPostgres, id types.UUID, actor *string) (models.Broadcasts, error) {
+	item, err := broadcastsPostgres.GetBroadcastById(id)
+	if err != nil {
+		return item, err
+	}
+	if item.Id == nil {
+		return item, models.ErrBroadcastNotFound
+	}
+	if item.IsOwner(actor) {
+		return item, nil
+	}
+
+	if actor != nil {
+		isAdmin, err := broadcastsPostgres.CheckAdminUser(api.SUsername{Username: actor})
+		if err != nil {
+			return models.Broadcasts{}, err
+		}
+		if isAdmin {
+			return item, nil
+		}
+	}
+	return models.Broadcasts{}, models.ErrNotOwner
+}
+
+// viewBroadcast returns the broadcast, ErrBroadcastNotFound or ErrNoAccess when
+// the viewer can not see it.
+func viewBroadcast(broadcastsPostgres transport.IBroadcastsPostgres, accessPostgres transport.IAccessPostgres,
+	id types.UUID, viewer *string) (models.Broadcasts, error) {
+	item, err := broadcastsPostgres.GetBroadcastById(id)
+	if err != nil {
+		return item, err
+	}
+	if item.Id == nil {
+		return item, models.ErrBroadcastNotFound
+	}
+
+	ok, err := canView(broadcastsPostgres, accessPostgres, item, viewer)
+	if err != nil {
+		return models.Broadcasts{}, err
+	}
+	if !ok {
+		return models.Broadcasts{}, models.ErrNoAccess
+	}
+	return item, nil
+}
+
+// canView reports whether the viewer can see the broadcast, the allowlist is
+// consulted for invite-only broadcasts only.
+func canView(broadcastsPostgres transport.IBroadcastsPostgres, accessPostgres transport.IAccessPostgres,
+	item models.Broadcasts, viewer *string) (bool, error) {
+	if item.VisibleTo(viewer, false) {
+		return true, nil
+	}
+	if viewer == nil {
+		return false, nil
+	}
+
+	isAdmin, err := broadcastsPostgres.CheckAdminUser(api.SUsername{Username: viewer})
+	if err != nil {
+		return false, err
+	}
+	if item.VisibleTo(viewer, isAdmin) {
+		return true, nil
+	}
+	return accessPostgres.HasAccess(*item.Id, *viewer)
+}
+
+// checkChannelAccess returns ErrNoAccess when the channel belongs to a
+// broadcast the viewer can not see, chats of trashed broadcasts are left to
+// admins, other channels are open to everyone.
+func checkChannelAccess(broadcastsPostgres transport.IBroadcastsPostgres, accessPostgres transport.IAccessPostgres,
+	channel string, viewer *string) error {
+	id, err := uuid.Parse(channel)
+	if err != nil {
+		return nil
+	}
+
+	item, err := broadcastsPostgres.GetBroadcastWithTrash(id)
+	if err != nil || item.Id == nil {
+		return err
+	}
+	if item.DeletedAt != nil {
+		if viewer == nil {
+			return models.ErrNoAccess
+		}
+		isAdmin, err := broadcastsPostgres.CheckAdminUser(api.SUsername{Username: viewer})
+		if err != nil {
+			return err
+		}
+		if !isAdmin {
+			return models.ErrNoAccess
+		}
+		return nil
+	}
+
+	ok, err := canView(broadcastsPostgres, accessPostgres, item, viewer)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return models.ErrNoAccess
+	}
+	return nil
+}
@@ -2,16 +2,21 @@ package service
 
 import (
 	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
 	"github.com/alexm24/golang/internal/transport"
 )
 
 type AdminService struct {
 	broadcastsPostgres transport.IBroadcastsPostgres
 	centrifugo         transport.ICentrifugo
+	accessPostgres     transport.IAccessPostgres
 }
 
-func NewAdminService(broadcastsPostgres transport.IBroadcastsPostgres, centrifugo transport.ICentrifugo) *AdminService {
-	return &AdminService{broadcastsPostgres, centrifugo}
+func NewAdminService(
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	centrifugo transport.ICentrifugo,
+	accessPostgres transport.IAccessPostgres) *AdminService {
+	return &AdminService{broadcastsPostgres, centrifugo, accessPostgres}
 }
 
 func (a *AdminService) CheckAdminUser(username api.SUsername) (api.SAdmin, error) {
@@ -22,3 +27,22 @@ func (a *AdminService) CheckAdminUser(username api.SUsername) (api.SAdmin, error
 func (a *AdminService) GetToken(username api.SUsername) (api.SToken, error) {
 	return a.centrifugo.GetToken(username)
 }
+
+// GetChannelToken issues a subscription token only to users who can see the broadcast of the channel,
+// tokens of moderators channels only to moderators of the chat.
+func (a *AdminService) GetChannelToken(username api.SUsername, channel string) (api.SToken, error) {
+	if chat, ok := models.ChatOfModerators(channel); ok {
+		isModerator, err := isModerator(a.broadcastsPostgres, chat, username.Username)
+		if err != nil {
+			return api.SToken{}, err
+		}
+		if !isModerator {
+			return api.SToken{}, models.ErrNotChatModerator
+		}
+		return a.centrifugo.GetChannelToken(username, channel)
+	}
+	if err := checkChannelAccess(a.broadcastsPostgres, a.accessPostgres, channel, username.Username); err != nil {
+		return api.SToken{}, err
+	}
+	return a.centrifugo.GetChannelToken(username, channel)
+}
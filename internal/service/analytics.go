@@ -0,0 +1,104 @@
+package service
+
+import (
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type AnalyticsService struct {
+	analyticsPostgres     transport.IAnalyticsPostgres
+	analyticsRedis        transport.IAnalyticsRedis
+	registrationsPostgres transport.IRegistrationsPostgres
+	broadcastsPostgres    transport.IBroadcastsPostgres
+	centrifugo            transport.ICentrifugo
+}
+
+func NewAnalyticsService(
+	analyticsPostgres transport.IAnalyticsPostgres,
+	analyticsRedis transport.IAnalyticsRedis,
+	registrationsPostgres transport.IRegistrationsPostgres,
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	centrifugo transport.ICentrifugo) *AnalyticsService {
+	return &AnalyticsService{analyticsPostgres, analyticsRedis, registrationsPostgres, broadcastsPostgres, centrifugo}
+}
+
+func (a *AnalyticsService) GetAnalytics(id types.UUID, actor *string) (models.Analytics, error) {
+	if _, err := manageBroadcast(a.broadcastsPostgres, id, actor); err != nil {
+		return models.Analytics{}, err
+	}
+	return broadcastAnalytics(a.analyticsPostgres, a.analyticsRedis, a.registrationsPostgres, id)
+}
+
+func (a *AnalyticsService) GetAnalyticsSeries(
+	id types.UUID, actor *string, bucket time.Duration) ([]models.AnalyticsBucket, error) {
+	if _, err := manageBroadcast(a.broadcastsPostgres, id, actor); err != nil {
+		return nil, err
+	}
+
+	channel := id.String()
+	items, err := a.analyticsPostgres.GetChatSeries(channel, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	viewers, err := a.analyticsRedis.GetViewers(channel)
+	if err != nil {
+		return nil, err
+	}
+	return models.MergeViewers(items, viewers, bucket), nil
+}
+
+// TrackViewers samples the number of viewers present in the channels of
+// broadcasts on air, the peaks are kept in Redis. A failed channel does not
+// stop the others, the first error is returned.
+func (a *AnalyticsService) TrackViewers() error {
+	items, err := a.broadcastsPostgres.GetOnAir()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var first error
+	for _, item := range items {
+		if err = a.trackViewers(item.Id.String(), now); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func (a *AnalyticsService) trackViewers(channel string, at time.Time) error {
+	stats, err := a.centrifugo.PresenceStats(channel)
+	if err != nil {
+		return err
+	}
+	return a.analyticsRedis.TrackViewers(channel, stats.NumUsers, at)
+}
+
+func broadcastAnalytics(
+	analyticsPostgres transport.IAnalyticsPostgres,
+	analyticsRedis transport.IAnalyticsRedis,
+	registrationsPostgres transport.IRegistrationsPostgres,
+	id types.UUID) (models.Analytics, error) {
+	channel := id.String()
+	item, err := analyticsPostgres.GetChatStats(channel)
+	if err != nil {
+		return item, err
+	}
+	if item.ReactionTypes, err = analyticsPostgres.GetReactionCounts(channel); err != nil {
+		return item, err
+	}
+
+	counts, err := registrationsPostgres.GetRegistrationCounts(id)
+	if err != nil {
+		return item, err
+	}
+	item.Confirmed, item.Waitlisted = counts.Confirmed, counts.Waitlisted
+
+	item.PeakViewers, err = analyticsRedis.GetPeakViewers(channel)
+	return item, err
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type onAirPostgres struct {
+	transport.IBroadcastsPostgres
+	items []api.SIdentifier
+}
+
+func (o *onAirPostgres) GetOnAir() ([]api.SIdentifier, error) {
+	return o.items, nil
+}
+
+// presenceCentrifugo fails presence of the channels in failed.
+type presenceCentrifugo struct {
+	transport.ICentrifugo
+	failed map[string]bool
+}
+
+func (p *presenceCentrifugo) PresenceStats(channel string) (models.PresenceStats, error) {
+	if p.failed[channel] {
+		return models.PresenceStats{}, errors.New("presence failed")
+	}
+	return models.PresenceStats{NumUsers: 1}, nil
+}
+
+type viewersRedis struct {
+	transport.IAnalyticsRedis
+	tracked []string
+}
+
+func (v *viewersRedis) TrackViewers(channel string, viewers int, at time.Time) error {
+	v.tracked = append(v.tracked, channel)
+	return nil
+}
+
+func TestService_TrackViewers(t *testing.T) {
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+	viewers := &viewersRedis{}
+	a := &AnalyticsService{
+		analyticsRedis:     viewers,
+		broadcastsPostgres: &onAirPostgres{items: []api.SIdentifier{{Id: &first}, {Id: &second}, {Id: &third}}},
+		centrifugo:         &presenceCentrifugo{failed: map[string]bool{second.String(): true}},
+	}
+
+	err := a.TrackViewers()
+
+	assert.EqualError(t, err, "presence failed")
+	assert.Equal(t, []string{first.String(), third.String()}, viewers.tracked)
+}
@@ -1,6 +1,9 @@
 package service
 
 import (
+	"sort"
+	"time"
+
 	"github.com/deepmap/oapi-codegen/pkg/types"
 
 	"github.com/alexm24/golang/internal/handler/api"
@@ -11,52 +14,509 @@ import (
 type BroadcastsService struct {
 	broadcastsPostgres transport.IBroadcastsPostgres
 	messagesPostgres   transport.IMessagesPostgres
+	revisionsPostgres  transport.IRevisionsPostgres
+	accessPostgres     transport.IAccessPostgres
+	webhooksPostgres   transport.IWebhooksPostgres
+	recordingsPostgres transport.IRecordingsPostgres
+	participants       IParticipants
+	recordings         models.RecordingsConfig
+	duration           time.Duration
 }
 
 func NewBroadcastsService(broadcastsPostgres transport.IBroadcastsPostgres,
-	messagesPostgres transport.IMessagesPostgres) *BroadcastsService {
-	return &BroadcastsService{broadcastsPostgres, messagesPostgres}
+	messagesPostgres transport.IMessagesPostgres,
+	revisionsPostgres transport.IRevisionsPostgres,
+	accessPostgres transport.IAccessPostgres,
+	webhooksPostgres transport.IWebhooksPostgres,
+	recordingsPostgres transport.IRecordingsPostgres,
+	participants IParticipants,
+	recordings models.RecordingsConfig,
+	duration time.Duration) *BroadcastsService {
+	return &BroadcastsService{broadcastsPostgres, messagesPostgres, revisionsPostgres, accessPostgres, webhooksPostgres,
+		recordingsPostgres, participants, recordings, duration}
 }
 
-func (b *BroadcastsService) GetBroadcasts() ([]models.Broadcasts, error) {
-	return b.broadcastsPostgres.GetBroadcasts()
+func (b *BroadcastsService) GetBroadcasts(filter models.BroadcastFilter) (models.BroadcastsPage, error) {
+	if len(filter.Life) == 0 {
+		filter.Life = []models.LifeCycleBroadcast{models.Created, models.OnAir}
+	}
+
+	page, err := b.broadcastsPostgres.GetBroadcasts(filter)
+	if err != nil {
+		return page, err
+	}
+
+	isAdmin, err := b.isAdmin(filter.Viewer)
+	if err != nil {
+		return page, err
+	}
+	hideStreamKeys(page.Items, filter.Viewer, isAdmin)
+
+	return page, nil
 }
 
-func (b *BroadcastsService) GetBroadcastById(id types.UUID) (models.Broadcasts, error) {
-	return b.broadcastsPostgres.GetBroadcastById(id)
+// GetBroadcastById returns ErrNoAccess when the viewer can not see the broadcast.
+func (b *BroadcastsService) GetBroadcastById(id types.UUID, viewer *string) (models.Broadcasts, error) {
+	item, err := b.broadcastsPostgres.GetBroadcastById(id)
+	if err != nil || item.Id == nil {
+		return item, err
+	}
+
+	ok, err := canView(b.broadcastsPostgres, b.accessPostgres, item, viewer)
+	if err != nil {
+		return models.Broadcasts{}, err
+	}
+	if !ok {
+		return models.Broadcasts{}, models.ErrNoAccess
+	}
+
+	isAdmin, err := b.isAdmin(viewer)
+	if err != nil {
+		return item, err
+	}
+	if !isAdmin && !item.IsOwner(viewer) {
+		item.StreamKey = nil
+	}
+
+	return item, nil
 }
 
-func (b *BroadcastsService) GetArchBroadcasts(username api.SUsername) ([]models.Broadcasts, error) {
+func (b *BroadcastsService) GetArchBroadcasts(username api.SUsername, filter models.BroadcastFilter) (models.BroadcastsPage, error) {
 	isAdmin, err := b.broadcastsPostgres.CheckAdminUser(username)
 	if err != nil {
-		return nil, err
+		return models.BroadcastsPage{}, err
 	}
-	if isAdmin {
-		return b.broadcastsPostgres.GetPastBroadcastAll()
+	if !isAdmin {
+		filter.Owner = username.Username
+	}
+	filter.Life = []models.LifeCycleBroadcast{models.Past}
+	filter.Viewer = username.Username
+
+	page, err := b.broadcastsPostgres.GetBroadcasts(filter)
+	if err != nil {
+		return page, err
+	}
+	hideStreamKeys(page.Items, username.Username, isAdmin)
+
+	if err = attachRecordings(b.recordingsPostgres, b.recordings, page.Items); err != nil {
+		return page, err
+	}
+	return page, nil
+}
+
+// CreateBroadcast returns ConflictError when the broadcast or occurrences of
+// the series overlap other broadcasts, force skips the check for admins.
+func (b *BroadcastsService) CreateBroadcast(
+	item models.PostBroadcast, actor *string, force bool) (models.Broadcasts, error) {
+	var starts = []time.Time{*item.StartTime}
+	if item.Rrule != nil {
+		var err error
+		if starts, err = item.Occurrences(); err != nil {
+			return models.Broadcasts{}, err
+		}
+	}
+	if err := b.checkConflicts(item.Slots(starts, b.duration), nil, actor, force); err != nil {
+		return models.Broadcasts{}, err
+	}
+
+	if item.Rrule == nil {
+		created, err := b.broadcastsPostgres.CreateBroadcast(item)
+		if err != nil {
+			return created, err
+		}
+		if err = saveRevision(b.revisionsPostgres, models.RevisionCreate, item.Owner, models.Broadcasts{}, created); err != nil {
+			return created, err
+		}
+		return created, emitBroadcasts(b.webhooksPostgres, models.EventBroadcastCreated, created)
+	}
+
+	items, err := b.broadcastsPostgres.CreateSeries(item, starts)
+	if err != nil {
+		return models.Broadcasts{}, err
+	}
+	for _, i := range items {
+		if err = saveRevision(b.revisionsPostgres, models.RevisionCreate, item.Owner, models.Broadcasts{}, i); err != nil {
+			return models.Broadcasts{}, err
+		}
+	}
+	if err = emitBroadcasts(b.webhooksPostgres, models.EventBroadcastCreated, items...); err != nil {
+		return models.Broadcasts{}, err
 	}
 
-	return b.broadcastsPostgres.GetPastBroadcastOwner(username)
+	return items[0], nil
 }
 
-func (b *BroadcastsService) CreateBroadcast(item models.PostBroadcast) (models.Broadcasts, error) {
-	return b.broadcastsPostgres.CreateBroadcast(item)
+func (b *BroadcastsService) GetSeriesById(id types.UUID, viewer *string) ([]models.Broadcasts, error) {
+	items, err := b.broadcastsPostgres.GetSeriesBroadcasts(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var visible = make([]models.Broadcasts, 0, len(items))
+	for _, i := range items {
+		ok, err := canView(b.broadcastsPostgres, b.accessPostgres, i, viewer)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			visible = append(visible, i)
+		}
+	}
+
+	isAdmin, err := b.isAdmin(viewer)
+	if err != nil {
+		return nil, err
+	}
+	hideStreamKeys(visible, viewer, isAdmin)
+
+	return visible, nil
 }
 
-func (b *BroadcastsService) DeleteBroadcast(id types.UUID) (api.SIdentifier, error) {
-	item, err := b.broadcastsPostgres.DeleteBroadcast(id)
+func (b *BroadcastsService) RotateStreamKey(id types.UUID, username api.SUsername) (models.Broadcasts, error) {
+	current, err := b.broadcastsPostgres.GetBroadcastById(id)
 	if err != nil {
-		return item, err
+		return current, err
+	}
+	if current.Id == nil {
+		return current, models.ErrBroadcastNotFound
 	}
 
-	channel := id.String()
-	err = b.messagesPostgres.DeleteMessages(channel)
+	if !current.IsOwner(username.Username) {
+		isAdmin, err := b.isAdmin(username.Username)
+		if err != nil {
+			return models.Broadcasts{}, err
+		}
+		if !isAdmin {
+			return models.Broadcasts{}, models.ErrNotOwner
+		}
+	}
+
+	item, err := b.broadcastsPostgres.RotateStreamKey(id)
 	if err != nil {
 		return item, err
 	}
 
-	return item, nil
+	return item, saveRevision(b.revisionsPostgres, models.RevisionUpdate, username.Username, current, item)
+}
+
+// DeleteBroadcast moves the broadcast, or the occurrences of its series, to the trash.
+// The chat is kept until the trash is purged.
+func (b *BroadcastsService) DeleteBroadcast(
+	id types.UUID, scope models.SeriesScope, deletedBy *string) (api.SIdentifier, error) {
+	current, err := manageBroadcast(b.broadcastsPostgres, id, deletedBy)
+	if err != nil {
+		return api.SIdentifier{}, err
+	}
+
+	if scope != models.ScopeThis && current.SeriesId != nil {
+		before, err := b.seriesById(*current.SeriesId)
+		if err != nil {
+			return api.SIdentifier{}, err
+		}
+
+		ids, err := b.broadcastsPostgres.DeleteSeriesBroadcasts(*current.SeriesId, seriesFrom(current, scope), deletedBy)
+		if err != nil {
+			return api.SIdentifier{}, err
+		}
+		for _, i := range ids {
+			deleted := before[*i.Id]
+			if err = saveRevision(b.revisionsPostgres, models.RevisionDelete, deletedBy, deleted, deleted); err != nil {
+				return api.SIdentifier{}, err
+			}
+		}
+	}
+
+	deleted, err := b.broadcastsPostgres.DeleteBroadcast(id, deletedBy)
+	if err != nil {
+		return api.SIdentifier{}, err
+	}
+	if deleted.Id == nil {
+		return api.SIdentifier{}, models.ErrBroadcastNotFound
+	}
+	if err = saveRevision(b.revisionsPostgres, models.RevisionDelete, deletedBy, current, current); err != nil {
+		return api.SIdentifier{}, err
+	}
+
+	return deleted, nil
 }
 
-func (b *BroadcastsService) ChangeBroadcast(item models.PutBroadcast) (models.Broadcasts, error) {
-	return b.broadcastsPostgres.ChangeBroadcast(item)
+// ChangeBroadcast returns the current broadcast with ErrVersionMismatch when
+// version is set and the broadcast has been changed since.
+func (b *BroadcastsService) ChangeBroadcast(item models.PutBroadcast, scope models.SeriesScope,
+	actor *string, version *int, force bool) (models.Broadcasts, error) {
+	changed, err := b.changeInScope(item, scope, actor, version, force)
+	if keyErr := b.hideStreamKey(&changed, actor); keyErr != nil {
+		return models.Broadcasts{}, keyErr
+	}
+	return changed, err
+}
+
+func (b *BroadcastsService) changeInScope(item models.PutBroadcast, scope models.SeriesScope,
+	actor *string, version *int, force bool) (models.Broadcasts, error) {
+	current, err := manageBroadcast(b.broadcastsPostgres, *item.Id, actor)
+	if err != nil {
+		return current, err
+	}
+	if version != nil && current.Version != *version {
+		return current, models.ErrVersionMismatch
+	}
+	rescheduled := !sameTime(item.StartTime, current.StartTime) || !sameTime(item.EndTime, current.EndTime)
+
+	if scope == models.ScopeThis || current.SeriesId == nil {
+		if rescheduled {
+			slots, exclude := changeSlots(item, current, nil, 0, nil, b.duration)
+			if err = b.checkConflicts(slots, exclude, actor, force); err != nil {
+				return models.Broadcasts{}, err
+			}
+		}
+		return b.changeBroadcast(item, current, actor, version, models.RevisionUpdate)
+	}
+
+	before, err := b.seriesById(*current.SeriesId)
+	if err != nil {
+		return models.Broadcasts{}, err
+	}
+
+	shift := item.StartTime.Sub(*current.StartTime)
+	if rescheduled {
+		slots, exclude := changeSlots(item, current, before, shift, seriesFrom(current, scope), b.duration)
+		if err = b.checkConflicts(slots, exclude, actor, force); err != nil {
+			return models.Broadcasts{}, err
+		}
+	}
+
+	items, err := b.broadcastsPostgres.ChangeSeriesBroadcasts(item, *current.SeriesId, shift, seriesFrom(current, scope))
+	if err != nil {
+		return models.Broadcasts{}, err
+	}
+
+	var changed *models.Broadcasts
+	for n, i := range items {
+		if err = saveRevision(b.revisionsPostgres, models.RevisionUpdate, actor, before[*i.Id], i); err != nil {
+			return models.Broadcasts{}, err
+		}
+		if *i.Id == *item.Id {
+			changed = &items[n]
+		}
+	}
+	if err = emitBroadcasts(b.webhooksPostgres, models.EventBroadcastChanged, items...); err != nil {
+		return models.Broadcasts{}, err
+	}
+	if changed != nil {
+		return *changed, nil
+	}
+
+	return b.changeBroadcast(item, current, actor, version, models.RevisionUpdate)
+}
+
+// PatchBroadcast changes only the fields present in the patch, it returns the
+// current broadcast with ErrVersionMismatch when version is set and the
+// broadcast has been changed since.
+func (b *BroadcastsService) PatchBroadcast(id types.UUID, patch models.PatchBroadcast,
+	actor *string, version *int, force bool) (models.Broadcasts, error) {
+	changed, err := b.patchBroadcast(id, patch, actor, version, force)
+	if keyErr := b.hideStreamKey(&changed, actor); keyErr != nil {
+		return models.Broadcasts{}, keyErr
+	}
+	return changed, err
+}
+
+func (b *BroadcastsService) patchBroadcast(id types.UUID, patch models.PatchBroadcast,
+	actor *string, version *int, force bool) (models.Broadcasts, error) {
+	current, err := manageBroadcast(b.broadcastsPostgres, id, actor)
+	if err != nil {
+		return current, err
+	}
+	if version != nil && current.Version != *version {
+		return current, models.ErrVersionMismatch
+	}
+	if err = patch.Validate(current); err != nil {
+		return models.Broadcasts{}, err
+	}
+	if patch.IsEmpty() {
+		return models.Broadcasts{}, models.ErrEmptyPatch
+	}
+	if patch.StartTime != nil || patch.EndTime != nil || patch.ClearEndTime {
+		moved := current
+		if patch.StartTime != nil {
+			moved.StartTime = patch.StartTime
+		}
+		if patch.EndTime != nil || patch.ClearEndTime {
+			moved.EndTime = patch.EndTime
+		}
+		if err = b.checkConflicts([]models.Slot{models.NewSlot(moved, b.duration)}, []types.UUID{id}, actor, force); err != nil {
+			return models.Broadcasts{}, err
+		}
+	}
+
+	changed, err := b.broadcastsPostgres.PatchBroadcast(id, patch, version)
+	if err != nil {
+		return changed, err
+	}
+	if changed.Id == nil {
+		latest, err := b.broadcastsPostgres.GetBroadcastById(id)
+		if err != nil {
+			return latest, err
+		}
+		if latest.Id == nil {
+			return latest, models.ErrBroadcastNotFound
+		}
+		return latest, models.ErrVersionMismatch
+	}
+
+	if err = saveRevision(b.revisionsPostgres, models.RevisionUpdate, actor, current, changed); err != nil {
+		return changed, err
+	}
+	if patch.Capacity != nil || patch.ClearCapacity {
+		if err = b.participants.PromoteWaitlist(id); err != nil {
+			return changed, err
+		}
+	}
+	return changed, emitBroadcasts(b.webhooksPostgres, models.EventBroadcastChanged, changed)
+}
+
+func (b *BroadcastsService) changeBroadcast(item models.PutBroadcast, current models.Broadcasts,
+	actor *string, version *int, action models.RevisionAction) (models.Broadcasts, error) {
+	changed, err := b.broadcastsPostgres.ChangeBroadcast(item, version)
+	if err != nil {
+		return changed, err
+	}
+	if changed.Id == nil && version != nil {
+		latest, err := b.broadcastsPostgres.GetBroadcastById(*item.Id)
+		if err != nil {
+			return latest, err
+		}
+		if latest.Id != nil {
+			return latest, models.ErrVersionMismatch
+		}
+	}
+	if err = saveRevision(b.revisionsPostgres, action, actor, current, changed); err != nil {
+		return changed, err
+	}
+	return changed, emitBroadcasts(b.webhooksPostgres, models.EventBroadcastChanged, changed)
+}
+
+// seriesById returns the current state of the occurrences by their id.
+func (b *BroadcastsService) seriesById(seriesId types.UUID) (map[types.UUID]models.Broadcasts, error) {
+	items, err := b.broadcastsPostgres.GetSeriesBroadcasts(seriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	var byId = make(map[types.UUID]models.Broadcasts, len(items))
+	for _, i := range items {
+		byId[*i.Id] = i
+	}
+	return byId, nil
+}
+
+func (b *BroadcastsService) isAdmin(username *string) (bool, error) {
+	if username == nil {
+		return false, nil
+	}
+	return b.broadcastsPostgres.CheckAdminUser(api.SUsername{Username: username})
+}
+
+// hideStreamKey clears the stream key of a changed broadcast unless the actor
+// owns it or is an admin.
+func (b *BroadcastsService) hideStreamKey(item *models.Broadcasts, actor *string) error {
+	if item.Id == nil || item.IsOwner(actor) {
+		return nil
+	}
+	isAdmin, err := b.isAdmin(actor)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		item.StreamKey = nil
+	}
+	return nil
+}
+
+// hideStreamKeys clears stream keys of broadcasts the viewer does not own.
+func hideStreamKeys(items []models.Broadcasts, viewer *string, isAdmin bool) {
+	if isAdmin {
+		return
+	}
+	for i := range items {
+		if !items[i].IsOwner(viewer) {
+			items[i].StreamKey = nil
+		}
+	}
+}
+
+// checkConflicts returns ConflictError naming the broadcasts which overlap
+// the slots, admins skip the check with force.
+func (b *BroadcastsService) checkConflicts(slots []models.Slot, exclude []types.UUID, actor *string, force bool) error {
+	if force {
+		isAdmin, err := b.isAdmin(actor)
+		if err != nil {
+			return err
+		}
+		if !isAdmin {
+			return models.ErrNotAdmin
+		}
+		return nil
+	}
+
+	var conflicts []models.Broadcasts
+	seen := make(map[types.UUID]bool)
+	for _, slot := range slots {
+		items, err := b.broadcastsPostgres.GetConflicts(slot, exclude, b.duration)
+		if err != nil {
+			return err
+		}
+		for _, i := range items {
+			if !seen[*i.Id] {
+				seen[*i.Id] = true
+				conflicts = append(conflicts, i)
+			}
+		}
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].StartTime.Before(*conflicts[j].StartTime) })
+	return models.NewConflictError(conflicts, b.duration)
+}
+
+// changeSlots returns the slots of the broadcast moved to the times of item and of the
+// upcoming occurrences of its series shifted the same way, with ids of all of them.
+func changeSlots(item models.PutBroadcast, current models.Broadcasts, series map[types.UUID]models.Broadcasts,
+	shift time.Duration, from *time.Time, duration time.Duration) ([]models.Slot, []types.UUID) {
+	moved := current
+	moved.StartTime, moved.EndTime = item.StartTime, item.EndTime
+	slots := []models.Slot{models.NewSlot(moved, duration)}
+	ids := []types.UUID{*current.Id}
+
+	for id, o := range series {
+		if id == *current.Id || *o.Life != models.Created.String() || (from != nil && o.StartTime.Before(*from)) {
+			continue
+		}
+		start := o.StartTime.Add(shift)
+		moved = o
+		moved.StartTime, moved.EndTime = &start, nil
+		if item.EndTime != nil {
+			end := start.Add(item.EndTime.Sub(*item.StartTime))
+			moved.EndTime = &end
+		}
+		slots = append(slots, models.NewSlot(moved, duration))
+		ids = append(ids, id)
+	}
+	return slots, ids
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func seriesFrom(item models.Broadcasts, scope models.SeriesScope) *time.Time {
+	if scope == models.ScopeFollowing {
+		return item.StartTime
+	}
+	return nil
 }
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+// conflictsPostgres matches the scheduled broadcasts the way GetConflicts
+// does: same owner or same stream key, overlapping times.
+type conflictsPostgres struct {
+	transport.IBroadcastsPostgres
+	scheduled []models.Broadcasts
+}
+
+func (c *conflictsPostgres) GetConflicts(
+	slot models.Slot, exclude []types.UUID, duration time.Duration) ([]models.Broadcasts, error) {
+	var items = make([]models.Broadcasts, 0)
+	for _, i := range c.scheduled {
+		s := models.NewSlot(i, duration)
+		sameKey := slot.StreamKey != nil && i.StreamKey != nil && *slot.StreamKey == *i.StreamKey
+		if (s.Owner == slot.Owner || sameKey) && s.Start.Before(slot.End) && s.End.After(slot.Start) {
+			items = append(items, i)
+		}
+	}
+	return items, nil
+}
+
+func TestService_CheckConflicts(t *testing.T) {
+	id, otherId := uuid.New(), uuid.New()
+	owner, other := "owner", "other"
+	key, otherKey := "key", "other-key"
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	scheduled := models.Broadcasts{
+		SIdentifier: api.SIdentifier{Id: &otherId},
+		SBroadcast:  api.SBroadcast{Owner: &other, StreamKey: &key},
+		SStartTime:  api.SStartTime{StartTime: &start},
+		SEndTime:    api.SEndTime{EndTime: &end},
+	}
+
+	testTable := []struct {
+		name      string
+		streamKey *string
+		conflicts []api.SConflictItem
+	}{
+		{
+			name:      "Same stream key of another owner",
+			streamKey: &key,
+			conflicts: []api.SConflictItem{
+				{Id: &otherId, Owner: &other, StartTime: &start, EndTime: &end},
+			},
+		},
+		{
+			name:      "Other stream key of another owner",
+			streamKey: &otherKey,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			b := &BroadcastsService{
+				broadcastsPostgres: &conflictsPostgres{scheduled: []models.Broadcasts{scheduled}},
+				duration:           time.Hour,
+			}
+			slot := models.NewSlot(models.Broadcasts{
+				SIdentifier: api.SIdentifier{Id: &id},
+				SBroadcast:  api.SBroadcast{Owner: &owner, StreamKey: testCase.streamKey},
+				SStartTime:  api.SStartTime{StartTime: &start},
+			}, time.Hour)
+
+			err := b.checkConflicts([]models.Slot{slot}, []types.UUID{id}, &owner, false)
+
+			if testCase.conflicts == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, &models.ConflictError{Conflicts: testCase.conflicts}, err)
+		})
+	}
+}
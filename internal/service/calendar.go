@@ -0,0 +1,67 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type CalendarService struct {
+	calendarPostgres transport.ICalendarPostgres
+	watchUrl         string
+	duration         time.Duration
+}
+
+func NewCalendarService(
+	calendarPostgres transport.ICalendarPostgres,
+	watchUrl string,
+	duration time.Duration) *CalendarService {
+	return &CalendarService{calendarPostgres, watchUrl, duration}
+}
+
+// CreateCalendarFeed issues a new token for the feed, replacing the previous
+// token of the same user and scope.
+func (c *CalendarService) CreateCalendarFeed(item models.PostCalendarFeed) (api.SCalendarFeed, error) {
+	token, err := models.NewSecret()
+	if err != nil {
+		return api.SCalendarFeed{}, err
+	}
+
+	feed, err := c.calendarPostgres.SaveCalendarFeed(models.CalendarFeed{
+		Token:    token,
+		Username: *item.Username,
+		Scope:    models.CalendarScope(*item.Scope),
+	})
+	if err != nil {
+		return api.SCalendarFeed{}, err
+	}
+
+	scope := string(feed.Scope)
+	return api.SCalendarFeed{Token: &feed.Token, Scope: &scope}, nil
+}
+
+// GetCalendar returns the feed as iCalendar text, empty when the token is unknown.
+func (c *CalendarService) GetCalendar(token string) ([]byte, error) {
+	feed, err := c.calendarPostgres.GetCalendarFeed(token)
+	if err != nil || len(feed.Token) == 0 {
+		return nil, err
+	}
+
+	var owner *string
+	name := "Broadcasts"
+	if feed.Scope == models.CalendarUser {
+		owner = &feed.Username
+		name = fmt.Sprintf("Broadcasts of %s", feed.Username)
+	}
+
+	events, err := c.calendarPostgres.GetCalendarEvents(owner, feed.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	cal := models.Calendar{Name: name, WatchUrl: c.watchUrl, Duration: c.duration, Events: events}
+	return cal.ICS(), nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type FeedsService struct {
+	feedsPostgres transport.IFeedsPostgres
+	cfg           models.FeedConfig
+	recordings    models.RecordingsConfig
+	apiPath       string
+	watchUrl      string
+}
+
+func NewFeedsService(
+	feedsPostgres transport.IFeedsPostgres,
+	cfg models.FeedConfig,
+	recordings models.RecordingsConfig,
+	apiPath string,
+	watchUrl string) *FeedsService {
+	return &FeedsService{feedsPostgres, cfg, recordings, apiPath, watchUrl}
+}
+
+// GetArchiveFeed returns the latest past public broadcasts as an Atom or RSS document.
+func (f *FeedsService) GetArchiveFeed(filter models.FeedFilter, format models.FeedFormat) ([]byte, error) {
+	filter.Limit = f.cfg.Limit
+	entries, err := f.feedsPostgres.GetArchiveEntries(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, entry := range entries {
+		if entry.RecordingId != nil {
+			entries[i].RecordingUrl = models.RecordingWatchUrl(f.recordings.PlayUrl, *entry.RecordingId)
+		}
+	}
+
+	feed := models.Feed{
+		Title: filter.Title(),
+		SelfUrl: fmt.Sprintf("%s/%s/feeds/archive.%s%s",
+			strings.TrimSuffix(f.cfg.Url, "/"), strings.Trim(f.apiPath, "/"), format, filter.Query()),
+		Url:      f.cfg.Url,
+		WatchUrl: f.watchUrl,
+		Entries:  entries,
+	}
+	return feed.Render(format)
+}
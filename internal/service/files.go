@@ -0,0 +1,92 @@
+package service
+
+import (
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type FilesService struct {
+	filesPostgres      transport.IFilesPostgres
+	broadcastsPostgres transport.IBroadcastsPostgres
+	accessPostgres     transport.IAccessPostgres
+	centrifugo         transport.ICentrifugo
+	maxSize            int64
+}
+
+func NewFilesService(
+	filesPostgres transport.IFilesPostgres,
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	accessPostgres transport.IAccessPostgres,
+	centrifugo transport.ICentrifugo,
+	maxSize int64) *FilesService {
+	return &FilesService{filesPostgres, broadcastsPostgres, accessPostgres, centrifugo, maxSize}
+}
+
+// UploadLimit checks that the actor manages the broadcast before the upload is
+// read and returns the largest file size accepted.
+func (f *FilesService) UploadLimit(broadcastId types.UUID, actor *string) (int64, error) {
+	if _, err := manageBroadcast(f.broadcastsPostgres, broadcastId, actor); err != nil {
+		return 0, err
+	}
+	return f.maxSize, nil
+}
+
+// CreateFile stores the file and announces it to the broadcast channel.
+func (f *FilesService) CreateFile(item models.File, actor *string) (models.FileInfo, error) {
+	if int64(len(item.Data)) > f.maxSize {
+		return models.FileInfo{}, models.ErrFileTooLarge
+	}
+
+	broadcast, err := manageBroadcast(f.broadcastsPostgres, *item.BroadcastId, actor)
+	if err != nil {
+		return models.FileInfo{}, err
+	}
+
+	info, err := f.filesPostgres.CreateFile(item)
+	if err != nil {
+		return info, err
+	}
+
+	msg := models.ActionCentrifugo{Type: models.ActionFileShared, Payload: info}
+	if err = f.centrifugo.Publish(broadcast.Id.String(), msg); err != nil {
+		return info, err
+	}
+	return info, nil
+}
+
+func (f *FilesService) GetFiles(broadcastId types.UUID, viewer *string) ([]models.FileInfo, error) {
+	if _, err := viewBroadcast(f.broadcastsPostgres, f.accessPostgres, broadcastId, viewer); err != nil {
+		return nil, err
+	}
+	return f.filesPostgres.GetFiles(broadcastId)
+}
+
+// GetFileById returns an empty file when it is not found and ErrNoAccess when
+// the viewer can not see its broadcast.
+func (f *FilesService) GetFileById(id types.UUID, viewer *string) (models.File, error) {
+	item, err := f.filesPostgres.GetFileById(id)
+	if err != nil || item.Id == nil {
+		return item, err
+	}
+	if _, err = viewBroadcast(f.broadcastsPostgres, f.accessPostgres, *item.BroadcastId, viewer); err != nil {
+		return models.File{}, err
+	}
+	return item, nil
+}
+
+func (f *FilesService) DeleteFile(id types.UUID, actor *string) (api.SIdentifier, error) {
+	info, err := f.filesPostgres.GetFileInfo(id)
+	if err != nil {
+		return api.SIdentifier{}, err
+	}
+	if info.Id == nil {
+		return api.SIdentifier{}, models.ErrFileNotFound
+	}
+	if _, err = manageBroadcast(f.broadcastsPostgres, *info.BroadcastId, actor); err != nil {
+		return api.SIdentifier{}, err
+	}
+	return f.filesPostgres.DeleteFile(id)
+}
@@ -5,24 +5,62 @@ import (
 	"github.com/alexm24/golang/internal/models"
 	"github.com/alexm24/golang/internal/transport"
 	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
 )
 
 type ImagesService struct {
-	imagesPostgres transport.IImagesPostgres
+	imagesPostgres     transport.IImagesPostgres
+	broadcastsPostgres transport.IBroadcastsPostgres
+	revisionsPostgres  transport.IRevisionsPostgres
 }
 
-func NewImagesService(imagesPostgres transport.IImagesPostgres) *ImagesService {
-	return &ImagesService{imagesPostgres}
+func NewImagesService(imagesPostgres transport.IImagesPostgres,
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	revisionsPostgres transport.IRevisionsPostgres) *ImagesService {
+	return &ImagesService{imagesPostgres, broadcastsPostgres, revisionsPostgres}
 }
 
 func (i *ImagesService) GetImageById(id types.UUID) ([]uint8, error) {
 	return i.imagesPostgres.GetImageById(id)
 }
 
-func (i *ImagesService) DelImageById(id types.UUID) (api.SIdentifier, error) {
-	return i.imagesPostgres.DelImageById(id)
+func (i *ImagesService) DelImageById(id types.UUID, actor *string) (api.SIdentifier, error) {
+	before, err := manageBroadcast(i.broadcastsPostgres, id, actor)
+	if err != nil {
+		return api.SIdentifier{}, err
+	}
+
+	item, err := i.imagesPostgres.DelImageById(id)
+	if err != nil {
+		return item, err
+	}
+
+	return item, i.saveRevision(id, actor, before)
+}
+
+func (i *ImagesService) CreateImage(id string, file []byte, actor *string) (models.ResImage, error) {
+	broadcastId, err := uuid.Parse(id)
+	if err != nil {
+		return models.ResImage{}, err
+	}
+
+	before, err := manageBroadcast(i.broadcastsPostgres, broadcastId, actor)
+	if err != nil {
+		return models.ResImage{}, err
+	}
+
+	item, err := i.imagesPostgres.CreateImage(id, file)
+	if err != nil {
+		return item, err
+	}
+
+	return item, i.saveRevision(broadcastId, actor, before)
 }
 
-func (i *ImagesService) CreateImage(id string, file []byte) (models.ResImage, error) {
-	return i.imagesPostgres.CreateImage(id, file)
+func (i *ImagesService) saveRevision(id types.UUID, actor *string, before models.Broadcasts) error {
+	after, err := i.broadcastsPostgres.GetBroadcastById(id)
+	if err != nil {
+		return err
+	}
+	return saveRevision(i.revisionsPostgres, models.RevisionImage, actor, before, after)
 }
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"time"
+
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type IngestService struct {
+	broadcastsPostgres transport.IBroadcastsPostgres
+	streamPostgres     transport.IStreamPostgres
+	recordingsPostgres transport.IRecordingsPostgres
+	storage            transport.IStorage
+	lifeCycle          ILifeCycle
+	early              time.Duration
+	duration           time.Duration
+}
+
+func NewIngestService(
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	streamPostgres transport.IStreamPostgres,
+	recordingsPostgres transport.IRecordingsPostgres,
+	storage transport.IStorage,
+	lifeCycle ILifeCycle,
+	early time.Duration,
+	duration time.Duration) *IngestService {
+	return &IngestService{broadcastsPostgres, streamPostgres, recordingsPostgres, storage, lifeCycle, early, duration}
+}
+
+// Publish authorizes a stream key: broadcast keys are accepted from early
+// before start_time until the broadcast is past, personal stream keys always.
+func (i *IngestService) Publish(e models.IngestEvent) error {
+	broadcast, err := i.broadcastsPostgres.GetBroadcastByStreamKey(e.StreamKey)
+	if err != nil {
+		return err
+	}
+	if broadcast.Id == nil {
+		return i.checkStream(e)
+	}
+
+	if err = broadcast.CheckPublish(time.Now(), i.early); err != nil {
+		return err
+	}
+	if err = i.broadcastsPostgres.SetPublishing(*broadcast.Id, true); err != nil {
+		return err
+	}
+	if *broadcast.Life == models.OnAir.String() {
+		return nil
+	}
+	_, err = i.lifeCycle.ChangeLifeCycle(*broadcast.Id, models.OnAir)
+	return err
+}
+
+// PublishDone finishes the broadcast only once it is over, a stream dropped
+// earlier can reconnect and the scheduler finishes the broadcast otherwise.
+func (i *IngestService) PublishDone(e models.IngestEvent) error {
+	broadcast, err := i.broadcastsPostgres.GetBroadcastByStreamKey(e.StreamKey)
+	if err != nil {
+		return err
+	}
+	if broadcast.Id == nil {
+		return i.checkStream(e)
+	}
+	if err = i.broadcastsPostgres.SetPublishing(*broadcast.Id, false); err != nil {
+		return err
+	}
+	if *broadcast.Life == models.Past.String() || !broadcast.Ended(time.Now(), i.duration) {
+		return nil
+	}
+
+	_, err = i.lifeCycle.ChangeLifeCycle(*broadcast.Id, models.Past)
+	return err
+}
+
+func (i *IngestService) RecordDone(e models.IngestEvent) error {
+	broadcast, err := i.broadcastsPostgres.GetBroadcastByStreamKey(e.StreamKey)
+	if err != nil {
+		return err
+	}
+	if broadcast.Id == nil {
+		return i.checkStream(e)
+	}
+	if len(e.Path) == 0 {
+		return nil
+	}
+	if !i.storage.Contains(e.Path) {
+		return models.ErrRecordingOutside
+	}
+
+	size, err := i.storage.Size(e.Path)
+	if err != nil {
+		return err
+	}
+
+	var recording models.Recording
+	recording.BroadcastId = broadcast.Id
+	recording.Path = &e.Path
+	recording.Size = size
+	if format := models.RecordingFormat(e.Path); len(format) > 0 {
+		recording.Format = &format
+	}
+	_, err = i.recordingsPostgres.CreateRecording(recording)
+	return err
+}
+
+func (i *IngestService) checkStream(e models.IngestEvent) error {
+	stream, err := i.streamPostgres.GetStreamByKey(e.StreamKey)
+	if err != nil {
+		return err
+	}
+	if stream.Id == nil {
+		return models.ErrStreamKeyUnknown
+	}
+	return nil
+}
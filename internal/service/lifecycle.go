@@ -0,0 +1,101 @@
+package service
+
+import (
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type LifeCycleService struct {
+	broadcastsPostgres transport.IBroadcastsPostgres
+	centrifugo         transport.ICentrifugo
+	webhooksPostgres   transport.IWebhooksPostgres
+	duration           time.Duration
+}
+
+func NewLifeCycleService(
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	centrifugo transport.ICentrifugo,
+	webhooksPostgres transport.IWebhooksPostgres,
+	duration time.Duration) *LifeCycleService {
+	return &LifeCycleService{broadcastsPostgres, centrifugo, webhooksPostgres, duration}
+}
+
+// UpdateLifeCycle moves broadcasts whose start_time has come to live and
+// broadcasts whose end_time (or start_time plus the default duration) has
+// passed to past. Changed broadcasts are not selected again, so webhooks are
+// queued before the events are published and a failed publish does not stop
+// the others.
+func (l *LifeCycleService) UpdateLifeCycle() error {
+	now := time.Now()
+
+	started, err := l.broadcastsPostgres.StartBroadcasts(now)
+	if err != nil {
+		return err
+	}
+	if err = emitBroadcasts(l.webhooksPostgres, models.EventBroadcastStarted, started...); err != nil {
+		return err
+	}
+	startErr := l.publish(started...)
+
+	finished, err := l.broadcastsPostgres.FinishBroadcasts(now, l.duration)
+	if err != nil {
+		return err
+	}
+	if err = emitBroadcasts(l.webhooksPostgres, models.EventBroadcastEnded, finished...); err != nil {
+		return err
+	}
+	if err = l.publish(finished...); err != nil {
+		return err
+	}
+	return startErr
+}
+
+// ChangeLifeCycle sets the state of one broadcast, e.g. on an ingest event.
+func (l *LifeCycleService) ChangeLifeCycle(id types.UUID, life models.LifeCycleBroadcast) (models.Broadcasts, error) {
+	item, err := l.broadcastsPostgres.ChangeLifeCycle(id, life)
+	if err != nil || item.Id == nil {
+		return item, err
+	}
+
+	switch life {
+	case models.OnAir:
+		err = emitBroadcasts(l.webhooksPostgres, models.EventBroadcastStarted, item)
+	case models.Past:
+		err = emitBroadcasts(l.webhooksPostgres, models.EventBroadcastEnded, item)
+	}
+	if err != nil {
+		return item, err
+	}
+	return item, l.publish(item)
+}
+
+// publish sends the lifecycle event of every broadcast and returns the first
+// error.
+func (l *LifeCycleService) publish(items ...models.Broadcasts) error {
+	var first error
+	for _, item := range items {
+		msg := models.ActionCentrifugo{
+			Type: models.ActionBroadcastLife,
+			Payload: models.LifeCycleEvent{
+				SIdentifier: item.SIdentifier,
+				SLifeCycle:  item.SLifeCycle,
+				SStartTime:  item.SStartTime,
+				SEndTime:    item.SEndTime,
+			},
+		}
+		if err := l.centrifugo.Publish(item.Id.String(), msg); err != nil && first == nil {
+			first = err
+		}
+		if !item.IsPublic() {
+			continue
+		}
+		if err := l.centrifugo.Publish(models.ChannelBroadcasts, msg); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
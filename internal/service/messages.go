@@ -1,6 +1,13 @@
 package service
 
 import (
+	"errors"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
+
+	"github.com/alexm24/golang/internal/handler/api"
 	"github.com/alexm24/golang/internal/models"
 	"github.com/alexm24/golang/internal/transport"
 )
@@ -8,28 +15,207 @@ import (
 type MessagesService struct {
 	messagesPostgres   transport.IMessagesPostgres
 	messagesCentrifugo transport.ICentrifugo
+	broadcastsPostgres transport.IBroadcastsPostgres
+	accessPostgres     transport.IAccessPostgres
+	cfg                models.ChatConfig
 }
 
-func NewMessagesService(messagesPostgres transport.IMessagesPostgres, messagesCentrifugo transport.ICentrifugo) *MessagesService {
-	return &MessagesService{messagesPostgres, messagesCentrifugo}
+func NewMessagesService(
+	messagesPostgres transport.IMessagesPostgres,
+	messagesCentrifugo transport.ICentrifugo,
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	accessPostgres transport.IAccessPostgres,
+	cfg models.ChatConfig) *MessagesService {
+	return &MessagesService{messagesPostgres, messagesCentrifugo, broadcastsPostgres, accessPostgres, cfg}
 }
 
-func (m *MessagesService) GetMessageByChannel(channel string) ([]models.Messages, error) {
-	return m.messagesPostgres.GetMessageByChannel(channel)
+func (m *MessagesService) GetMessageByChannel(filter models.MessageFilter, viewer *string) (models.MessagesPage, error) {
+	if err := checkChannelAccess(m.broadcastsPostgres, m.accessPostgres, filter.Channel, viewer); err != nil {
+		return models.MessagesPage{}, err
+	}
+	var err error
+	if filter.Moderator, err = isModerator(m.broadcastsPostgres, filter.Channel, viewer); err != nil {
+		return models.MessagesPage{}, err
+	}
+	return m.messagesPostgres.GetMessagesPage(filter)
 }
 
 func (m *MessagesService) CreateMsg(channel string, msg models.PostMessage) (models.Messages, error) {
+	if err := checkChannelAccess(m.broadcastsPostgres, m.accessPostgres, channel, msg.Username); err != nil {
+		return models.Messages{}, err
+	}
+
+	if msg.ParentId != nil {
+		parent, _, err := m.viewMessage(channel, *msg.ParentId, msg.Username)
+		if err == nil && parent.Deleted() {
+			err = models.ErrMessageNotFound
+		}
+		if errors.Is(err, models.ErrMessageNotFound) {
+			return models.Messages{}, models.ErrParentNotFound
+		}
+		if err != nil {
+			return models.Messages{}, err
+		}
+		msg.ParentId = parent.ThreadId()
+	}
+
 	message, err := m.messagesPostgres.CreateMsg(channel, msg)
 	if err != nil {
 		return message, err
 	}
-
-	err = m.messagesCentrifugo.Publish(channel, message)
+	// pending questions reach the channel once a moderator approves them
+	err = m.messagesCentrifugo.Publish(publishTo(channel, message), message)
 
 	return message, err
 }
 
+// getLiveMessage returns ErrMessageNotFound for deleted messages too.
+func (m *MessagesService) getLiveMessage(channel string, id types.UUID) (models.Messages, error) {
+	msg, err := m.getMessage(channel, id)
+	if err == nil && msg.Deleted() {
+		return models.Messages{}, models.ErrMessageNotFound
+	}
+	return msg, err
+}
+
+// viewMessage returns ErrMessageNotFound for questions hidden from the viewer
+// too, moderator reports whether the viewer moderates the channel.
+func (m *MessagesService) viewMessage(channel string, id types.UUID, viewer *string) (msg models.Messages, moderator bool, err error) {
+	if msg, err = m.getMessage(channel, id); err != nil {
+		return msg, false, err
+	}
+	if moderator, err = isModerator(m.broadcastsPostgres, channel, viewer); err != nil {
+		return models.Messages{}, false, err
+	}
+	if msg.Hidden && !moderator {
+		return models.Messages{}, false, models.ErrMessageNotFound
+	}
+	return msg, moderator, nil
+}
+
+// publishTo is the channel changes of the message are published to, hidden
+// questions go to the moderators only.
+func publishTo(channel string, msg models.Messages) string {
+	if msg.Hidden {
+		return models.ModeratorsChannel(channel)
+	}
+	return channel
+}
+
+// getMessage returns ErrMessageNotFound for messages of other channels too,
+// tombstones of deleted messages are returned.
+func (m *MessagesService) getMessage(channel string, id types.UUID) (models.Messages, error) {
+	msg, err := m.messagesPostgres.GetMessageById(id)
+	if err != nil {
+		return msg, err
+	}
+	if msg.Id == nil || msg.Channel != channel {
+		return models.Messages{}, models.ErrMessageNotFound
+	}
+	return msg, nil
+}
+
+func (m *MessagesService) EditMsg(channel string, id types.UUID, item models.EditMessage, actor *string) (models.Messages, error) {
+	msg, err := m.getLiveMessage(channel, id)
+	if err != nil {
+		return msg, err
+	}
+	if !msg.IsAuthor(actor) {
+		return models.Messages{}, models.ErrNotAuthor
+	}
+	if !msg.Editable(m.cfg.EditWindow, time.Now()) {
+		return models.Messages{}, models.ErrEditWindowClosed
+	}
+
+	msg, err = m.messagesPostgres.EditMessage(id, *item.Text, *actor)
+	if err != nil {
+		return msg, err
+	}
+
+	err = m.messagesCentrifugo.Publish(publishTo(channel, msg),
+		models.ActionCentrifugo{Type: models.ActionChatEdit, Payload: msg})
+
+	return msg, err
+}
+
+// DeleteMsg deletes the message of the actor or, for moderators, of anyone.
+func (m *MessagesService) DeleteMsg(channel string, id types.UUID, actor *string) (api.SIdentifier, error) {
+	msg, err := m.getLiveMessage(channel, id)
+	if err != nil {
+		return api.SIdentifier{}, err
+	}
+	if !msg.IsAuthor(actor) {
+		ok, err := isModerator(m.broadcastsPostgres, channel, actor)
+		if err != nil {
+			return api.SIdentifier{}, err
+		}
+		if !ok {
+			return api.SIdentifier{}, models.ErrNotModerator
+		}
+	}
+
+	item, err := m.messagesPostgres.DeleteMessage(id)
+	if err != nil {
+		return item, err
+	}
+	if item.Id == nil {
+		return item, models.ErrMessageNotFound
+	}
+
+	err = m.messagesCentrifugo.Publish(publishTo(channel, msg),
+		models.ActionCentrifugo{Type: models.ActionChatDelete, Payload: item})
+
+	return item, err
+}
+
+func (m *MessagesService) GetMsgEdits(channel string, id types.UUID, viewer *string) ([]models.MessageEdit, error) {
+	if err := checkChannelAccess(m.broadcastsPostgres, m.accessPostgres, channel, viewer); err != nil {
+		return nil, err
+	}
+	msg, _, err := m.viewMessage(channel, id, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Deleted() {
+		return nil, models.ErrMessageNotFound
+	}
+	return m.messagesPostgres.GetMessageEdits(id)
+}
+
+func (m *MessagesService) GetMsgReplies(channel string, id types.UUID, viewer *string) ([]models.Messages, error) {
+	if err := checkChannelAccess(m.broadcastsPostgres, m.accessPostgres, channel, viewer); err != nil {
+		return nil, err
+	}
+	_, moderator, err := m.viewMessage(channel, id, viewer)
+	if err != nil {
+		return nil, err
+	}
+	return m.messagesPostgres.GetReplies(id, moderator)
+}
+
+// isModerator reports whether the actor owns the broadcast of the channel or
+// is an admin.
+func isModerator(broadcastsPostgres transport.IBroadcastsPostgres, channel string, actor *string) (bool, error) {
+	if actor == nil {
+		return false, nil
+	}
+	if id, err := uuid.Parse(channel); err == nil {
+		item, err := broadcastsPostgres.GetBroadcastById(id)
+		if err != nil {
+			return false, err
+		}
+		if item.IsOwner(actor) {
+			return true, nil
+		}
+	}
+	return broadcastsPostgres.CheckAdminUser(api.SUsername{Username: actor})
+}
+
 func (m *MessagesService) CreateReaction(channel string, item models.PostReactionMsg) error {
+	if err := checkChannelAccess(m.broadcastsPostgres, m.accessPostgres, channel, item.Username); err != nil {
+		return err
+	}
+
 	message, err := m.messagesPostgres.AddReaction(item)
 	if err != nil {
 		return err
@@ -43,6 +229,10 @@ func (m *MessagesService) CreateReaction(channel string, item models.PostReactio
 }
 
 func (m *MessagesService) DeleteReaction(channel string, item models.PatchReactionMsg) error {
+	if err := checkChannelAccess(m.broadcastsPostgres, m.accessPostgres, channel, item.Username); err != nil {
+		return err
+	}
+
 	message, err := m.messagesPostgres.DeleteReaction(item)
 	if err != nil {
 		return err
@@ -6,6 +6,7 @@ package mock_service
 
 import (
 	reflect "reflect"
+	time "time"
 
 	api "github.com/alexm24/golang/internal/handler/api"
 	models "github.com/alexm24/golang/internal/models"
@@ -51,6 +52,21 @@ func (mr *MockIAdminMockRecorder) CheckAdminUser(username interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAdminUser", reflect.TypeOf((*MockIAdmin)(nil).CheckAdminUser), username)
 }
 
+// GetChannelToken mocks base method.
+func (m *MockIAdmin) GetChannelToken(user api.SUsername, channel string) (api.SToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelToken", user, channel)
+	ret0, _ := ret[0].(api.SToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelToken indicates an expected call of GetChannelToken.
+func (mr *MockIAdminMockRecorder) GetChannelToken(user, channel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelToken", reflect.TypeOf((*MockIAdmin)(nil).GetChannelToken), user, channel)
+}
+
 // GetToken mocks base method.
 func (m *MockIAdmin) GetToken(user api.SUsername) (api.SToken, error) {
 	m.ctrl.T.Helper()
@@ -90,93 +106,310 @@ func (m *MockIBroadcasts) EXPECT() *MockIBroadcastsMockRecorder {
 }
 
 // ChangeBroadcast mocks base method.
-func (m *MockIBroadcasts) ChangeBroadcast(item models.PutBroadcast) (models.Broadcasts, error) {
+func (m *MockIBroadcasts) ChangeBroadcast(item models.PutBroadcast, scope models.SeriesScope, actor *string, version *int, force bool) (models.Broadcasts, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeBroadcast", item)
+	ret := m.ctrl.Call(m, "ChangeBroadcast", item, scope, actor, version, force)
 	ret0, _ := ret[0].(models.Broadcasts)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ChangeBroadcast indicates an expected call of ChangeBroadcast.
-func (mr *MockIBroadcastsMockRecorder) ChangeBroadcast(item interface{}) *gomock.Call {
+func (mr *MockIBroadcastsMockRecorder) ChangeBroadcast(item, scope, actor, version, force interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBroadcast", reflect.TypeOf((*MockIBroadcasts)(nil).ChangeBroadcast), item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBroadcast", reflect.TypeOf((*MockIBroadcasts)(nil).ChangeBroadcast), item, scope, actor, version, force)
 }
 
 // CreateBroadcast mocks base method.
-func (m *MockIBroadcasts) CreateBroadcast(item models.PostBroadcast) (models.Broadcasts, error) {
+func (m *MockIBroadcasts) CreateBroadcast(item models.PostBroadcast, actor *string, force bool) (models.Broadcasts, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBroadcast", item)
+	ret := m.ctrl.Call(m, "CreateBroadcast", item, actor, force)
 	ret0, _ := ret[0].(models.Broadcasts)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateBroadcast indicates an expected call of CreateBroadcast.
-func (mr *MockIBroadcastsMockRecorder) CreateBroadcast(item interface{}) *gomock.Call {
+func (mr *MockIBroadcastsMockRecorder) CreateBroadcast(item, actor, force interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBroadcast", reflect.TypeOf((*MockIBroadcasts)(nil).CreateBroadcast), item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBroadcast", reflect.TypeOf((*MockIBroadcasts)(nil).CreateBroadcast), item, actor, force)
 }
 
 // DeleteBroadcast mocks base method.
-func (m *MockIBroadcasts) DeleteBroadcast(id types.UUID) (api.SIdentifier, error) {
+func (m *MockIBroadcasts) DeleteBroadcast(id types.UUID, scope models.SeriesScope, deletedBy *string) (api.SIdentifier, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBroadcast", id)
+	ret := m.ctrl.Call(m, "DeleteBroadcast", id, scope, deletedBy)
 	ret0, _ := ret[0].(api.SIdentifier)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteBroadcast indicates an expected call of DeleteBroadcast.
-func (mr *MockIBroadcastsMockRecorder) DeleteBroadcast(id interface{}) *gomock.Call {
+func (mr *MockIBroadcastsMockRecorder) DeleteBroadcast(id, scope, deletedBy interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBroadcast", reflect.TypeOf((*MockIBroadcasts)(nil).DeleteBroadcast), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBroadcast", reflect.TypeOf((*MockIBroadcasts)(nil).DeleteBroadcast), id, scope, deletedBy)
 }
 
 // GetArchBroadcasts mocks base method.
-func (m *MockIBroadcasts) GetArchBroadcasts(username api.SUsername) ([]models.Broadcasts, error) {
+func (m *MockIBroadcasts) GetArchBroadcasts(username api.SUsername, filter models.BroadcastFilter) (models.BroadcastsPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetArchBroadcasts", username)
-	ret0, _ := ret[0].([]models.Broadcasts)
+	ret := m.ctrl.Call(m, "GetArchBroadcasts", username, filter)
+	ret0, _ := ret[0].(models.BroadcastsPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetArchBroadcasts indicates an expected call of GetArchBroadcasts.
-func (mr *MockIBroadcastsMockRecorder) GetArchBroadcasts(username interface{}) *gomock.Call {
+func (mr *MockIBroadcastsMockRecorder) GetArchBroadcasts(username, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchBroadcasts", reflect.TypeOf((*MockIBroadcasts)(nil).GetArchBroadcasts), username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchBroadcasts", reflect.TypeOf((*MockIBroadcasts)(nil).GetArchBroadcasts), username, filter)
 }
 
 // GetBroadcastById mocks base method.
-func (m *MockIBroadcasts) GetBroadcastById(id types.UUID) (models.Broadcasts, error) {
+func (m *MockIBroadcasts) GetBroadcastById(id types.UUID, viewer *string) (models.Broadcasts, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBroadcastById", id)
+	ret := m.ctrl.Call(m, "GetBroadcastById", id, viewer)
 	ret0, _ := ret[0].(models.Broadcasts)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBroadcastById indicates an expected call of GetBroadcastById.
-func (mr *MockIBroadcastsMockRecorder) GetBroadcastById(id interface{}) *gomock.Call {
+func (mr *MockIBroadcastsMockRecorder) GetBroadcastById(id, viewer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBroadcastById", reflect.TypeOf((*MockIBroadcasts)(nil).GetBroadcastById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBroadcastById", reflect.TypeOf((*MockIBroadcasts)(nil).GetBroadcastById), id, viewer)
 }
 
 // GetBroadcasts mocks base method.
-func (m *MockIBroadcasts) GetBroadcasts() ([]models.Broadcasts, error) {
+func (m *MockIBroadcasts) GetBroadcasts(filter models.BroadcastFilter) (models.BroadcastsPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBroadcasts")
-	ret0, _ := ret[0].([]models.Broadcasts)
+	ret := m.ctrl.Call(m, "GetBroadcasts", filter)
+	ret0, _ := ret[0].(models.BroadcastsPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBroadcasts indicates an expected call of GetBroadcasts.
-func (mr *MockIBroadcastsMockRecorder) GetBroadcasts() *gomock.Call {
+func (mr *MockIBroadcastsMockRecorder) GetBroadcasts(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBroadcasts", reflect.TypeOf((*MockIBroadcasts)(nil).GetBroadcasts), filter)
+}
+
+// GetSeriesById mocks base method.
+func (m *MockIBroadcasts) GetSeriesById(id types.UUID, viewer *string) ([]models.Broadcasts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeriesById", id, viewer)
+	ret0, _ := ret[0].([]models.Broadcasts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeriesById indicates an expected call of GetSeriesById.
+func (mr *MockIBroadcastsMockRecorder) GetSeriesById(id, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeriesById", reflect.TypeOf((*MockIBroadcasts)(nil).GetSeriesById), id, viewer)
+}
+
+// PatchBroadcast mocks base method.
+func (m *MockIBroadcasts) PatchBroadcast(id types.UUID, patch models.PatchBroadcast, actor *string, version *int, force bool) (models.Broadcasts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchBroadcast", id, patch, actor, version, force)
+	ret0, _ := ret[0].(models.Broadcasts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchBroadcast indicates an expected call of PatchBroadcast.
+func (mr *MockIBroadcastsMockRecorder) PatchBroadcast(id, patch, actor, version, force interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchBroadcast", reflect.TypeOf((*MockIBroadcasts)(nil).PatchBroadcast), id, patch, actor, version, force)
+}
+
+// RotateStreamKey mocks base method.
+func (m *MockIBroadcasts) RotateStreamKey(id types.UUID, username api.SUsername) (models.Broadcasts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateStreamKey", id, username)
+	ret0, _ := ret[0].(models.Broadcasts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateStreamKey indicates an expected call of RotateStreamKey.
+func (mr *MockIBroadcastsMockRecorder) RotateStreamKey(id, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateStreamKey", reflect.TypeOf((*MockIBroadcasts)(nil).RotateStreamKey), id, username)
+}
+
+// MockITrash is a mock of ITrash interface.
+type MockITrash struct {
+	ctrl     *gomock.Controller
+	recorder *MockITrashMockRecorder
+}
+
+// MockITrashMockRecorder is the mock recorder for MockITrash.
+type MockITrashMockRecorder struct {
+	mock *MockITrash
+}
+
+// NewMockITrash creates a new mock instance.
+func NewMockITrash(ctrl *gomock.Controller) *MockITrash {
+	mock := &MockITrash{ctrl: ctrl}
+	mock.recorder = &MockITrashMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITrash) EXPECT() *MockITrashMockRecorder {
+	return m.recorder
+}
+
+// GetTrash mocks base method.
+func (m *MockITrash) GetTrash(username api.SUsername) ([]models.Broadcasts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", username)
+	ret0, _ := ret[0].([]models.Broadcasts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockITrashMockRecorder) GetTrash(username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockITrash)(nil).GetTrash), username)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockITrash) PurgeTrash() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockITrashMockRecorder) PurgeTrash() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockITrash)(nil).PurgeTrash))
+}
+
+// RestoreBroadcast mocks base method.
+func (m *MockITrash) RestoreBroadcast(id types.UUID, username api.SUsername) (models.Broadcasts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBroadcast", id, username)
+	ret0, _ := ret[0].(models.Broadcasts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBroadcast indicates an expected call of RestoreBroadcast.
+func (mr *MockITrashMockRecorder) RestoreBroadcast(id, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBroadcast", reflect.TypeOf((*MockITrash)(nil).RestoreBroadcast), id, username)
+}
+
+// MockIRevisions is a mock of IRevisions interface.
+type MockIRevisions struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRevisionsMockRecorder
+}
+
+// MockIRevisionsMockRecorder is the mock recorder for MockIRevisions.
+type MockIRevisionsMockRecorder struct {
+	mock *MockIRevisions
+}
+
+// NewMockIRevisions creates a new mock instance.
+func NewMockIRevisions(ctrl *gomock.Controller) *MockIRevisions {
+	mock := &MockIRevisions{ctrl: ctrl}
+	mock.recorder = &MockIRevisionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRevisions) EXPECT() *MockIRevisionsMockRecorder {
+	return m.recorder
+}
+
+// GetRevisions mocks base method.
+func (m *MockIRevisions) GetRevisions(broadcastId types.UUID, viewer *string) ([]models.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", broadcastId, viewer)
+	ret0, _ := ret[0].([]models.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockIRevisionsMockRecorder) GetRevisions(broadcastId, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockIRevisions)(nil).GetRevisions), broadcastId, viewer)
+}
+
+// RevertRevision mocks base method.
+func (m *MockIRevisions) RevertRevision(id, revisionId types.UUID, username api.SUsername, version *int, force bool) (models.Broadcasts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertRevision", id, revisionId, username, version, force)
+	ret0, _ := ret[0].(models.Broadcasts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertRevision indicates an expected call of RevertRevision.
+func (mr *MockIRevisionsMockRecorder) RevertRevision(id, revisionId, username, version, force interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertRevision", reflect.TypeOf((*MockIRevisions)(nil).RevertRevision), id, revisionId, username, version, force)
+}
+
+// MockILifeCycle is a mock of ILifeCycle interface.
+type MockILifeCycle struct {
+	ctrl     *gomock.Controller
+	recorder *MockILifeCycleMockRecorder
+}
+
+// MockILifeCycleMockRecorder is the mock recorder for MockILifeCycle.
+type MockILifeCycleMockRecorder struct {
+	mock *MockILifeCycle
+}
+
+// NewMockILifeCycle creates a new mock instance.
+func NewMockILifeCycle(ctrl *gomock.Controller) *MockILifeCycle {
+	mock := &MockILifeCycle{ctrl: ctrl}
+	mock.recorder = &MockILifeCycleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILifeCycle) EXPECT() *MockILifeCycleMockRecorder {
+	return m.recorder
+}
+
+// ChangeLifeCycle mocks base method.
+func (m *MockILifeCycle) ChangeLifeCycle(id types.UUID, life models.LifeCycleBroadcast) (models.Broadcasts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeLifeCycle", id, life)
+	ret0, _ := ret[0].(models.Broadcasts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeLifeCycle indicates an expected call of ChangeLifeCycle.
+func (mr *MockILifeCycleMockRecorder) ChangeLifeCycle(id, life interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeLifeCycle", reflect.TypeOf((*MockILifeCycle)(nil).ChangeLifeCycle), id, life)
+}
+
+// UpdateLifeCycle mocks base method.
+func (m *MockILifeCycle) UpdateLifeCycle() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLifeCycle")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLifeCycle indicates an expected call of UpdateLifeCycle.
+func (mr *MockILifeCycleMockRecorder) UpdateLifeCycle() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBroadcasts", reflect.TypeOf((*MockIBroadcasts)(nil).GetBroadcasts))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLifeCycle", reflect.TypeOf((*MockILifeCycle)(nil).UpdateLifeCycle))
 }
 
 // MockIParticipants is a mock of IParticipants interface.
@@ -203,11 +436,12 @@ func (m *MockIParticipants) EXPECT() *MockIParticipantsMockRecorder {
 }
 
 // CreateParticipant mocks base method.
-func (m *MockIParticipants) CreateParticipant(channel string, user models.PostParticipant) error {
+func (m *MockIParticipants) CreateParticipant(channel string, user models.PostParticipant) (models.Registration, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateParticipant", channel, user)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(models.Registration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreateParticipant indicates an expected call of CreateParticipant.
@@ -216,19 +450,48 @@ func (mr *MockIParticipantsMockRecorder) CreateParticipant(channel, user interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateParticipant", reflect.TypeOf((*MockIParticipants)(nil).CreateParticipant), channel, user)
 }
 
+// DeleteParticipant mocks base method.
+func (m *MockIParticipants) DeleteParticipant(channel, username string) (models.Registration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteParticipant", channel, username)
+	ret0, _ := ret[0].(models.Registration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteParticipant indicates an expected call of DeleteParticipant.
+func (mr *MockIParticipantsMockRecorder) DeleteParticipant(channel, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParticipant", reflect.TypeOf((*MockIParticipants)(nil).DeleteParticipant), channel, username)
+}
+
 // GetParticipants mocks base method.
-func (m *MockIParticipants) GetParticipants(channel string) ([]models.Participant, error) {
+func (m *MockIParticipants) GetParticipants(channel string, viewer *string) ([]models.Participant, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetParticipants", channel)
+	ret := m.ctrl.Call(m, "GetParticipants", channel, viewer)
 	ret0, _ := ret[0].([]models.Participant)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetParticipants indicates an expected call of GetParticipants.
-func (mr *MockIParticipantsMockRecorder) GetParticipants(channel interface{}) *gomock.Call {
+func (mr *MockIParticipantsMockRecorder) GetParticipants(channel, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipants", reflect.TypeOf((*MockIParticipants)(nil).GetParticipants), channel, viewer)
+}
+
+// PromoteWaitlist mocks base method.
+func (m *MockIParticipants) PromoteWaitlist(broadcastId types.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteWaitlist", broadcastId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PromoteWaitlist indicates an expected call of PromoteWaitlist.
+func (mr *MockIParticipantsMockRecorder) PromoteWaitlist(broadcastId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipants", reflect.TypeOf((*MockIParticipants)(nil).GetParticipants), channel)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteWaitlist", reflect.TypeOf((*MockIParticipants)(nil).PromoteWaitlist), broadcastId)
 }
 
 // MockIMessages is a mock of IMessages interface.
@@ -283,6 +546,21 @@ func (mr *MockIMessagesMockRecorder) CreateReaction(channel, item interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReaction", reflect.TypeOf((*MockIMessages)(nil).CreateReaction), channel, item)
 }
 
+// DeleteMsg mocks base method.
+func (m *MockIMessages) DeleteMsg(channel string, id types.UUID, actor *string) (api.SIdentifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMsg", channel, id, actor)
+	ret0, _ := ret[0].(api.SIdentifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMsg indicates an expected call of DeleteMsg.
+func (mr *MockIMessagesMockRecorder) DeleteMsg(channel, id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMsg", reflect.TypeOf((*MockIMessages)(nil).DeleteMsg), channel, id, actor)
+}
+
 // DeleteReaction mocks base method.
 func (m *MockIMessages) DeleteReaction(channel string, item models.PatchReactionMsg) error {
 	m.ctrl.T.Helper()
@@ -297,19 +575,177 @@ func (mr *MockIMessagesMockRecorder) DeleteReaction(channel, item interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReaction", reflect.TypeOf((*MockIMessages)(nil).DeleteReaction), channel, item)
 }
 
+// EditMsg mocks base method.
+func (m *MockIMessages) EditMsg(channel string, id types.UUID, item models.EditMessage, actor *string) (models.Messages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditMsg", channel, id, item, actor)
+	ret0, _ := ret[0].(models.Messages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditMsg indicates an expected call of EditMsg.
+func (mr *MockIMessagesMockRecorder) EditMsg(channel, id, item, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMsg", reflect.TypeOf((*MockIMessages)(nil).EditMsg), channel, id, item, actor)
+}
+
 // GetMessageByChannel mocks base method.
-func (m *MockIMessages) GetMessageByChannel(channel string) ([]models.Messages, error) {
+func (m *MockIMessages) GetMessageByChannel(filter models.MessageFilter, viewer *string) (models.MessagesPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMessageByChannel", channel)
-	ret0, _ := ret[0].([]models.Messages)
+	ret := m.ctrl.Call(m, "GetMessageByChannel", filter, viewer)
+	ret0, _ := ret[0].(models.MessagesPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMessageByChannel indicates an expected call of GetMessageByChannel.
-func (mr *MockIMessagesMockRecorder) GetMessageByChannel(channel interface{}) *gomock.Call {
+func (mr *MockIMessagesMockRecorder) GetMessageByChannel(filter, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByChannel", reflect.TypeOf((*MockIMessages)(nil).GetMessageByChannel), filter, viewer)
+}
+
+// GetMsgEdits mocks base method.
+func (m *MockIMessages) GetMsgEdits(channel string, id types.UUID, viewer *string) ([]models.MessageEdit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMsgEdits", channel, id, viewer)
+	ret0, _ := ret[0].([]models.MessageEdit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMsgEdits indicates an expected call of GetMsgEdits.
+func (mr *MockIMessagesMockRecorder) GetMsgEdits(channel, id, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMsgEdits", reflect.TypeOf((*MockIMessages)(nil).GetMsgEdits), channel, id, viewer)
+}
+
+// GetMsgReplies mocks base method.
+func (m *MockIMessages) GetMsgReplies(channel string, id types.UUID, viewer *string) ([]models.Messages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMsgReplies", channel, id, viewer)
+	ret0, _ := ret[0].([]models.Messages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMsgReplies indicates an expected call of GetMsgReplies.
+func (mr *MockIMessagesMockRecorder) GetMsgReplies(channel, id, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMsgReplies", reflect.TypeOf((*MockIMessages)(nil).GetMsgReplies), channel, id, viewer)
+}
+
+// MockIQuestions is a mock of IQuestions interface.
+type MockIQuestions struct {
+	ctrl     *gomock.Controller
+	recorder *MockIQuestionsMockRecorder
+}
+
+// MockIQuestionsMockRecorder is the mock recorder for MockIQuestions.
+type MockIQuestionsMockRecorder struct {
+	mock *MockIQuestions
+}
+
+// NewMockIQuestions creates a new mock instance.
+func NewMockIQuestions(ctrl *gomock.Controller) *MockIQuestions {
+	mock := &MockIQuestions{ctrl: ctrl}
+	mock.recorder = &MockIQuestionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIQuestions) EXPECT() *MockIQuestionsMockRecorder {
+	return m.recorder
+}
+
+// GetQuestions mocks base method.
+func (m *MockIQuestions) GetQuestions(channel string, states []models.QuestionState, viewer *string) ([]models.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestions", channel, states, viewer)
+	ret0, _ := ret[0].([]models.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestions indicates an expected call of GetQuestions.
+func (mr *MockIQuestionsMockRecorder) GetQuestions(channel, states, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestions", reflect.TypeOf((*MockIQuestions)(nil).GetQuestions), channel, states, viewer)
+}
+
+// PatchQuestion mocks base method.
+func (m *MockIQuestions) PatchQuestion(channel string, id types.UUID, item models.PatchQuestion, actor *string) (models.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchQuestion", channel, id, item, actor)
+	ret0, _ := ret[0].(models.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchQuestion indicates an expected call of PatchQuestion.
+func (mr *MockIQuestionsMockRecorder) PatchQuestion(channel, id, item, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchQuestion", reflect.TypeOf((*MockIQuestions)(nil).PatchQuestion), channel, id, item, actor)
+}
+
+// PinQuestion mocks base method.
+func (m *MockIQuestions) PinQuestion(channel string, id types.UUID, actor *string) (models.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinQuestion", channel, id, actor)
+	ret0, _ := ret[0].(models.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinQuestion indicates an expected call of PinQuestion.
+func (mr *MockIQuestionsMockRecorder) PinQuestion(channel, id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinQuestion", reflect.TypeOf((*MockIQuestions)(nil).PinQuestion), channel, id, actor)
+}
+
+// UnpinQuestion mocks base method.
+func (m *MockIQuestions) UnpinQuestion(channel string, id types.UUID, actor *string) (models.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinQuestion", channel, id, actor)
+	ret0, _ := ret[0].(models.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpinQuestion indicates an expected call of UnpinQuestion.
+func (mr *MockIQuestionsMockRecorder) UnpinQuestion(channel, id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinQuestion", reflect.TypeOf((*MockIQuestions)(nil).UnpinQuestion), channel, id, actor)
+}
+
+// UnvoteQuestion mocks base method.
+func (m *MockIQuestions) UnvoteQuestion(channel string, id types.UUID, actor string) (models.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnvoteQuestion", channel, id, actor)
+	ret0, _ := ret[0].(models.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnvoteQuestion indicates an expected call of UnvoteQuestion.
+func (mr *MockIQuestionsMockRecorder) UnvoteQuestion(channel, id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnvoteQuestion", reflect.TypeOf((*MockIQuestions)(nil).UnvoteQuestion), channel, id, actor)
+}
+
+// VoteQuestion mocks base method.
+func (m *MockIQuestions) VoteQuestion(channel string, id types.UUID, actor string) (models.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoteQuestion", channel, id, actor)
+	ret0, _ := ret[0].(models.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoteQuestion indicates an expected call of VoteQuestion.
+func (mr *MockIQuestionsMockRecorder) VoteQuestion(channel, id, actor interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByChannel", reflect.TypeOf((*MockIMessages)(nil).GetMessageByChannel), channel)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteQuestion", reflect.TypeOf((*MockIQuestions)(nil).VoteQuestion), channel, id, actor)
 }
 
 // MockIStream is a mock of IStream interface.
@@ -336,18 +772,18 @@ func (m *MockIStream) EXPECT() *MockIStreamMockRecorder {
 }
 
 // ChangeDescByUsername mocks base method.
-func (m *MockIStream) ChangeDescByUsername(stream models.PutStream) (models.Stream, error) {
+func (m *MockIStream) ChangeDescByUsername(stream models.PutStream, version *int) (models.Stream, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeDescByUsername", stream)
+	ret := m.ctrl.Call(m, "ChangeDescByUsername", stream, version)
 	ret0, _ := ret[0].(models.Stream)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ChangeDescByUsername indicates an expected call of ChangeDescByUsername.
-func (mr *MockIStreamMockRecorder) ChangeDescByUsername(stream interface{}) *gomock.Call {
+func (mr *MockIStreamMockRecorder) ChangeDescByUsername(stream, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeDescByUsername", reflect.TypeOf((*MockIStream)(nil).ChangeDescByUsername), stream)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeDescByUsername", reflect.TypeOf((*MockIStream)(nil).ChangeDescByUsername), stream, version)
 }
 
 // ClearChat mocks base method.
@@ -394,12 +830,27 @@ func (mr *MockIStreamMockRecorder) GetStream(username interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStream", reflect.TypeOf((*MockIStream)(nil).GetStream), username)
 }
 
-// MockILive is a mock of ILive interface.
-type MockILive struct {
-	ctrl     *gomock.Controller
-	recorder *MockILiveMockRecorder
-}
-
+// RotateStreamKey mocks base method.
+func (m *MockIStream) RotateStreamKey(username string, actor api.SUsername) (models.Stream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateStreamKey", username, actor)
+	ret0, _ := ret[0].(models.Stream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateStreamKey indicates an expected call of RotateStreamKey.
+func (mr *MockIStreamMockRecorder) RotateStreamKey(username, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateStreamKey", reflect.TypeOf((*MockIStream)(nil).RotateStreamKey), username, actor)
+}
+
+// MockILive is a mock of ILive interface.
+type MockILive struct {
+	ctrl     *gomock.Controller
+	recorder *MockILiveMockRecorder
+}
+
 // MockILiveMockRecorder is the mock recorder for MockILive.
 type MockILiveMockRecorder struct {
 	mock *MockILive
@@ -471,33 +922,33 @@ func (m *MockIImages) EXPECT() *MockIImagesMockRecorder {
 }
 
 // CreateImage mocks base method.
-func (m *MockIImages) CreateImage(id string, file []byte) (models.ResImage, error) {
+func (m *MockIImages) CreateImage(id string, file []byte, actor *string) (models.ResImage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateImage", id, file)
+	ret := m.ctrl.Call(m, "CreateImage", id, file, actor)
 	ret0, _ := ret[0].(models.ResImage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateImage indicates an expected call of CreateImage.
-func (mr *MockIImagesMockRecorder) CreateImage(id, file interface{}) *gomock.Call {
+func (mr *MockIImagesMockRecorder) CreateImage(id, file, actor interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImage", reflect.TypeOf((*MockIImages)(nil).CreateImage), id, file)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImage", reflect.TypeOf((*MockIImages)(nil).CreateImage), id, file, actor)
 }
 
 // DelImageById mocks base method.
-func (m *MockIImages) DelImageById(id types.UUID) (api.SIdentifier, error) {
+func (m *MockIImages) DelImageById(id types.UUID, actor *string) (api.SIdentifier, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DelImageById", id)
+	ret := m.ctrl.Call(m, "DelImageById", id, actor)
 	ret0, _ := ret[0].(api.SIdentifier)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DelImageById indicates an expected call of DelImageById.
-func (mr *MockIImagesMockRecorder) DelImageById(id interface{}) *gomock.Call {
+func (mr *MockIImagesMockRecorder) DelImageById(id, actor interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelImageById", reflect.TypeOf((*MockIImages)(nil).DelImageById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelImageById", reflect.TypeOf((*MockIImages)(nil).DelImageById), id, actor)
 }
 
 // GetImageById mocks base method.
@@ -611,3 +1062,910 @@ func (mr *MockIZoomMockRecorder) SendMail(item interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMail", reflect.TypeOf((*MockIZoom)(nil).SendMail), item)
 }
+
+// MockISearch is a mock of ISearch interface.
+type MockISearch struct {
+	ctrl     *gomock.Controller
+	recorder *MockISearchMockRecorder
+}
+
+// MockISearchMockRecorder is the mock recorder for MockISearch.
+type MockISearchMockRecorder struct {
+	mock *MockISearch
+}
+
+// NewMockISearch creates a new mock instance.
+func NewMockISearch(ctrl *gomock.Controller) *MockISearch {
+	mock := &MockISearch{ctrl: ctrl}
+	mock.recorder = &MockISearchMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISearch) EXPECT() *MockISearchMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockISearch) Search(q models.SearchQuery) (models.Search, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", q)
+	ret0, _ := ret[0].(models.Search)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockISearchMockRecorder) Search(q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockISearch)(nil).Search), q)
+}
+
+// MockICalendar is a mock of ICalendar interface.
+type MockICalendar struct {
+	ctrl     *gomock.Controller
+	recorder *MockICalendarMockRecorder
+}
+
+// MockICalendarMockRecorder is the mock recorder for MockICalendar.
+type MockICalendarMockRecorder struct {
+	mock *MockICalendar
+}
+
+// NewMockICalendar creates a new mock instance.
+func NewMockICalendar(ctrl *gomock.Controller) *MockICalendar {
+	mock := &MockICalendar{ctrl: ctrl}
+	mock.recorder = &MockICalendarMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICalendar) EXPECT() *MockICalendarMockRecorder {
+	return m.recorder
+}
+
+// CreateCalendarFeed mocks base method.
+func (m *MockICalendar) CreateCalendarFeed(item models.PostCalendarFeed) (api.SCalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendarFeed", item)
+	ret0, _ := ret[0].(api.SCalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCalendarFeed indicates an expected call of CreateCalendarFeed.
+func (mr *MockICalendarMockRecorder) CreateCalendarFeed(item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendarFeed", reflect.TypeOf((*MockICalendar)(nil).CreateCalendarFeed), item)
+}
+
+// GetCalendar mocks base method.
+func (m *MockICalendar) GetCalendar(token string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendar", token)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendar indicates an expected call of GetCalendar.
+func (mr *MockICalendarMockRecorder) GetCalendar(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockICalendar)(nil).GetCalendar), token)
+}
+
+// MockIFeeds is a mock of IFeeds interface.
+type MockIFeeds struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFeedsMockRecorder
+}
+
+// MockIFeedsMockRecorder is the mock recorder for MockIFeeds.
+type MockIFeedsMockRecorder struct {
+	mock *MockIFeeds
+}
+
+// NewMockIFeeds creates a new mock instance.
+func NewMockIFeeds(ctrl *gomock.Controller) *MockIFeeds {
+	mock := &MockIFeeds{ctrl: ctrl}
+	mock.recorder = &MockIFeedsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFeeds) EXPECT() *MockIFeedsMockRecorder {
+	return m.recorder
+}
+
+// GetArchiveFeed mocks base method.
+func (m *MockIFeeds) GetArchiveFeed(filter models.FeedFilter, format models.FeedFormat) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchiveFeed", filter, format)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchiveFeed indicates an expected call of GetArchiveFeed.
+func (mr *MockIFeedsMockRecorder) GetArchiveFeed(filter, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchiveFeed", reflect.TypeOf((*MockIFeeds)(nil).GetArchiveFeed), filter, format)
+}
+
+// MockIRecordings is a mock of IRecordings interface.
+type MockIRecordings struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRecordingsMockRecorder
+}
+
+// MockIRecordingsMockRecorder is the mock recorder for MockIRecordings.
+type MockIRecordingsMockRecorder struct {
+	mock *MockIRecordings
+}
+
+// NewMockIRecordings creates a new mock instance.
+func NewMockIRecordings(ctrl *gomock.Controller) *MockIRecordings {
+	mock := &MockIRecordings{ctrl: ctrl}
+	mock.recorder = &MockIRecordingsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRecordings) EXPECT() *MockIRecordingsMockRecorder {
+	return m.recorder
+}
+
+// CreateRecording mocks base method.
+func (m *MockIRecordings) CreateRecording(broadcastId types.UUID, item models.PostRecording, actor *string) (models.Recording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecording", broadcastId, item, actor)
+	ret0, _ := ret[0].(models.Recording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecording indicates an expected call of CreateRecording.
+func (mr *MockIRecordingsMockRecorder) CreateRecording(broadcastId, item, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecording", reflect.TypeOf((*MockIRecordings)(nil).CreateRecording), broadcastId, item, actor)
+}
+
+// DeleteRecording mocks base method.
+func (m *MockIRecordings) DeleteRecording(id types.UUID, actor *string) (api.SIdentifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecording", id, actor)
+	ret0, _ := ret[0].(api.SIdentifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRecording indicates an expected call of DeleteRecording.
+func (mr *MockIRecordingsMockRecorder) DeleteRecording(id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecording", reflect.TypeOf((*MockIRecordings)(nil).DeleteRecording), id, actor)
+}
+
+// GetRecordings mocks base method.
+func (m *MockIRecordings) GetRecordings(broadcastId types.UUID, viewer *string) ([]models.Recording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecordings", broadcastId, viewer)
+	ret0, _ := ret[0].([]models.Recording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecordings indicates an expected call of GetRecordings.
+func (mr *MockIRecordingsMockRecorder) GetRecordings(broadcastId, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecordings", reflect.TypeOf((*MockIRecordings)(nil).GetRecordings), broadcastId, viewer)
+}
+
+// PlayRecording mocks base method.
+func (m *MockIRecordings) PlayRecording(id types.UUID, expires int64, signature string) (models.RecordingMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlayRecording", id, expires, signature)
+	ret0, _ := ret[0].(models.RecordingMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlayRecording indicates an expected call of PlayRecording.
+func (mr *MockIRecordingsMockRecorder) PlayRecording(id, expires, signature interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayRecording", reflect.TypeOf((*MockIRecordings)(nil).PlayRecording), id, expires, signature)
+}
+
+// UploadLimit mocks base method.
+func (m *MockIRecordings) UploadLimit(broadcastId types.UUID, actor *string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadLimit", broadcastId, actor)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadLimit indicates an expected call of UploadLimit.
+func (mr *MockIRecordingsMockRecorder) UploadLimit(broadcastId, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadLimit", reflect.TypeOf((*MockIRecordings)(nil).UploadLimit), broadcastId, actor)
+}
+
+// UploadRecording mocks base method.
+func (m *MockIRecordings) UploadRecording(broadcastId types.UUID, item models.RecordingUpload, actor *string) (models.Recording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadRecording", broadcastId, item, actor)
+	ret0, _ := ret[0].(models.Recording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadRecording indicates an expected call of UploadRecording.
+func (mr *MockIRecordingsMockRecorder) UploadRecording(broadcastId, item, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadRecording", reflect.TypeOf((*MockIRecordings)(nil).UploadRecording), broadcastId, item, actor)
+}
+
+// WatchRecording mocks base method.
+func (m *MockIRecordings) WatchRecording(id types.UUID, viewer *string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchRecording", id, viewer)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchRecording indicates an expected call of WatchRecording.
+func (mr *MockIRecordingsMockRecorder) WatchRecording(id, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRecording", reflect.TypeOf((*MockIRecordings)(nil).WatchRecording), id, viewer)
+}
+
+// MockIIngest is a mock of IIngest interface.
+type MockIIngest struct {
+	ctrl     *gomock.Controller
+	recorder *MockIIngestMockRecorder
+}
+
+// MockIIngestMockRecorder is the mock recorder for MockIIngest.
+type MockIIngestMockRecorder struct {
+	mock *MockIIngest
+}
+
+// NewMockIIngest creates a new mock instance.
+func NewMockIIngest(ctrl *gomock.Controller) *MockIIngest {
+	mock := &MockIIngest{ctrl: ctrl}
+	mock.recorder = &MockIIngestMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIIngest) EXPECT() *MockIIngestMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockIIngest) Publish(e models.IngestEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockIIngestMockRecorder) Publish(e interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockIIngest)(nil).Publish), e)
+}
+
+// PublishDone mocks base method.
+func (m *MockIIngest) PublishDone(e models.IngestEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishDone", e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishDone indicates an expected call of PublishDone.
+func (mr *MockIIngestMockRecorder) PublishDone(e interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDone", reflect.TypeOf((*MockIIngest)(nil).PublishDone), e)
+}
+
+// RecordDone mocks base method.
+func (m *MockIIngest) RecordDone(e models.IngestEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordDone", e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordDone indicates an expected call of RecordDone.
+func (mr *MockIIngestMockRecorder) RecordDone(e interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDone", reflect.TypeOf((*MockIIngest)(nil).RecordDone), e)
+}
+
+// MockIAccess is a mock of IAccess interface.
+type MockIAccess struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAccessMockRecorder
+}
+
+// MockIAccessMockRecorder is the mock recorder for MockIAccess.
+type MockIAccessMockRecorder struct {
+	mock *MockIAccess
+}
+
+// NewMockIAccess creates a new mock instance.
+func NewMockIAccess(ctrl *gomock.Controller) *MockIAccess {
+	mock := &MockIAccess{ctrl: ctrl}
+	mock.recorder = &MockIAccessMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAccess) EXPECT() *MockIAccessMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvite mocks base method.
+func (m *MockIAccess) AcceptInvite(token, username string) (models.Broadcasts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvite", token, username)
+	ret0, _ := ret[0].(models.Broadcasts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvite indicates an expected call of AcceptInvite.
+func (mr *MockIAccessMockRecorder) AcceptInvite(token, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvite", reflect.TypeOf((*MockIAccess)(nil).AcceptInvite), token, username)
+}
+
+// CreateInvite mocks base method.
+func (m *MockIAccess) CreateInvite(id types.UUID, actor *string) (api.SInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvite", id, actor)
+	ret0, _ := ret[0].(api.SInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockIAccessMockRecorder) CreateInvite(id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockIAccess)(nil).CreateInvite), id, actor)
+}
+
+// GetAccess mocks base method.
+func (m *MockIAccess) GetAccess(id types.UUID, actor *string) ([]models.Access, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccess", id, actor)
+	ret0, _ := ret[0].([]models.Access)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccess indicates an expected call of GetAccess.
+func (mr *MockIAccessMockRecorder) GetAccess(id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccess", reflect.TypeOf((*MockIAccess)(nil).GetAccess), id, actor)
+}
+
+// GrantAccess mocks base method.
+func (m *MockIAccess) GrantAccess(id types.UUID, username string, actor *string) (models.Access, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantAccess", id, username, actor)
+	ret0, _ := ret[0].(models.Access)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantAccess indicates an expected call of GrantAccess.
+func (mr *MockIAccessMockRecorder) GrantAccess(id, username, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantAccess", reflect.TypeOf((*MockIAccess)(nil).GrantAccess), id, username, actor)
+}
+
+// RevokeAccess mocks base method.
+func (m *MockIAccess) RevokeAccess(id types.UUID, username string, actor *string) (models.Access, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccess", id, username, actor)
+	ret0, _ := ret[0].(models.Access)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccess indicates an expected call of RevokeAccess.
+func (mr *MockIAccessMockRecorder) RevokeAccess(id, username, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccess", reflect.TypeOf((*MockIAccess)(nil).RevokeAccess), id, username, actor)
+}
+
+// MockITags is a mock of ITags interface.
+type MockITags struct {
+	ctrl     *gomock.Controller
+	recorder *MockITagsMockRecorder
+}
+
+// MockITagsMockRecorder is the mock recorder for MockITags.
+type MockITagsMockRecorder struct {
+	mock *MockITags
+}
+
+// NewMockITags creates a new mock instance.
+func NewMockITags(ctrl *gomock.Controller) *MockITags {
+	mock := &MockITags{ctrl: ctrl}
+	mock.recorder = &MockITagsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITags) EXPECT() *MockITagsMockRecorder {
+	return m.recorder
+}
+
+// ChangeTag mocks base method.
+func (m *MockITags) ChangeTag(id types.UUID, item models.Tag, actor *string) (models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeTag", id, item, actor)
+	ret0, _ := ret[0].(models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeTag indicates an expected call of ChangeTag.
+func (mr *MockITagsMockRecorder) ChangeTag(id, item, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeTag", reflect.TypeOf((*MockITags)(nil).ChangeTag), id, item, actor)
+}
+
+// CreateCategory mocks base method.
+func (m *MockITags) CreateCategory(item models.Category, actor *string) (models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", item, actor)
+	ret0, _ := ret[0].(models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockITagsMockRecorder) CreateCategory(item, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockITags)(nil).CreateCategory), item, actor)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockITags) DeleteCategory(id types.UUID, actor *string) (api.SIdentifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", id, actor)
+	ret0, _ := ret[0].(api.SIdentifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockITagsMockRecorder) DeleteCategory(id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockITags)(nil).DeleteCategory), id, actor)
+}
+
+// DeleteTag mocks base method.
+func (m *MockITags) DeleteTag(id types.UUID, actor *string) (api.SIdentifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", id, actor)
+	ret0, _ := ret[0].(api.SIdentifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockITagsMockRecorder) DeleteTag(id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockITags)(nil).DeleteTag), id, actor)
+}
+
+// GetCategories mocks base method.
+func (m *MockITags) GetCategories() ([]models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategories")
+	ret0, _ := ret[0].([]models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategories indicates an expected call of GetCategories.
+func (mr *MockITagsMockRecorder) GetCategories() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockITags)(nil).GetCategories))
+}
+
+// GetTags mocks base method.
+func (m *MockITags) GetTags() ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags")
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockITagsMockRecorder) GetTags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockITags)(nil).GetTags))
+}
+
+// SaveTag mocks base method.
+func (m *MockITags) SaveTag(item models.Tag, actor *string) (models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTag", item, actor)
+	ret0, _ := ret[0].(models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTag indicates an expected call of SaveTag.
+func (mr *MockITagsMockRecorder) SaveTag(item, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTag", reflect.TypeOf((*MockITags)(nil).SaveTag), item, actor)
+}
+
+// MockIAnalytics is a mock of IAnalytics interface.
+type MockIAnalytics struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAnalyticsMockRecorder
+}
+
+// MockIAnalyticsMockRecorder is the mock recorder for MockIAnalytics.
+type MockIAnalyticsMockRecorder struct {
+	mock *MockIAnalytics
+}
+
+// NewMockIAnalytics creates a new mock instance.
+func NewMockIAnalytics(ctrl *gomock.Controller) *MockIAnalytics {
+	mock := &MockIAnalytics{ctrl: ctrl}
+	mock.recorder = &MockIAnalyticsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAnalytics) EXPECT() *MockIAnalyticsMockRecorder {
+	return m.recorder
+}
+
+// GetAnalytics mocks base method.
+func (m *MockIAnalytics) GetAnalytics(id types.UUID, actor *string) (models.Analytics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalytics", id, actor)
+	ret0, _ := ret[0].(models.Analytics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalytics indicates an expected call of GetAnalytics.
+func (mr *MockIAnalyticsMockRecorder) GetAnalytics(id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalytics", reflect.TypeOf((*MockIAnalytics)(nil).GetAnalytics), id, actor)
+}
+
+// GetAnalyticsSeries mocks base method.
+func (m *MockIAnalytics) GetAnalyticsSeries(id types.UUID, actor *string, bucket time.Duration) ([]models.AnalyticsBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalyticsSeries", id, actor, bucket)
+	ret0, _ := ret[0].([]models.AnalyticsBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalyticsSeries indicates an expected call of GetAnalyticsSeries.
+func (mr *MockIAnalyticsMockRecorder) GetAnalyticsSeries(id, actor, bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalyticsSeries", reflect.TypeOf((*MockIAnalytics)(nil).GetAnalyticsSeries), id, actor, bucket)
+}
+
+// TrackViewers mocks base method.
+func (m *MockIAnalytics) TrackViewers() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrackViewers")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrackViewers indicates an expected call of TrackViewers.
+func (mr *MockIAnalyticsMockRecorder) TrackViewers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackViewers", reflect.TypeOf((*MockIAnalytics)(nil).TrackViewers))
+}
+
+// MockIReports is a mock of IReports interface.
+type MockIReports struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReportsMockRecorder
+}
+
+// MockIReportsMockRecorder is the mock recorder for MockIReports.
+type MockIReportsMockRecorder struct {
+	mock *MockIReports
+}
+
+// NewMockIReports creates a new mock instance.
+func NewMockIReports(ctrl *gomock.Controller) *MockIReports {
+	mock := &MockIReports{ctrl: ctrl}
+	mock.recorder = &MockIReportsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReports) EXPECT() *MockIReportsMockRecorder {
+	return m.recorder
+}
+
+// DeleteReportMail mocks base method.
+func (m *MockIReports) DeleteReportMail(id types.UUID, actor *string) (models.ReportMail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReportMail", id, actor)
+	ret0, _ := ret[0].(models.ReportMail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReportMail indicates an expected call of DeleteReportMail.
+func (mr *MockIReportsMockRecorder) DeleteReportMail(id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReportMail", reflect.TypeOf((*MockIReports)(nil).DeleteReportMail), id, actor)
+}
+
+// GetReport mocks base method.
+func (m *MockIReports) GetReport(id types.UUID, actor *string, format models.ReportFormat) (models.ReportFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", id, actor, format)
+	ret0, _ := ret[0].(models.ReportFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockIReportsMockRecorder) GetReport(id, actor, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockIReports)(nil).GetReport), id, actor, format)
+}
+
+// SaveReportMail mocks base method.
+func (m *MockIReports) SaveReportMail(id types.UUID, actor *string, item models.PutReportMail) (models.ReportMail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReportMail", id, actor, item)
+	ret0, _ := ret[0].(models.ReportMail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveReportMail indicates an expected call of SaveReportMail.
+func (mr *MockIReportsMockRecorder) SaveReportMail(id, actor, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReportMail", reflect.TypeOf((*MockIReports)(nil).SaveReportMail), id, actor, item)
+}
+
+// SendReports mocks base method.
+func (m *MockIReports) SendReports() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendReports")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendReports indicates an expected call of SendReports.
+func (mr *MockIReportsMockRecorder) SendReports() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendReports", reflect.TypeOf((*MockIReports)(nil).SendReports))
+}
+
+// MockIWebhooks is a mock of IWebhooks interface.
+type MockIWebhooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhooksMockRecorder
+}
+
+// MockIWebhooksMockRecorder is the mock recorder for MockIWebhooks.
+type MockIWebhooksMockRecorder struct {
+	mock *MockIWebhooks
+}
+
+// NewMockIWebhooks creates a new mock instance.
+func NewMockIWebhooks(ctrl *gomock.Controller) *MockIWebhooks {
+	mock := &MockIWebhooks{ctrl: ctrl}
+	mock.recorder = &MockIWebhooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhooks) EXPECT() *MockIWebhooksMockRecorder {
+	return m.recorder
+}
+
+// ChangeWebhook mocks base method.
+func (m *MockIWebhooks) ChangeWebhook(id types.UUID, item models.PostWebhook, actor *string) (models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeWebhook", id, item, actor)
+	ret0, _ := ret[0].(models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeWebhook indicates an expected call of ChangeWebhook.
+func (mr *MockIWebhooksMockRecorder) ChangeWebhook(id, item, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeWebhook", reflect.TypeOf((*MockIWebhooks)(nil).ChangeWebhook), id, item, actor)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockIWebhooks) CreateWebhook(item models.PostWebhook, actor *string) (models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", item, actor)
+	ret0, _ := ret[0].(models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockIWebhooksMockRecorder) CreateWebhook(item, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockIWebhooks)(nil).CreateWebhook), item, actor)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockIWebhooks) DeleteWebhook(id types.UUID, actor *string) (api.SIdentifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", id, actor)
+	ret0, _ := ret[0].(api.SIdentifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockIWebhooksMockRecorder) DeleteWebhook(id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockIWebhooks)(nil).DeleteWebhook), id, actor)
+}
+
+// DeliverWebhooks mocks base method.
+func (m *MockIWebhooks) DeliverWebhooks() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverWebhooks")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeliverWebhooks indicates an expected call of DeliverWebhooks.
+func (mr *MockIWebhooksMockRecorder) DeliverWebhooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverWebhooks", reflect.TypeOf((*MockIWebhooks)(nil).DeliverWebhooks))
+}
+
+// GetDeliveries mocks base method.
+func (m *MockIWebhooks) GetDeliveries(id types.UUID, actor *string, limit int) ([]models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", id, actor, limit)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockIWebhooksMockRecorder) GetDeliveries(id, actor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockIWebhooks)(nil).GetDeliveries), id, actor, limit)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockIWebhooks) GetWebhooks(actor *string) ([]models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", actor)
+	ret0, _ := ret[0].([]models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockIWebhooksMockRecorder) GetWebhooks(actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockIWebhooks)(nil).GetWebhooks), actor)
+}
+
+// MockIFiles is a mock of IFiles interface.
+type MockIFiles struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFilesMockRecorder
+}
+
+// MockIFilesMockRecorder is the mock recorder for MockIFiles.
+type MockIFilesMockRecorder struct {
+	mock *MockIFiles
+}
+
+// NewMockIFiles creates a new mock instance.
+func NewMockIFiles(ctrl *gomock.Controller) *MockIFiles {
+	mock := &MockIFiles{ctrl: ctrl}
+	mock.recorder = &MockIFilesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFiles) EXPECT() *MockIFilesMockRecorder {
+	return m.recorder
+}
+
+// CreateFile mocks base method.
+func (m *MockIFiles) CreateFile(item models.File, actor *string) (models.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFile", item, actor)
+	ret0, _ := ret[0].(models.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFile indicates an expected call of CreateFile.
+func (mr *MockIFilesMockRecorder) CreateFile(item, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFile", reflect.TypeOf((*MockIFiles)(nil).CreateFile), item, actor)
+}
+
+// DeleteFile mocks base method.
+func (m *MockIFiles) DeleteFile(id types.UUID, actor *string) (api.SIdentifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", id, actor)
+	ret0, _ := ret[0].(api.SIdentifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFile indicates an expected call of DeleteFile.
+func (mr *MockIFilesMockRecorder) DeleteFile(id, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockIFiles)(nil).DeleteFile), id, actor)
+}
+
+// GetFileById mocks base method.
+func (m *MockIFiles) GetFileById(id types.UUID, viewer *string) (models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileById", id, viewer)
+	ret0, _ := ret[0].(models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileById indicates an expected call of GetFileById.
+func (mr *MockIFilesMockRecorder) GetFileById(id, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileById", reflect.TypeOf((*MockIFiles)(nil).GetFileById), id, viewer)
+}
+
+// GetFiles mocks base method.
+func (m *MockIFiles) GetFiles(broadcastId types.UUID, viewer *string) ([]models.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFiles", broadcastId, viewer)
+	ret0, _ := ret[0].([]models.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFiles indicates an expected call of GetFiles.
+func (mr *MockIFilesMockRecorder) GetFiles(broadcastId, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFiles", reflect.TypeOf((*MockIFiles)(nil).GetFiles), broadcastId, viewer)
+}
+
+// UploadLimit mocks base method.
+func (m *MockIFiles) UploadLimit(broadcastId types.UUID, actor *string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadLimit", broadcastId, actor)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadLimit indicates an expected call of UploadLimit.
+func (mr *MockIFilesMockRecorder) UploadLimit(broadcastId, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadLimit", reflect.TypeOf((*MockIFiles)(nil).UploadLimit), broadcastId, actor)
+}
@@ -1,25 +1,153 @@
 package service
 
 import (
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
+
 	"github.com/alexm24/golang/internal/models"
 	"github.com/alexm24/golang/internal/transport"
 )
 
 type ParticipantsService struct {
-	participantsPostgres transport.IParticipantsPostgres
-	participantsRedis    transport.IParticipantsRedis
+	participantsPostgres  transport.IParticipantsPostgres
+	participantsRedis     transport.IParticipantsRedis
+	registrationsPostgres transport.IRegistrationsPostgres
+	broadcastsPostgres    transport.IBroadcastsPostgres
+	centrifugo            transport.ICentrifugo
+	mail                  transport.IMail
+	accessPostgres        transport.IAccessPostgres
+	webhooksPostgres      transport.IWebhooksPostgres
+	watchUrl              string
 }
 
 func NewParticipantsService(
 	participantsPostgres transport.IParticipantsPostgres,
-	participantsRedis transport.IParticipantsRedis) *ParticipantsService {
-	return &ParticipantsService{participantsPostgres, participantsRedis}
+	participantsRedis transport.IParticipantsRedis,
+	registrationsPostgres transport.IRegistrationsPostgres,
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	centrifugo transport.ICentrifugo,
+	mail transport.IMail,
+	accessPostgres transport.IAccessPostgres,
+	webhooksPostgres transport.IWebhooksPostgres,
+	watchUrl string) *ParticipantsService {
+	return &ParticipantsService{participantsPostgres, participantsRedis, registrationsPostgres, broadcastsPostgres,
+		centrifugo, mail, accessPostgres, webhooksPostgres, watchUrl}
 }
 
-func (p *ParticipantsService) GetParticipants(channel string) ([]models.Participant, error) {
+func (p *ParticipantsService) GetParticipants(channel string, viewer *string) ([]models.Participant, error) {
+	if err := checkChannelAccess(p.broadcastsPostgres, p.accessPostgres, channel, viewer); err != nil {
+		return nil, err
+	}
 	return p.participantsPostgres.GetParticipants(channel)
 }
 
-func (p *ParticipantsService) CreateParticipant(channel string, user models.PostParticipant) error {
-	return p.participantsRedis.CreateParticipant(channel, user)
+// CreateParticipant registers the user on broadcast channels, other channels
+// only track the user and return an empty registration.
+func (p *ParticipantsService) CreateParticipant(channel string, user models.PostParticipant) (models.Registration, error) {
+	if err := checkChannelAccess(p.broadcastsPostgres, p.accessPostgres, channel, user.Username); err != nil {
+		return models.Registration{}, err
+	}
+	if err := p.participantsRedis.CreateParticipant(channel, user); err != nil {
+		return models.Registration{}, err
+	}
+
+	broadcastId, err := uuid.Parse(channel)
+	if err != nil {
+		return models.Registration{}, nil
+	}
+
+	item, created, err := p.registrationsPostgres.Register(broadcastId, user)
+	if err != nil || !created {
+		return item, err
+	}
+	if err = p.webhooksPostgres.EnqueueEvent(models.NewRegistrationEvent(item)); err != nil {
+		return item, err
+	}
+
+	return item, p.publishCounts(broadcastId)
+}
+
+// DeleteParticipant cancels the registration, the user promoted from the
+// waitlist is notified by mail.
+func (p *ParticipantsService) DeleteParticipant(channel string, username string) (models.Registration, error) {
+	broadcastId, err := uuid.Parse(channel)
+	if err != nil {
+		return models.Registration{}, models.ErrNotRegistered
+	}
+
+	removed, promoted, err := p.registrationsPostgres.Unregister(broadcastId, username)
+	if err != nil {
+		return removed, err
+	}
+	if removed.Id == nil {
+		return removed, models.ErrNotRegistered
+	}
+
+	if err = p.participantsRedis.DeleteParticipant(channel, username); err != nil {
+		return removed, err
+	}
+
+	if promoted.Id != nil {
+		if err = p.notifyPromoted(broadcastId, promoted); err != nil {
+			return removed, err
+		}
+	}
+
+	return removed, p.publishCounts(broadcastId)
+}
+
+// PromoteWaitlist fills the seats freed by a changed capacity from the waitlist,
+// notifies the promoted users and publishes the new counts.
+func (p *ParticipantsService) PromoteWaitlist(broadcastId types.UUID) error {
+	promoted, err := p.registrationsPostgres.PromoteWaitlist(broadcastId)
+	if err != nil {
+		return err
+	}
+	if err = p.notifyPromoted(broadcastId, promoted...); err != nil {
+		return err
+	}
+	return p.publishCounts(broadcastId)
+}
+
+// notifyPromoted mails the users confirmed from the waitlist.
+func (p *ParticipantsService) notifyPromoted(broadcastId types.UUID, promoted ...models.Registration) error {
+	if len(promoted) == 0 {
+		return nil
+	}
+	broadcast, err := p.broadcastsPostgres.GetBroadcastById(broadcastId)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range promoted {
+		if r.Email == nil {
+			continue
+		}
+		promotion := models.Promotion{
+			Email: *r.Email,
+			Url:   strings.TrimSuffix(p.watchUrl, "/") + "/" + broadcastId.String(),
+		}
+		if r.Fullname != nil {
+			promotion.Fullname = *r.Fullname
+		}
+		if broadcast.Name != nil {
+			promotion.Name = *broadcast.Name
+		}
+		if err = p.mail.SendPromotion(promotion); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *ParticipantsService) publishCounts(broadcastId types.UUID) error {
+	counts, err := p.registrationsPostgres.GetRegistrationCounts(broadcastId)
+	if err != nil {
+		return err
+	}
+
+	msg := models.ActionCentrifugo{Type: models.ActionRegistrations, Payload: counts}
+	return p.centrifugo.Publish(broadcastId.String(), msg)
 }
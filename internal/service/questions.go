@@ -0,0 +1,160 @@
+package service
+
+import (
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type QuestionsService struct {
+	questionsPostgres  transport.IQuestionsPostgres
+	centrifugo         transport.ICentrifugo
+	broadcastsPostgres transport.IBroadcastsPostgres
+	accessPostgres     transport.IAccessPostgres
+}
+
+func NewQuestionsService(
+	questionsPostgres transport.IQuestionsPostgres,
+	centrifugo transport.ICentrifugo,
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	accessPostgres transport.IAccessPostgres) *QuestionsService {
+	return &QuestionsService{questionsPostgres, centrifugo, broadcastsPostgres, accessPostgres}
+}
+
+// GetQuestions returns questions in any state to moderators, others see the
+// public ones only.
+func (q *QuestionsService) GetQuestions(channel string, states []models.QuestionState, viewer *string) ([]models.Question, error) {
+	if err := checkChannelAccess(q.broadcastsPostgres, q.accessPostgres, channel, viewer); err != nil {
+		return nil, err
+	}
+	ok, err := isModerator(q.broadcastsPostgres, channel, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if states = models.PublicStates(states); len(states) == 0 {
+			return make([]models.Question, 0), nil
+		}
+	}
+	return q.questionsPostgres.GetQuestions(channel, states)
+}
+
+func (q *QuestionsService) PatchQuestion(channel string, id types.UUID, item models.PatchQuestion, actor *string) (models.Question, error) {
+	current, err := q.moderateQuestion(channel, id, actor)
+	if err != nil {
+		return models.Question{}, err
+	}
+	state, err := models.ParseQuestionState(*item.State)
+	if err != nil {
+		return models.Question{}, err
+	}
+	if err = q.questionsPostgres.SetQuestionState(id, state); err != nil {
+		return models.Question{}, err
+	}
+	return q.publish(channel, current, models.ActionQuestionState)
+}
+
+func (q *QuestionsService) VoteQuestion(channel string, id types.UUID, actor string) (models.Question, error) {
+	item, err := q.getQuestion(channel, id, &actor)
+	if err != nil {
+		return item, err
+	}
+	if !item.InState(models.QuestionApproved) {
+		return models.Question{}, models.ErrQuestionNotApproved
+	}
+	if err = q.questionsPostgres.Vote(id, actor); err != nil {
+		return models.Question{}, err
+	}
+	return q.publish(channel, item, models.ActionQuestionVotes)
+}
+
+func (q *QuestionsService) UnvoteQuestion(channel string, id types.UUID, actor string) (models.Question, error) {
+	item, err := q.getQuestion(channel, id, &actor)
+	if err != nil {
+		return item, err
+	}
+	if err = q.questionsPostgres.Unvote(id, actor); err != nil {
+		return models.Question{}, err
+	}
+	return q.publish(channel, item, models.ActionQuestionVotes)
+}
+
+// PinQuestion pins the question being answered now, the previously pinned
+// question of the channel is unpinned.
+func (q *QuestionsService) PinQuestion(channel string, id types.UUID, actor *string) (models.Question, error) {
+	item, err := q.moderateQuestion(channel, id, actor)
+	if err != nil {
+		return item, err
+	}
+	if item.InState(models.QuestionDismissed) {
+		return models.Question{}, models.ErrQuestionDismissed
+	}
+	if err = q.questionsPostgres.PinQuestion(channel, id); err != nil {
+		return models.Question{}, err
+	}
+	return q.publish(channel, item, models.ActionQuestionPin)
+}
+
+func (q *QuestionsService) UnpinQuestion(channel string, id types.UUID, actor *string) (models.Question, error) {
+	item, err := q.moderateQuestion(channel, id, actor)
+	if err != nil {
+		return item, err
+	}
+	if err = q.questionsPostgres.UnpinQuestion(id); err != nil {
+		return models.Question{}, err
+	}
+	return q.publish(channel, item, models.ActionQuestionPin)
+}
+
+// getQuestion returns ErrQuestionNotFound for questions of other channels too.
+func (q *QuestionsService) getQuestion(channel string, id types.UUID, viewer *string) (models.Question, error) {
+	if err := checkChannelAccess(q.broadcastsPostgres, q.accessPostgres, channel, viewer); err != nil {
+		return models.Question{}, err
+	}
+	item, err := q.questionsPostgres.GetQuestionById(id)
+	if err != nil {
+		return item, err
+	}
+	if item.Id == nil || item.Channel != channel {
+		return models.Question{}, models.ErrQuestionNotFound
+	}
+	return item, nil
+}
+
+func (q *QuestionsService) moderateQuestion(channel string, id types.UUID, actor *string) (models.Question, error) {
+	item, err := q.getQuestion(channel, id, actor)
+	if err != nil {
+		return item, err
+	}
+	ok, err := isModerator(q.broadcastsPostgres, channel, actor)
+	if err != nil {
+		return models.Question{}, err
+	}
+	if !ok {
+		return models.Question{}, models.ErrNotChatModerator
+	}
+	return item, nil
+}
+
+// publish sends the changed question to the channel when it is public and to
+// the moderators channel otherwise, viewers are told to drop a question which
+// was public before the change.
+func (q *QuestionsService) publish(channel string, before models.Question, action string) (models.Question, error) {
+	item, err := q.questionsPostgres.GetQuestionById(*before.Id)
+	if err != nil {
+		return item, err
+	}
+	msg := models.ActionCentrifugo{Type: action, Payload: item}
+	if item.Public() {
+		return item, q.centrifugo.Publish(channel, msg)
+	}
+
+	if err = q.centrifugo.Publish(models.ModeratorsChannel(channel), msg); err != nil {
+		return item, err
+	}
+	if before.Public() {
+		err = q.centrifugo.Publish(channel, models.ActionCentrifugo{Type: models.ActionChatDelete, Payload: item.SIdentifier})
+	}
+	return item, err
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+// statePostgres keeps the state of a single question of the channel.
+type statePostgres struct {
+	transport.IQuestionsPostgres
+	channel string
+	id      types.UUID
+	state   models.QuestionState
+}
+
+func (s *statePostgres) GetQuestionById(id types.UUID) (models.Question, error) {
+	var item models.Question
+	if id != s.id {
+		return item, nil
+	}
+	state := string(s.state)
+	item.Id, item.Channel, item.QuestionState = &s.id, s.channel, &state
+	return item, nil
+}
+
+func (s *statePostgres) SetQuestionState(id types.UUID, state models.QuestionState) error {
+	s.state = state
+	return nil
+}
+
+type adminPostgres struct {
+	transport.IBroadcastsPostgres
+}
+
+func (a *adminPostgres) CheckAdminUser(username api.SUsername) (bool, error) {
+	return true, nil
+}
+
+// publishedCentrifugo records the channels and types of published messages.
+type publishedCentrifugo struct {
+	transport.ICentrifugo
+	published []string
+}
+
+func (p *publishedCentrifugo) Publish(channel string, msg interface{}) error {
+	p.published = append(p.published, channel+" "+msg.(models.ActionCentrifugo).Type)
+	return nil
+}
+
+func TestService_PatchQuestion(t *testing.T) {
+	channel, moderator := "chat", "admin"
+	moderators := models.ModeratorsChannel(channel)
+
+	testTable := []struct {
+		name      string
+		from, to  models.QuestionState
+		published []string
+	}{
+		{
+			name: "Approved",
+			from: models.QuestionPending,
+			to:   models.QuestionApproved,
+			published: []string{
+				channel + " " + models.ActionQuestionState,
+			},
+		},
+		{
+			name: "Dismissed pending",
+			from: models.QuestionPending,
+			to:   models.QuestionDismissed,
+			published: []string{
+				moderators + " " + models.ActionQuestionState,
+			},
+		},
+		{
+			name: "Dismissed approved",
+			from: models.QuestionApproved,
+			to:   models.QuestionDismissed,
+			published: []string{
+				moderators + " " + models.ActionQuestionState,
+				channel + " " + models.ActionChatDelete,
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			id := uuid.New()
+			centrifugo := &publishedCentrifugo{}
+			q := NewQuestionsService(&statePostgres{channel: channel, id: id, state: testCase.from},
+				centrifugo, &adminPostgres{}, nil)
+
+			state := string(testCase.to)
+			item, err := q.PatchQuestion(channel, id, models.PatchQuestion{State: &state}, &moderator)
+
+			assert.NoError(t, err)
+			assert.Equal(t, &state, item.QuestionState)
+			assert.Equal(t, testCase.published, centrifugo.published)
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"path"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type RecordingsService struct {
+	recordingsPostgres transport.IRecordingsPostgres
+	broadcastsPostgres transport.IBroadcastsPostgres
+	accessPostgres     transport.IAccessPostgres
+	storage            transport.IStorage
+	cfg                models.RecordingsConfig
+}
+
+func NewRecordingsService(
+	recordingsPostgres transport.IRecordingsPostgres,
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	accessPostgres transport.IAccessPostgres,
+	storage transport.IStorage,
+	cfg models.RecordingsConfig) *RecordingsService {
+	return &RecordingsService{recordingsPostgres, broadcastsPostgres, accessPostgres, storage, cfg}
+}
+
+// GetRecordings returns ErrNoAccess when the viewer can not see the broadcast.
+func (r *RecordingsService) GetRecordings(broadcastId types.UUID, viewer *string) ([]models.Recording, error) {
+	broadcast, err := r.broadcastsPostgres.GetBroadcastById(broadcastId)
+	if err != nil {
+		return nil, err
+	}
+	if broadcast.Id == nil {
+		return nil, models.ErrBroadcastNotFound
+	}
+
+	ok, err := canView(r.broadcastsPostgres, r.accessPostgres, broadcast, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, models.ErrNoAccess
+	}
+
+	items, err := r.recordingsPostgres.GetRecordings([]types.UUID{broadcastId})
+	if err != nil {
+		return nil, err
+	}
+	signRecordings(r.cfg, items)
+	return items, nil
+}
+
+func (r *RecordingsService) CreateRecording(
+	broadcastId types.UUID, item models.PostRecording, actor *string) (models.Recording, error) {
+	if _, err := manageBroadcast(r.broadcastsPostgres, broadcastId, actor); err != nil {
+		return models.Recording{}, err
+	}
+
+	recording, err := r.recordingsPostgres.CreateRecording(item.Recording(broadcastId, actor))
+	if err != nil {
+		return recording, err
+	}
+	recording.Sign(r.cfg.PlayUrl, r.cfg.Secret, playExpires(r.cfg))
+	return recording, nil
+}
+
+// UploadLimit checks that the actor manages the broadcast before the upload is
+// read and returns the largest recording size accepted.
+func (r *RecordingsService) UploadLimit(broadcastId types.UUID, actor *string) (int64, error) {
+	if _, err := manageBroadcast(r.broadcastsPostgres, broadcastId, actor); err != nil {
+		return 0, err
+	}
+	return r.cfg.MaxSize, nil
+}
+
+// UploadRecording saves the file to the storage, it is removed again when the
+// recording can not be created.
+func (r *RecordingsService) UploadRecording(
+	broadcastId types.UUID, item models.RecordingUpload, actor *string) (models.Recording, error) {
+	if _, err := manageBroadcast(r.broadcastsPostgres, broadcastId, actor); err != nil {
+		return models.Recording{}, err
+	}
+
+	format := models.RecordingFormat(item.Name)
+	filePath, size, err := r.storage.Save(uuid.New().String()+"."+format, item.Content, r.cfg.MaxSize)
+	if err != nil {
+		return models.Recording{}, err
+	}
+
+	var recording models.Recording
+	recording.BroadcastId = &broadcastId
+	recording.Path = &filePath
+	recording.Duration = item.Duration
+	recording.Size = &size
+	recording.Format = &format
+	recording.CreatedBy = actor
+
+	recording, err = r.recordingsPostgres.CreateRecording(recording)
+	if err != nil {
+		_ = r.storage.Remove(filePath)
+		return recording, err
+	}
+	recording.Sign(r.cfg.PlayUrl, r.cfg.Secret, playExpires(r.cfg))
+	return recording, nil
+}
+
+func (r *RecordingsService) DeleteRecording(id types.UUID, actor *string) (api.SIdentifier, error) {
+	recording, err := r.recordingsPostgres.GetRecordingById(id)
+	if err != nil {
+		return api.SIdentifier{}, err
+	}
+	if recording.Id == nil {
+		return api.SIdentifier{}, models.ErrRecordingNotFound
+	}
+	if _, err = manageBroadcast(r.broadcastsPostgres, *recording.BroadcastId, actor); err != nil {
+		return api.SIdentifier{}, err
+	}
+
+	item, err := r.recordingsPostgres.DeleteRecording(id)
+	if err != nil {
+		return item, err
+	}
+	if recording.Path != nil {
+		if err = r.storage.Remove(*recording.Path); err != nil {
+			return item, err
+		}
+	}
+	return item, nil
+}
+
+// PlayRecording checks the signature of the play url and returns the file of
+// the recording or the url it is hosted at.
+func (r *RecordingsService) PlayRecording(id types.UUID, expires int64, signature string) (models.RecordingMedia, error) {
+	if err := models.CheckRecordingSignature(r.cfg.Secret, id, expires, signature, time.Now()); err != nil {
+		return models.RecordingMedia{}, err
+	}
+
+	recording, err := r.recordingsPostgres.GetRecordingById(id)
+	if err != nil {
+		return models.RecordingMedia{}, err
+	}
+	if recording.Id == nil {
+		return models.RecordingMedia{}, models.ErrRecordingNotFound
+	}
+	if recording.Url != nil {
+		return models.RecordingMedia{Url: *recording.Url}, nil
+	}
+
+	content, modTime, err := r.storage.Open(*recording.Path)
+	if err != nil {
+		return models.RecordingMedia{}, err
+	}
+	if content == nil {
+		return models.RecordingMedia{}, models.ErrRecordingNotFound
+	}
+	return models.RecordingMedia{
+		Name:        path.Base(*recording.Path),
+		ContentType: models.RecordingContentType(recording.Format),
+		ModTime:     modTime,
+		Content:     content,
+	}, nil
+}
+
+// WatchRecording returns a play url of the recording signed now, ErrNoAccess
+// when the viewer can not see its broadcast.
+func (r *RecordingsService) WatchRecording(id types.UUID, viewer *string) (string, error) {
+	recording, err := r.recordingsPostgres.GetRecordingById(id)
+	if err != nil {
+		return "", err
+	}
+	if recording.Id == nil {
+		return "", models.ErrRecordingNotFound
+	}
+	if _, err = viewBroadcast(r.broadcastsPostgres, r.accessPostgres, *recording.BroadcastId, viewer); err != nil {
+		return "", err
+	}
+	return models.RecordingPlayUrl(r.cfg.PlayUrl, r.cfg.Secret, id, playExpires(r.cfg)), nil
+}
+
+// playExpires is the time play urls signed now expire at.
+func playExpires(cfg models.RecordingsConfig) time.Time {
+	return time.Now().Add(cfg.Ttl).Truncate(time.Second)
+}
+
+func signRecordings(cfg models.RecordingsConfig, items []models.Recording) {
+	expires := playExpires(cfg)
+	for i := range items {
+		items[i].Sign(cfg.PlayUrl, cfg.Secret, expires)
+	}
+}
+
+// attachRecordings adds signed recordings to the broadcasts.
+func attachRecordings(recordingsPostgres transport.IRecordingsPostgres, cfg models.RecordingsConfig,
+	items []models.Broadcasts) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	ids := make([]types.UUID, len(items))
+	for i, item := range items {
+		ids[i] = *item.Id
+	}
+	recordings, err := recordingsPostgres.GetRecordings(ids)
+	if err != nil {
+		return err
+	}
+	signRecordings(cfg, recordings)
+
+	byBroadcast := make(map[types.UUID][]models.Recording)
+	for _, recording := range recordings {
+		byBroadcast[*recording.BroadcastId] = append(byBroadcast[*recording.BroadcastId], recording)
+	}
+	for i := range items {
+		items[i].Recordings = byBroadcast[*items[i].Id]
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type ReportsService struct {
+	reportsPostgres       transport.IReportsPostgres
+	analyticsPostgres     transport.IAnalyticsPostgres
+	analyticsRedis        transport.IAnalyticsRedis
+	registrationsPostgres transport.IRegistrationsPostgres
+	participantsPostgres  transport.IParticipantsPostgres
+	messagesPostgres      transport.IMessagesPostgres
+	broadcastsPostgres    transport.IBroadcastsPostgres
+	mail                  transport.IMail
+}
+
+func NewReportsService(
+	reportsPostgres transport.IReportsPostgres,
+	analyticsPostgres transport.IAnalyticsPostgres,
+	analyticsRedis transport.IAnalyticsRedis,
+	registrationsPostgres transport.IRegistrationsPostgres,
+	participantsPostgres transport.IParticipantsPostgres,
+	messagesPostgres transport.IMessagesPostgres,
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	mail transport.IMail) *ReportsService {
+	return &ReportsService{reportsPostgres, analyticsPostgres, analyticsRedis, registrationsPostgres,
+		participantsPostgres, messagesPostgres, broadcastsPostgres, mail}
+}
+
+func (r *ReportsService) GetReport(id types.UUID, actor *string, format models.ReportFormat) (models.ReportFile, error) {
+	broadcast, err := manageBroadcast(r.broadcastsPostgres, id, actor)
+	if err != nil {
+		return models.ReportFile{}, err
+	}
+
+	report, err := r.report(broadcast)
+	if err != nil {
+		return models.ReportFile{}, err
+	}
+	return report.File(format)
+}
+
+func (r *ReportsService) SaveReportMail(id types.UUID, actor *string, item models.PutReportMail) (models.ReportMail, error) {
+	if _, err := manageBroadcast(r.broadcastsPostgres, id, actor); err != nil {
+		return models.ReportMail{}, err
+	}
+	return r.reportsPostgres.SaveReportMail(id, strings.TrimSpace(*item.Email))
+}
+
+func (r *ReportsService) DeleteReportMail(id types.UUID, actor *string) (models.ReportMail, error) {
+	if _, err := manageBroadcast(r.broadcastsPostgres, id, actor); err != nil {
+		return models.ReportMail{}, err
+	}
+
+	item, err := r.reportsPostgres.DeleteReportMail(id)
+	if err != nil {
+		return item, err
+	}
+	if item.Email == nil {
+		return item, models.ErrReportMailNotFound
+	}
+	return item, nil
+}
+
+// SendReports mails XLSX reports of broadcasts that moved to past. A failed
+// mail does not stop the others and is retried on the next run.
+func (r *ReportsService) SendReports() error {
+	items, err := r.reportsPostgres.GetPendingReportMails()
+	if err != nil {
+		return err
+	}
+
+	var first error
+	for _, item := range items {
+		if err = r.sendReport(item); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func (r *ReportsService) sendReport(item models.ReportMail) error {
+	broadcast, err := r.broadcastsPostgres.GetBroadcastById(item.BroadcastId)
+	if err != nil || broadcast.Id == nil {
+		return err
+	}
+
+	report, err := r.report(broadcast)
+	if err != nil {
+		return err
+	}
+	file, err := report.File(models.ReportXLSX)
+	if err != nil {
+		return err
+	}
+
+	letter := models.ReportLetter{Email: *item.Email, File: file}
+	if broadcast.Name != nil {
+		letter.Name = *broadcast.Name
+	}
+	if err = r.mail.SendReport(letter); err != nil {
+		return err
+	}
+	return r.reportsPostgres.SetReportMailSent(item.BroadcastId, time.Now())
+}
+
+func (r *ReportsService) report(broadcast models.Broadcasts) (models.Report, error) {
+	report := models.Report{Broadcast: broadcast}
+	channel := broadcast.Id.String()
+
+	var err error
+	report.Analytics, err = broadcastAnalytics(r.analyticsPostgres, r.analyticsRedis, r.registrationsPostgres, *broadcast.Id)
+	if err != nil {
+		return report, err
+	}
+
+	if report.Participants, err = r.participantsPostgres.GetParticipants(channel); err != nil {
+		return report, err
+	}
+
+	messages, err := r.messagesPostgres.GetMessageByChannel(channel)
+	if err != nil {
+		return report, err
+	}
+	for _, msg := range messages {
+		if msg.IsQuestion != nil && *msg.IsQuestion {
+			report.Questions = append(report.Questions, msg)
+		}
+	}
+	return report, nil
+}
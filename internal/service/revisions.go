@@ -0,0 +1,79 @@
+package service
+
+import (
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type RevisionsService struct {
+	broadcasts        *BroadcastsService
+	revisionsPostgres transport.IRevisionsPostgres
+}
+
+func NewRevisionsService(
+	broadcasts *BroadcastsService,
+	revisionsPostgres transport.IRevisionsPostgres) *RevisionsService {
+	return &RevisionsService{broadcasts, revisionsPostgres}
+}
+
+func (r *RevisionsService) GetRevisions(broadcastId types.UUID, viewer *string) ([]models.Revision, error) {
+	if _, err := viewBroadcast(r.broadcasts.broadcastsPostgres, r.broadcasts.accessPostgres, broadcastId, viewer); err != nil {
+		return nil, err
+	}
+	return r.revisionsPostgres.GetRevisions(broadcastId)
+}
+
+// RevertRevision applies name, description, time and tags stored in the revision
+// like any other change of the broadcast: with the version and conflict checks,
+// a new revision and the broadcast.changed webhook.
+func (r *RevisionsService) RevertRevision(id types.UUID, revisionId types.UUID, username api.SUsername,
+	version *int, force bool) (models.Broadcasts, error) {
+	revision, err := r.revisionsPostgres.GetRevisionById(revisionId)
+	if err != nil {
+		return models.Broadcasts{}, err
+	}
+	if revision.BroadcastId != id {
+		return models.Broadcasts{}, models.ErrRevisionNotFound
+	}
+
+	current, err := manageBroadcast(r.broadcasts.broadcastsPostgres, id, username.Username)
+	if err != nil {
+		return models.Broadcasts{}, err
+	}
+	if version != nil && current.Version != *version {
+		return current, models.ErrVersionMismatch
+	}
+
+	snapshot := revision.Snapshot
+	tags := []string(snapshot.Tags)
+	item := models.PutBroadcast{
+		Id:          &id,
+		Name:        snapshot.Name,
+		Description: snapshot.Description,
+		StartTime:   snapshot.StartTime,
+		EndTime:     snapshot.EndTime,
+		Tags:        &tags,
+	}
+	if !sameTime(item.StartTime, current.StartTime) || !sameTime(item.EndTime, current.EndTime) {
+		slots, exclude := changeSlots(item, current, nil, 0, nil, r.broadcasts.duration)
+		if err = r.broadcasts.checkConflicts(slots, exclude, username.Username, force); err != nil {
+			return models.Broadcasts{}, err
+		}
+	}
+
+	return r.broadcasts.changeBroadcast(item, current, username.Username, version, models.RevisionRevert)
+}
+
+// saveRevision records the change of a broadcast, changes of broadcasts
+// which were not found are skipped.
+func saveRevision(revisionsPostgres transport.IRevisionsPostgres,
+	action models.RevisionAction, actor *string, before, after models.Broadcasts) error {
+	if after.Id == nil {
+		return nil
+	}
+	_, err := revisionsPostgres.CreateRevision(models.NewRevision(action, actor, before, after))
+	return err
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type SearchService struct {
+	searchPostgres     transport.ISearchPostgres
+	broadcastsPostgres transport.IBroadcastsPostgres
+}
+
+func NewSearchService(searchPostgres transport.ISearchPostgres, broadcastsPostgres transport.IBroadcastsPostgres) *SearchService {
+	return &SearchService{searchPostgres, broadcastsPostgres}
+}
+
+func (s *SearchService) Search(q models.SearchQuery) (models.Search, error) {
+	var res models.Search
+
+	if q.Username != nil {
+		isAdmin, err := s.broadcastsPostgres.CheckAdminUser(api.SUsername{Username: q.Username})
+		if err != nil {
+			return res, err
+		}
+		q.IsAdmin = isAdmin
+	}
+
+	broadcasts, err := s.searchPostgres.SearchBroadcasts(q)
+	if err != nil {
+		return res, err
+	}
+
+	messages, err := s.searchPostgres.SearchMessages(q)
+	if err != nil {
+		return res, err
+	}
+
+	return models.Search{Broadcasts: broadcasts, Messages: messages}, nil
+}
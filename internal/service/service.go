@@ -1,6 +1,8 @@
 package service
 
 import (
+	"time"
+
 	"github.com/deepmap/oapi-codegen/pkg/types"
 
 	"github.com/alexm24/golang/internal/handler/api"
@@ -13,33 +15,71 @@ import (
 type IAdmin interface {
 	CheckAdminUser(username api.SUsername) (api.SAdmin, error)
 	GetToken(user api.SUsername) (api.SToken, error)
+	GetChannelToken(user api.SUsername, channel string) (api.SToken, error)
 }
 
 type IBroadcasts interface {
-	CreateBroadcast(item models.PostBroadcast) (models.Broadcasts, error)
-	ChangeBroadcast(item models.PutBroadcast) (models.Broadcasts, error)
-	GetBroadcasts() ([]models.Broadcasts, error)
-	GetBroadcastById(id types.UUID) (models.Broadcasts, error)
-	DeleteBroadcast(id types.UUID) (api.SIdentifier, error)
-	GetArchBroadcasts(username api.SUsername) ([]models.Broadcasts, error)
+	CreateBroadcast(item models.PostBroadcast, actor *string, force bool) (models.Broadcasts, error)
+	ChangeBroadcast(item models.PutBroadcast, scope models.SeriesScope,
+		actor *string, version *int, force bool) (models.Broadcasts, error)
+	PatchBroadcast(id types.UUID, patch models.PatchBroadcast,
+		actor *string, version *int, force bool) (models.Broadcasts, error)
+	GetBroadcasts(filter models.BroadcastFilter) (models.BroadcastsPage, error)
+	GetBroadcastById(id types.UUID, viewer *string) (models.Broadcasts, error)
+	GetSeriesById(id types.UUID, viewer *string) ([]models.Broadcasts, error)
+	DeleteBroadcast(id types.UUID, scope models.SeriesScope, deletedBy *string) (api.SIdentifier, error)
+	GetArchBroadcasts(username api.SUsername, filter models.BroadcastFilter) (models.BroadcastsPage, error)
+	RotateStreamKey(id types.UUID, username api.SUsername) (models.Broadcasts, error)
+}
+
+type ITrash interface {
+	GetTrash(username api.SUsername) ([]models.Broadcasts, error)
+	RestoreBroadcast(id types.UUID, username api.SUsername) (models.Broadcasts, error)
+	PurgeTrash() error
+}
+
+type IRevisions interface {
+	GetRevisions(broadcastId types.UUID, viewer *string) ([]models.Revision, error)
+	RevertRevision(id types.UUID, revisionId types.UUID, username api.SUsername, version *int, force bool) (models.Broadcasts, error)
+}
+
+type ILifeCycle interface {
+	UpdateLifeCycle() error
+	ChangeLifeCycle(id types.UUID, life models.LifeCycleBroadcast) (models.Broadcasts, error)
 }
 
 type IParticipants interface {
-	CreateParticipant(channel string, user models.PostParticipant) error
-	GetParticipants(channel string) ([]models.Participant, error)
+	CreateParticipant(channel string, user models.PostParticipant) (models.Registration, error)
+	DeleteParticipant(channel string, username string) (models.Registration, error)
+	GetParticipants(channel string, viewer *string) ([]models.Participant, error)
+	PromoteWaitlist(broadcastId types.UUID) error
 }
 
 type IMessages interface {
-	GetMessageByChannel(channel string) ([]models.Messages, error)
+	GetMessageByChannel(filter models.MessageFilter, viewer *string) (models.MessagesPage, error)
 	CreateMsg(channel string, msg models.PostMessage) (models.Messages, error)
+	EditMsg(channel string, id types.UUID, item models.EditMessage, actor *string) (models.Messages, error)
+	DeleteMsg(channel string, id types.UUID, actor *string) (api.SIdentifier, error)
+	GetMsgEdits(channel string, id types.UUID, viewer *string) ([]models.MessageEdit, error)
+	GetMsgReplies(channel string, id types.UUID, viewer *string) ([]models.Messages, error)
 	CreateReaction(channel string, item models.PostReactionMsg) error
 	DeleteReaction(channel string, item models.PatchReactionMsg) error
 }
 
+type IQuestions interface {
+	GetQuestions(channel string, states []models.QuestionState, viewer *string) ([]models.Question, error)
+	PatchQuestion(channel string, id types.UUID, item models.PatchQuestion, actor *string) (models.Question, error)
+	VoteQuestion(channel string, id types.UUID, actor string) (models.Question, error)
+	UnvoteQuestion(channel string, id types.UUID, actor string) (models.Question, error)
+	PinQuestion(channel string, id types.UUID, actor *string) (models.Question, error)
+	UnpinQuestion(channel string, id types.UUID, actor *string) (models.Question, error)
+}
+
 type IStream interface {
 	CreateStream(username api.SUsername) (models.Stream, error)
 	GetStream(username string) (models.Stream, error)
-	ChangeDescByUsername(stream models.PutStream) (models.Stream, error)
+	ChangeDescByUsername(stream models.PutStream, version *int) (models.Stream, error)
+	RotateStreamKey(username string, actor api.SUsername) (models.Stream, error)
 	ClearChat(channel string) error
 }
 
@@ -49,9 +89,9 @@ type ILive interface {
 }
 
 type IImages interface {
-	CreateImage(id string, file []byte) (models.ResImage, error)
+	CreateImage(id string, file []byte, actor *string) (models.ResImage, error)
 	GetImageById(id types.UUID) ([]uint8, error)
-	DelImageById(id types.UUID) (api.SIdentifier, error)
+	DelImageById(id types.UUID, actor *string) (api.SIdentifier, error)
 }
 
 type IZoom interface {
@@ -62,26 +102,137 @@ type IZoom interface {
 	SendMail(item models.Zoom) error
 }
 
+type ISearch interface {
+	Search(q models.SearchQuery) (models.Search, error)
+}
+
+type ICalendar interface {
+	CreateCalendarFeed(item models.PostCalendarFeed) (api.SCalendarFeed, error)
+	GetCalendar(token string) ([]byte, error)
+}
+
+type IFeeds interface {
+	GetArchiveFeed(filter models.FeedFilter, format models.FeedFormat) ([]byte, error)
+}
+
+type IRecordings interface {
+	GetRecordings(broadcastId types.UUID, viewer *string) ([]models.Recording, error)
+	CreateRecording(broadcastId types.UUID, item models.PostRecording, actor *string) (models.Recording, error)
+	UploadLimit(broadcastId types.UUID, actor *string) (int64, error)
+	UploadRecording(broadcastId types.UUID, item models.RecordingUpload, actor *string) (models.Recording, error)
+	DeleteRecording(id types.UUID, actor *string) (api.SIdentifier, error)
+	PlayRecording(id types.UUID, expires int64, signature string) (models.RecordingMedia, error)
+	WatchRecording(id types.UUID, viewer *string) (string, error)
+}
+
+type IIngest interface {
+	Publish(e models.IngestEvent) error
+	PublishDone(e models.IngestEvent) error
+	RecordDone(e models.IngestEvent) error
+}
+
+type IAccess interface {
+	GetAccess(id types.UUID, actor *string) ([]models.Access, error)
+	GrantAccess(id types.UUID, username string, actor *string) (models.Access, error)
+	RevokeAccess(id types.UUID, username string, actor *string) (models.Access, error)
+	CreateInvite(id types.UUID, actor *string) (api.SInvite, error)
+	AcceptInvite(token string, username string) (models.Broadcasts, error)
+}
+
+type ITags interface {
+	GetTags() ([]models.Tag, error)
+	SaveTag(item models.Tag, actor *string) (models.Tag, error)
+	ChangeTag(id types.UUID, item models.Tag, actor *string) (models.Tag, error)
+	DeleteTag(id types.UUID, actor *string) (api.SIdentifier, error)
+	GetCategories() ([]models.Category, error)
+	CreateCategory(item models.Category, actor *string) (models.Category, error)
+	DeleteCategory(id types.UUID, actor *string) (api.SIdentifier, error)
+}
+
+type IAnalytics interface {
+	GetAnalytics(id types.UUID, actor *string) (models.Analytics, error)
+	GetAnalyticsSeries(id types.UUID, actor *string, bucket time.Duration) ([]models.AnalyticsBucket, error)
+	TrackViewers() error
+}
+
+type IReports interface {
+	GetReport(id types.UUID, actor *string, format models.ReportFormat) (models.ReportFile, error)
+	SaveReportMail(id types.UUID, actor *string, item models.PutReportMail) (models.ReportMail, error)
+	DeleteReportMail(id types.UUID, actor *string) (models.ReportMail, error)
+	SendReports() error
+}
+
+type IWebhooks interface {
+	GetWebhooks(actor *string) ([]models.Webhook, error)
+	CreateWebhook(item models.PostWebhook, actor *string) (models.Webhook, error)
+	ChangeWebhook(id types.UUID, item models.PostWebhook, actor *string) (models.Webhook, error)
+	DeleteWebhook(id types.UUID, actor *string) (api.SIdentifier, error)
+	GetDeliveries(id types.UUID, actor *string, limit int) ([]models.WebhookDelivery, error)
+	DeliverWebhooks() error
+}
+
+type IFiles interface {
+	UploadLimit(broadcastId types.UUID, actor *string) (int64, error)
+	CreateFile(item models.File, actor *string) (models.FileInfo, error)
+	GetFiles(broadcastId types.UUID, viewer *string) ([]models.FileInfo, error)
+	GetFileById(id types.UUID, viewer *string) (models.File, error)
+	DeleteFile(id types.UUID, actor *string) (api.SIdentifier, error)
+}
+
 type Service struct {
 	IAdmin
 	IBroadcasts
+	ITrash
+	IRevisions
+	ILifeCycle
 	IParticipants
 	IMessages
+	IQuestions
 	IStream
 	ILive
 	IImages
 	IZoom
+	ISearch
+	ICalendar
+	IFeeds
+	IRecordings
+	IIngest
+	IFiles
+	IAccess
+	ITags
+	IAnalytics
+	IReports
+	IWebhooks
 }
 
-func NewService(t *transport.Transport) *Service {
+func NewService(t *transport.Transport, cfg models.Config) *Service {
+	lifeCycle := NewLifeCycleService(t.IBroadcastsPostgres, t.ICentrifugo, t.IWebhooksPostgres, cfg.SchedulerConfig.BroadcastDuration)
+	participants := NewParticipantsService(t.IParticipantsPostgres, t.IParticipantsRedis, t.IRegistrationsPostgres, t.IBroadcastsPostgres, t.ICentrifugo, t.IMail, t.IAccessPostgres, t.IWebhooksPostgres, cfg.WatchUrl)
+	broadcasts := NewBroadcastsService(t.IBroadcastsPostgres, t.IMessagesPostgres, t.IRevisionsPostgres, t.IAccessPostgres, t.IWebhooksPostgres, t.IRecordingsPostgres, participants, cfg.RecordingsConfig, cfg.SchedulerConfig.BroadcastDuration)
+
 	return &Service{
-		IAdmin:        NewAdminService(t.IBroadcastsPostgres, t.ICentrifugo),
-		IBroadcasts:   NewBroadcastsService(t.IBroadcastsPostgres, t.IMessagesPostgres),
-		IParticipants: NewParticipantsService(t.IParticipantsPostgres, t.IParticipantsRedis),
-		IMessages:     NewMessagesService(t.IMessagesPostgres, t.ICentrifugo),
-		IStream:       NewStreamService(t.IStreamPostgres, t.IMessagesPostgres, t.ICentrifugo),
+		IAdmin:        NewAdminService(t.IBroadcastsPostgres, t.ICentrifugo, t.IAccessPostgres),
+		IBroadcasts:   broadcasts,
+		ITrash:        NewTrashService(t.IBroadcastsPostgres, t.IMessagesPostgres, t.IRevisionsPostgres, t.IRecordingsPostgres, t.IStorage, cfg.TrashConfig.Retention),
+		IRevisions:    NewRevisionsService(broadcasts, t.IRevisionsPostgres),
+		ILifeCycle:    lifeCycle,
+		IParticipants: participants,
+		IMessages:     NewMessagesService(t.IMessagesPostgres, t.ICentrifugo, t.IBroadcastsPostgres, t.IAccessPostgres, cfg.ChatConfig),
+		IQuestions:    NewQuestionsService(t.IQuestionsPostgres, t.ICentrifugo, t.IBroadcastsPostgres, t.IAccessPostgres),
+		IStream:       NewStreamService(t.IStreamPostgres, t.IMessagesPostgres, t.IBroadcastsPostgres, t.ICentrifugo),
 		ILive:         NewLiveService(t.ILivePostgres),
-		IImages:       NewImagesService(t.IImagesPostgres),
+		IImages:       NewImagesService(t.IImagesPostgres, t.IBroadcastsPostgres, t.IRevisionsPostgres),
 		IZoom:         NewZoomService(t.IZoomPostgres, t.IMail),
+		ISearch:       NewSearchService(t.ISearchPostgres, t.IBroadcastsPostgres),
+		ICalendar:     NewCalendarService(t.ICalendarPostgres, cfg.WatchUrl, cfg.SchedulerConfig.BroadcastDuration),
+		IFeeds:        NewFeedsService(t.IFeedsPostgres, cfg.FeedConfig, cfg.RecordingsConfig, cfg.HTTPServerConfig.Path, cfg.WatchUrl),
+		IRecordings:   NewRecordingsService(t.IRecordingsPostgres, t.IBroadcastsPostgres, t.IAccessPostgres, t.IStorage, cfg.RecordingsConfig),
+		IIngest:       NewIngestService(t.IBroadcastsPostgres, t.IStreamPostgres, t.IRecordingsPostgres, t.IStorage, lifeCycle, cfg.EarlyPublish, cfg.SchedulerConfig.BroadcastDuration),
+		IFiles:        NewFilesService(t.IFilesPostgres, t.IBroadcastsPostgres, t.IAccessPostgres, t.ICentrifugo, cfg.FilesConfig.MaxSize),
+		IAccess:       NewAccessService(t.IAccessPostgres, t.IBroadcastsPostgres, cfg.WatchUrl),
+		ITags:         NewTagsService(t.ITagsPostgres, t.IBroadcastsPostgres),
+		IAnalytics:    NewAnalyticsService(t.IAnalyticsPostgres, t.IAnalyticsRedis, t.IRegistrationsPostgres, t.IBroadcastsPostgres, t.ICentrifugo),
+		IReports:      NewReportsService(t.IReportsPostgres, t.IAnalyticsPostgres, t.IAnalyticsRedis, t.IRegistrationsPostgres, t.IParticipantsPostgres, t.IMessagesPostgres, t.IBroadcastsPostgres, t.IMail),
+		IWebhooks:     NewWebhooksService(t.IWebhooksPostgres, t.IWebhook, t.IBroadcastsPostgres, cfg.WebhooksConfig),
 	}
 }
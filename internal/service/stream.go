@@ -7,28 +7,76 @@ import (
 )
 
 type StreamService struct {
-	streamPostgres   transport.IStreamPostgres
-	messagesPostgres transport.IMessagesPostgres
-	centrifugo       transport.ICentrifugo
+	streamPostgres     transport.IStreamPostgres
+	messagesPostgres   transport.IMessagesPostgres
+	broadcastsPostgres transport.IBroadcastsPostgres
+	centrifugo         transport.ICentrifugo
 }
 
 func NewStreamService(
 	streamPostgres transport.IStreamPostgres,
 	messagesPostgres transport.IMessagesPostgres,
+	broadcastsPostgres transport.IBroadcastsPostgres,
 	centrifugo transport.ICentrifugo) *StreamService {
-	return &StreamService{streamPostgres, messagesPostgres, centrifugo}
+	return &StreamService{streamPostgres, messagesPostgres, broadcastsPostgres, centrifugo}
 }
 
 func (s *StreamService) CreateStream(username api.SUsername) (models.Stream, error) {
-	return s.streamPostgres.CreateStream(username)
+	stream, err := s.streamPostgres.CreateStream(username)
+	stream.StreamKey = nil
+	return stream, err
 }
 
 func (s *StreamService) GetStream(username string) (models.Stream, error) {
-	return s.streamPostgres.GetStream(username)
+	stream, err := s.streamPostgres.GetStream(username)
+	stream.StreamKey = nil
+	return stream, err
 }
 
-func (s *StreamService) ChangeDescByUsername(item models.PutStream) (models.Stream, error) {
-	return s.streamPostgres.ChangeDescByUsername(item)
+// ChangeDescByUsername returns the current stream with ErrVersionMismatch when
+// version is set and the stream has been changed since.
+func (s *StreamService) ChangeDescByUsername(item models.PutStream, version *int) (models.Stream, error) {
+	stream, err := s.streamPostgres.ChangeDescByUsername(item, version)
+	if err != nil {
+		return stream, err
+	}
+	if stream.Id == nil {
+		current, err := s.streamPostgres.GetStream(*item.Username)
+		if err != nil {
+			return current, err
+		}
+		current.StreamKey = nil
+		if current.Id == nil {
+			return current, models.ErrStreamNotFound
+		}
+		return current, models.ErrVersionMismatch
+	}
+
+	stream.StreamKey = nil
+	return stream, nil
+}
+
+// RotateStreamKey is the only response carrying the personal stream key, it
+// is allowed to the owner of the stream and admins.
+func (s *StreamService) RotateStreamKey(username string, actor api.SUsername) (models.Stream, error) {
+	if actor.Username == nil || *actor.Username != username {
+		isAdmin, err := s.broadcastsPostgres.CheckAdminUser(actor)
+		if err != nil {
+			return models.Stream{}, err
+		}
+		if !isAdmin {
+			return models.Stream{}, models.ErrNotOwner
+		}
+	}
+
+	stream, err := s.streamPostgres.RotateStreamKey(username)
+	if err != nil {
+		return stream, err
+	}
+	if stream.Id == nil {
+		return stream, models.ErrStreamNotFound
+	}
+	return stream, nil
 }
 
 func (s *StreamService) ClearChat(channel string) error {
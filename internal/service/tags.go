@@ -0,0 +1,101 @@
+package service
+
+import (
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type TagsService struct {
+	tagsPostgres       transport.ITagsPostgres
+	broadcastsPostgres transport.IBroadcastsPostgres
+}
+
+func NewTagsService(
+	tagsPostgres transport.ITagsPostgres,
+	broadcastsPostgres transport.IBroadcastsPostgres) *TagsService {
+	return &TagsService{tagsPostgres, broadcastsPostgres}
+}
+
+func (t *TagsService) GetTags() ([]models.Tag, error) {
+	return t.tagsPostgres.GetTags()
+}
+
+func (t *TagsService) SaveTag(item models.Tag, actor *string) (models.Tag, error) {
+	if err := t.checkAdmin(actor); err != nil {
+		return models.Tag{}, err
+	}
+	return t.tagsPostgres.SaveTag(item)
+}
+
+func (t *TagsService) ChangeTag(id types.UUID, item models.Tag, actor *string) (models.Tag, error) {
+	if err := t.checkAdmin(actor); err != nil {
+		return models.Tag{}, err
+	}
+
+	tag, err := t.tagsPostgres.ChangeTag(id, item)
+	if err != nil {
+		return tag, err
+	}
+	if tag.Id == nil {
+		return tag, models.ErrTagNotFound
+	}
+	return tag, nil
+}
+
+func (t *TagsService) DeleteTag(id types.UUID, actor *string) (api.SIdentifier, error) {
+	if err := t.checkAdmin(actor); err != nil {
+		return api.SIdentifier{}, err
+	}
+
+	item, err := t.tagsPostgres.DeleteTag(id)
+	if err != nil {
+		return item, err
+	}
+	if item.Id == nil {
+		return item, models.ErrTagNotFound
+	}
+	return item, nil
+}
+
+func (t *TagsService) GetCategories() ([]models.Category, error) {
+	return t.tagsPostgres.GetCategories()
+}
+
+func (t *TagsService) CreateCategory(item models.Category, actor *string) (models.Category, error) {
+	if err := t.checkAdmin(actor); err != nil {
+		return models.Category{}, err
+	}
+	return t.tagsPostgres.CreateCategory(item)
+}
+
+func (t *TagsService) DeleteCategory(id types.UUID, actor *string) (api.SIdentifier, error) {
+	if err := t.checkAdmin(actor); err != nil {
+		return api.SIdentifier{}, err
+	}
+
+	item, err := t.tagsPostgres.DeleteCategory(id)
+	if err != nil {
+		return item, err
+	}
+	if item.Id == nil {
+		return item, models.ErrCategoryNotFound
+	}
+	return item, nil
+}
+
+func (t *TagsService) checkAdmin(actor *string) error {
+	if actor == nil {
+		return models.ErrNotAdmin
+	}
+	isAdmin, err := t.broadcastsPostgres.CheckAdminUser(api.SUsername{Username: actor})
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return models.ErrNotAdmin
+	}
+	return nil
+}
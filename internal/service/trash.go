@@ -0,0 +1,118 @@
+package service
+
+import (
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+type TrashService struct {
+	broadcastsPostgres transport.IBroadcastsPostgres
+	messagesPostgres   transport.IMessagesPostgres
+	revisionsPostgres  transport.IRevisionsPostgres
+	recordingsPostgres transport.IRecordingsPostgres
+	storage            transport.IStorage
+	retention          time.Duration
+}
+
+func NewTrashService(
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	messagesPostgres transport.IMessagesPostgres,
+	revisionsPostgres transport.IRevisionsPostgres,
+	recordingsPostgres transport.IRecordingsPostgres,
+	storage transport.IStorage,
+	retention time.Duration) *TrashService {
+	return &TrashService{broadcastsPostgres, messagesPostgres, revisionsPostgres, recordingsPostgres, storage, retention}
+}
+
+func (t *TrashService) GetTrash(username api.SUsername) ([]models.Broadcasts, error) {
+	if err := t.checkAdmin(username); err != nil {
+		return nil, err
+	}
+	return t.broadcastsPostgres.GetTrash()
+}
+
+func (t *TrashService) RestoreBroadcast(id types.UUID, username api.SUsername) (models.Broadcasts, error) {
+	if err := t.checkAdmin(username); err != nil {
+		return models.Broadcasts{}, err
+	}
+
+	item, err := t.broadcastsPostgres.RestoreBroadcast(id)
+	if err != nil {
+		return item, err
+	}
+	if item.Id == nil {
+		return item, models.ErrNotInTrash
+	}
+
+	return item, saveRevision(t.revisionsPostgres, models.RevisionRestore, username.Username, item, item)
+}
+
+// PurgeTrash permanently deletes broadcasts kept in the trash longer than the
+// retention period together with their chat and recording files. Paths are
+// loaded before the purge since the recordings rows are deleted with it.
+func (t *TrashService) PurgeTrash() error {
+	before := time.Now().Add(-t.retention)
+	recordings, err := t.expiredRecordings(before)
+	if err != nil {
+		return err
+	}
+
+	items, err := t.broadcastsPostgres.PurgeTrash(before)
+	if err != nil {
+		return err
+	}
+
+	purged := make(map[types.UUID]bool, len(items))
+	for _, i := range items {
+		purged[*i.Id] = true
+		if err = t.messagesPostgres.DeleteMessages(i.Id.String()); err != nil {
+			return err
+		}
+	}
+
+	var first error
+	for _, r := range recordings {
+		if r.Path == nil || !purged[*r.BroadcastId] {
+			continue
+		}
+		if err = t.storage.Remove(*r.Path); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// expiredRecordings returns recordings of broadcasts deleted before the time.
+func (t *TrashService) expiredRecordings(before time.Time) ([]models.Recording, error) {
+	trash, err := t.broadcastsPostgres.GetTrash()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []types.UUID
+	for _, i := range trash {
+		if i.DeletedAt != nil && i.DeletedAt.Before(before) {
+			ids = append(ids, *i.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return t.recordingsPostgres.GetRecordings(ids)
+}
+
+func (t *TrashService) checkAdmin(username api.SUsername) error {
+	isAdmin, err := t.broadcastsPostgres.CheckAdminUser(username)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return models.ErrNotAdmin
+	}
+	return nil
+}
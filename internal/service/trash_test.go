@@ -0,0 +1,95 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+// trashPostgres purges the trashed broadcasts deleted before the time.
+type trashPostgres struct {
+	transport.IBroadcastsPostgres
+	trash []models.Broadcasts
+}
+
+func (p *trashPostgres) GetTrash() ([]models.Broadcasts, error) {
+	return p.trash, nil
+}
+
+func (p *trashPostgres) PurgeTrash(before time.Time) ([]api.SIdentifier, error) {
+	var items = make([]api.SIdentifier, 0)
+	for _, i := range p.trash {
+		if i.DeletedAt.Before(before) {
+			items = append(items, i.SIdentifier)
+		}
+	}
+	return items, nil
+}
+
+type chatPostgres struct {
+	transport.IMessagesPostgres
+}
+
+func (c *chatPostgres) DeleteMessages(channel string) error {
+	return nil
+}
+
+type broadcastRecordingsPostgres struct {
+	transport.IRecordingsPostgres
+	recordings []models.Recording
+}
+
+func (r *broadcastRecordingsPostgres) GetRecordings(broadcastIds []types.UUID) ([]models.Recording, error) {
+	var items = make([]models.Recording, 0)
+	for _, recording := range r.recordings {
+		for _, id := range broadcastIds {
+			if *recording.BroadcastId == id {
+				items = append(items, recording)
+			}
+		}
+	}
+	return items, nil
+}
+
+type removedStorage struct {
+	transport.IStorage
+	removed []string
+}
+
+func (s *removedStorage) Remove(path string) error {
+	s.removed = append(s.removed, path)
+	return nil
+}
+
+func TestService_PurgeTrash(t *testing.T) {
+	expired, kept := uuid.New(), uuid.New()
+	expiredAt, keptAt := time.Now().Add(-48*time.Hour), time.Now().Add(-time.Hour)
+	expiredPath, keptPath := "/recordings/expired.mp4", "/recordings/kept.mp4"
+	url := "https://video.vp.ru/town-hall.mp4"
+
+	storage := &removedStorage{}
+	trash := NewTrashService(
+		&trashPostgres{trash: []models.Broadcasts{
+			{SIdentifier: api.SIdentifier{Id: &expired}, SDeleted: api.SDeleted{DeletedAt: &expiredAt}},
+			{SIdentifier: api.SIdentifier{Id: &kept}, SDeleted: api.SDeleted{DeletedAt: &keptAt}},
+		}},
+		&chatPostgres{},
+		nil,
+		&broadcastRecordingsPostgres{recordings: []models.Recording{
+			{SRecording: api.SRecording{BroadcastId: &expired}, Path: &expiredPath},
+			{SRecording: api.SRecording{BroadcastId: &expired}, Url: &url},
+			{SRecording: api.SRecording{BroadcastId: &kept}, Path: &keptPath},
+		}},
+		storage,
+		24*time.Hour)
+
+	assert.NoError(t, trash.PurgeTrash())
+	assert.Equal(t, []string{expiredPath}, storage.removed)
+}
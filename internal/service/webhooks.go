@@ -0,0 +1,165 @@
+package service
+
+import (
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+	"github.com/alexm24/golang/internal/transport"
+)
+
+// deliveriesBatch limits deliveries attempted in one run.
+const deliveriesBatch = 100
+
+type WebhooksService struct {
+	webhooksPostgres   transport.IWebhooksPostgres
+	webhook            transport.IWebhook
+	broadcastsPostgres transport.IBroadcastsPostgres
+	cfg                models.WebhooksConfig
+}
+
+func NewWebhooksService(
+	webhooksPostgres transport.IWebhooksPostgres,
+	webhook transport.IWebhook,
+	broadcastsPostgres transport.IBroadcastsPostgres,
+	cfg models.WebhooksConfig) *WebhooksService {
+	return &WebhooksService{webhooksPostgres, webhook, broadcastsPostgres, cfg}
+}
+
+func (w *WebhooksService) GetWebhooks(actor *string) ([]models.Webhook, error) {
+	if err := w.checkAdmin(actor); err != nil {
+		return nil, err
+	}
+	return w.webhooksPostgres.GetWebhooks()
+}
+
+// CreateWebhook generates the secret of the webhook, it is not returned later.
+func (w *WebhooksService) CreateWebhook(item models.PostWebhook, actor *string) (models.Webhook, error) {
+	if err := w.checkAdmin(actor); err != nil {
+		return models.Webhook{}, err
+	}
+
+	secret, err := models.NewSecret()
+	if err != nil {
+		return models.Webhook{}, err
+	}
+	return w.webhooksPostgres.CreateWebhook(item, secret, actor)
+}
+
+func (w *WebhooksService) ChangeWebhook(id types.UUID, item models.PostWebhook, actor *string) (models.Webhook, error) {
+	if err := w.checkAdmin(actor); err != nil {
+		return models.Webhook{}, err
+	}
+
+	webhook, err := w.webhooksPostgres.ChangeWebhook(id, item)
+	if err != nil {
+		return webhook, err
+	}
+	if webhook.Id == nil {
+		return webhook, models.ErrWebhookNotFound
+	}
+	return webhook, nil
+}
+
+func (w *WebhooksService) DeleteWebhook(id types.UUID, actor *string) (api.SIdentifier, error) {
+	if err := w.checkAdmin(actor); err != nil {
+		return api.SIdentifier{}, err
+	}
+
+	item, err := w.webhooksPostgres.DeleteWebhook(id)
+	if err != nil {
+		return item, err
+	}
+	if item.Id == nil {
+		return item, models.ErrWebhookNotFound
+	}
+	return item, nil
+}
+
+func (w *WebhooksService) GetDeliveries(id types.UUID, actor *string, limit int) ([]models.WebhookDelivery, error) {
+	if err := w.checkAdmin(actor); err != nil {
+		return nil, err
+	}
+
+	exists, err := w.webhooksPostgres.WebhookExists(id)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, models.ErrWebhookNotFound
+	}
+	return w.webhooksPostgres.GetDeliveries(id, limit)
+}
+
+// DeliverWebhooks attempts due deliveries. Failed attempts are retried with
+// exponential backoff until the delivery runs out of attempts.
+func (w *WebhooksService) DeliverWebhooks() error {
+	items, err := w.webhooksPostgres.GetDueDeliveries(time.Now(), deliveriesBatch)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if err = w.deliver(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *WebhooksService) deliver(item models.WebhookDelivery) error {
+	start := time.Now()
+	code, err := w.webhook.Send(item.Request(start))
+	duration := int(time.Since(start).Milliseconds())
+
+	attempt := api.SWebhookAttempt{Time: &start, DurationMs: &duration}
+	if err != nil {
+		msg := err.Error()
+		attempt.Error = &msg
+	} else {
+		attempt.StatusCode = &code
+	}
+
+	attempts := 1
+	if item.Attempts != nil {
+		attempts += *item.Attempts
+	}
+
+	switch {
+	case err == nil && code >= 200 && code < 300:
+		return w.webhooksPostgres.SaveAttempt(*item.Id, attempt, models.WebhookDelivered, nil)
+	case attempts >= w.cfg.MaxAttempts:
+		return w.webhooksPostgres.SaveAttempt(*item.Id, attempt, models.WebhookFailed, nil)
+	}
+	next := start.Add(models.WebhookBackoff(w.cfg.Backoff, attempts))
+	return w.webhooksPostgres.SaveAttempt(*item.Id, attempt, models.WebhookPending, &next)
+}
+
+func (w *WebhooksService) checkAdmin(actor *string) error {
+	if actor == nil {
+		return models.ErrNotAdmin
+	}
+	isAdmin, err := w.broadcastsPostgres.CheckAdminUser(api.SUsername{Username: actor})
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return models.ErrNotAdmin
+	}
+	return nil
+}
+
+// emitBroadcasts queues the event of every broadcast for subscribed webhooks.
+func emitBroadcasts(webhooksPostgres transport.IWebhooksPostgres, event string, items ...models.Broadcasts) error {
+	for _, item := range items {
+		if item.Id == nil {
+			continue
+		}
+		if err := webhooksPostgres.EnqueueEvent(models.NewBroadcastEvent(event, item)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -40,6 +40,27 @@ func (c *Centrifugo) GetToken(user api.SUsername) (api.SToken, error) {
 	return api.SToken{Exp: &ttl, Token: &tokenString}, nil
 }
 
+// GetChannelToken signs a subscription token, required by channels of
+// namespaces protected in the Centrifugo config.
+func (c *Centrifugo) GetChannelToken(user api.SUsername, channel string) (api.SToken, error) {
+	ttl := time.Now().Add(time.Hour * 10)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":     *user.Username,
+		"channel": channel,
+		"exp":     ttl.Unix(),
+	})
+
+	tokenString, err := token.SignedString([]byte(c.cfg.TokenHmacSecret))
+	if err != nil {
+		tokenEmpty := ""
+		timeNow := time.Now()
+		return api.SToken{Exp: &timeNow, Token: &tokenEmpty}, err
+	}
+
+	return api.SToken{Exp: &ttl, Token: &tokenString}, nil
+}
+
 func (c *Centrifugo) Publish(channel string, msg interface{}) error {
 	cmd := models.Centrifugo{
 		Method: "publish",
@@ -48,26 +69,52 @@ func (c *Centrifugo) Publish(channel string, msg interface{}) error {
 			Data:    msg,
 		},
 	}
-	byteCmd, err := json.Marshal(cmd)
+	res, err := c.call(cmd)
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+// PresenceStats counts clients subscribed to the channel right now.
+func (c *Centrifugo) PresenceStats(channel string) (models.PresenceStats, error) {
+	cmd := models.Centrifugo{
+		Method: "presence_stats",
+		Params: models.Params{Channel: channel},
+	}
+	res, err := c.call(cmd)
+	if err != nil {
+		return models.PresenceStats{}, err
+	}
+	defer res.Body.Close()
+
+	var reply models.PresenceStatsReply
+	if err = json.NewDecoder(res.Body).Decode(&reply); err != nil {
+		return models.PresenceStats{}, err
+	}
+	if reply.Error != nil {
+		return models.PresenceStats{}, reply.Error
+	}
+	return reply.Result, nil
+}
+
+func (c *Centrifugo) call(cmd models.Centrifugo) (*http.Response, error) {
+	byteCmd, err := json.Marshal(cmd)
+	if err != nil {
+		return nil, err
+	}
 
 	authKey := fmt.Sprintf("apikey %s", c.cfg.APIKey)
 
 	req, err := http.NewRequest("POST", c.cfg.Url, bytes.NewBuffer(byteCmd))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
 	req.Header.Set("Authorization", authKey)
 
 	client := &http.Client{}
-	res, err := client.Do(req)
-	if err != nil {
-		return err
-	}
-	defer res.Body.Close()
-
-	return nil
+	return client.Do(req)
 }
@@ -1,9 +1,14 @@
 package mail
 
 import (
+	"bytes"
 	"encoding/base64"
+	"html"
+	"mime"
+	"mime/multipart"
 	"net/mail"
 	"net/smtp"
+	"net/textproto"
 
 	"github.com/alexm24/golang/internal/models"
 )
@@ -16,18 +21,38 @@ func NewMail() *Mail {
 }
 
 func (m *Mail) SendMail(item models.Zoom) error {
+	body := "<h2>Запись zoom конференции находится по адресу: </h2>"
+	body += "<h2><a href=\"https://vp.ru/zoom/" + item.Id.String() + "\">" + *item.Topic + "</a></h2>"
+
+	return m.send(*item.Email, "Запись Zoom", body)
+}
+
+func (m *Mail) SendPromotion(item models.Promotion) error {
+	body := "<h2>" + html.EscapeString(item.Fullname) + ", для вас освободилось место на трансляции: </h2>"
+	body += "<h2><a href=\"" + item.Url + "\">" + html.EscapeString(item.Name) + "</a></h2>"
+
+	return m.send(item.Email, "Место на трансляции", body)
+}
+
+func (m *Mail) SendReport(item models.ReportLetter) error {
+	body := "<h2>Отчёт о трансляции: " + html.EscapeString(item.Name) + "</h2>"
+
+	return m.send(item.Email, "Отчёт о трансляции", body, item.File)
+}
+
+func (m *Mail) send(to string, subject string, body string, attachments ...models.ReportFile) error {
 	c, err := smtp.Dial("10.0.16.1:25")
 	if err != nil {
 		return err
 	}
 	fromEmail := "null@vp.ru"
-	from := (&mail.Address{Name: "Запись Zoom", Address: fromEmail}).String()
+	from := (&mail.Address{Name: subject, Address: fromEmail}).String()
 
 	if err = c.Mail(fromEmail); err != nil {
 		return err
 	}
 
-	if err = c.Rcpt(*item.Email); err != nil {
+	if err = c.Rcpt(to); err != nil {
 		return err
 	}
 
@@ -36,14 +61,15 @@ func (m *Mail) SendMail(item models.Zoom) error {
 		return err
 	}
 
-	body := "<h2>Запись zoom конференции находится по адресу: </h2>"
-	body += "<h2><a href=\"https://vp.ru/zoom/" + item.Id.String() + "\">" + *item.Topic + "</a></h2>"
-
 	msg := "From: " + from + "\r\n" +
-		"Subject: Запись Zoom" + "\r\n" +
-		"Content-Type: text/html; charset=\"UTF-8\"\r\n" +
-		"Content-Transfer-Encoding: base64\r\n" +
-		"\r\n" + base64.StdEncoding.EncodeToString([]byte(body))
+		"Subject: " + subject + "\r\n"
+	if len(attachments) == 0 {
+		msg += "Content-Type: text/html; charset=\"UTF-8\"\r\n" +
+			"Content-Transfer-Encoding: base64\r\n" +
+			"\r\n" + base64.StdEncoding.EncodeToString([]byte(body))
+	} else {
+		msg += mixed(body, attachments)
+	}
 
 	_, err = w.Write([]byte(msg))
 	if err != nil {
@@ -61,3 +87,33 @@ func (m *Mail) SendMail(item models.Zoom) error {
 
 	return nil
 }
+
+// mixed renders the html body with attached files as a multipart/mixed message.
+func mixed(body string, attachments []models.ReportFile) string {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	part := func(header textproto.MIMEHeader, data []byte) {
+		header.Set("Content-Transfer-Encoding", "base64")
+		w, _ := mw.CreatePart(header)
+		encoded := base64.StdEncoding.EncodeToString(data)
+		for len(encoded) > 76 {
+			_, _ = w.Write([]byte(encoded[:76] + "\r\n"))
+			encoded = encoded[76:]
+		}
+		_, _ = w.Write([]byte(encoded))
+	}
+
+	part(textproto.MIMEHeader{"Content-Type": {`text/html; charset="UTF-8"`}}, []byte(body))
+	for _, file := range attachments {
+		part(textproto.MIMEHeader{
+			"Content-Type":        {file.ContentType},
+			"Content-Disposition": {mime.FormatMediaType("attachment", map[string]string{"filename": file.Name})},
+		}, file.Data)
+	}
+	_ = mw.Close()
+
+	return "MIME-Version: 1.0\r\n" +
+		"Content-Type: multipart/mixed; boundary=" + mw.Boundary() + "\r\n" +
+		"\r\n" + buf.String()
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+const accessTable = "broadcast_access"
+
+const accessFields = "broadcast_id, username, granted_by, created_at"
+
+type AccessPostgres struct {
+	db *sqlx.DB
+}
+
+func NewAccessPostgres(db *sqlx.DB) *AccessPostgres {
+	return &AccessPostgres{db}
+}
+
+func (a *AccessPostgres) GetAccess(broadcastId types.UUID) ([]models.Access, error) {
+	var items = make([]models.Access, 0)
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE broadcast_id = $1 ORDER BY created_at;", accessFields, accessTable)
+	if err := a.db.Select(&items, query, broadcastId); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// GrantAccess adds the user to the allowlist, granting twice keeps the first grant.
+func (a *AccessPostgres) GrantAccess(broadcastId types.UUID, username string, grantedBy *string) (models.Access, error) {
+	var item models.Access
+	query := fmt.Sprintf(`INSERT INTO %s (broadcast_id, username, granted_by) VALUES ($1, $2, $3)
+		ON CONFLICT (broadcast_id, username) DO UPDATE SET username = EXCLUDED.username
+		RETURNING %s;`, accessTable, accessFields)
+	if err := a.db.QueryRowx(query, broadcastId, username, grantedBy).StructScan(&item); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+func (a *AccessPostgres) RevokeAccess(broadcastId types.UUID, username string) (models.Access, error) {
+	var item models.Access
+	query := fmt.Sprintf("DELETE FROM %s WHERE broadcast_id = $1 AND username = $2 RETURNING %s;",
+		accessTable, accessFields)
+	if err := a.db.QueryRowx(query, broadcastId, username).StructScan(&item); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+func (a *AccessPostgres) HasAccess(broadcastId types.UUID, username string) (bool, error) {
+	var ok bool
+	query := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE broadcast_id = $1 AND username = $2);", accessTable)
+	if err := a.db.Get(&ok, query, broadcastId, username); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// CreateInvite replaces the invite token of the broadcast.
+func (a *AccessPostgres) CreateInvite(broadcastId types.UUID, token string) (api.SIdentifier, error) {
+	var item api.SIdentifier
+	query := fmt.Sprintf("UPDATE %s SET invite_token = $2 WHERE id = $1 AND %s RETURNING id;", broadcastTable, notDeleted)
+	if err := a.db.QueryRowx(query, broadcastId, token).StructScan(&item); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+func (a *AccessPostgres) GetBroadcastByInvite(token string) (models.Broadcasts, error) {
+	var item models.Broadcasts
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE invite_token = $1 AND %s", broadcastFields, broadcastTable, notDeleted)
+	if err := a.db.Get(&item, query, token); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/alexm24/golang/internal/models"
+)
+
+// reactionsCount counts reactions of a message, reactions map usernames to reaction types.
+const reactionsCount = "COALESCE(sum((SELECT count(*) FROM jsonb_object_keys(reactions))), 0)::integer"
+
+type AnalyticsPostgres struct {
+	db *sqlx.DB
+}
+
+func NewAnalyticsPostgres(db *sqlx.DB) *AnalyticsPostgres {
+	return &AnalyticsPostgres{db}
+}
+
+func (a *AnalyticsPostgres) GetChatStats(channel string) (models.Analytics, error) {
+	var item models.Analytics
+	query := fmt.Sprintf(`SELECT count(*) AS messages, count(*) FILTER (WHERE is_question) AS questions,
+		count(DISTINCT username) AS chatters, %s AS reactions
+		FROM %s WHERE channel = $1 AND %s;`, reactionsCount, messagesTable, messageNotDeleted)
+	if err := a.db.Get(&item, query, channel); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+func (a *AnalyticsPostgres) GetReactionCounts(channel string) ([]models.ReactionCount, error) {
+	var items = make([]models.ReactionCount, 0)
+	query := fmt.Sprintf(`SELECT r.value AS type, count(*) AS count
+		FROM %s m, jsonb_each_text(m.reactions) r WHERE m.channel = $1
+		GROUP BY r.value ORDER BY count DESC, type;`, messagesTable)
+	if err := a.db.Select(&items, query, channel); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// GetChatSeries counts messages per bucket of the given size aligned to the
+// unix epoch, reactions are counted in the bucket of their message.
+func (a *AnalyticsPostgres) GetChatSeries(channel string, bucket time.Duration) ([]models.AnalyticsBucket, error) {
+	var items = make([]models.AnalyticsBucket, 0)
+	query := fmt.Sprintf(`SELECT to_timestamp(floor(extract(epoch FROM time) / $2) * $2) AS time,
+		count(*) AS messages, count(*) FILTER (WHERE is_question) AS questions, %s AS reactions
+		FROM %s WHERE channel = $1 AND %s GROUP BY 1 ORDER BY 1;`, reactionsCount, messagesTable, messageNotDeleted)
+	if err := a.db.Select(&items, query, channel, bucket.Seconds()); err != nil {
+		return items, err
+	}
+	return items, nil
+}
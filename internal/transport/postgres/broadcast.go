@@ -3,9 +3,12 @@ package postgres
 import (
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"github.com/alexm24/golang/internal/handler/api"
 	"github.com/alexm24/golang/internal/models"
@@ -15,8 +18,29 @@ const (
 	broadcastTable = "broadcasts"
 	usersTable     = "users"
 	imagesTable    = "images"
+	seriesTable    = "series"
 )
 
+const broadcastFields = "id, name, owner, description, previewurl, streamkey, start_time, end_time, life, series_id, " +
+	"deleted_at, deleted_by, version, capacity, visibility, " + broadcastTags
+
+// broadcastTags aggregates tag names of the broadcast into a json array.
+const broadcastTags = "COALESCE((SELECT json_agg(t.name ORDER BY t.name) FROM " + broadcastTagsTable + " bt JOIN " +
+	tagsTable + " t ON t.id = bt.tag_id WHERE bt.broadcast_id = " + broadcastTable + ".id), '[]') AS tags"
+
+// notDeleted excludes broadcasts in the trash.
+const notDeleted = "deleted_at IS NULL"
+
+// visibleTo matches broadcasts the viewer can see, alias prefixes the columns
+// and viewer is the placeholder of the username, which may be NULL.
+func visibleTo(alias, viewer string) string {
+	return fmt.Sprintf(`(%[1]svisibility = '%[3]s' OR %[1]sowner = %[2]s::text
+		OR (%[1]svisibility = '%[4]s' AND %[2]s::text IS NOT NULL)
+		OR EXISTS (SELECT 1 FROM %[5]s a WHERE a.broadcast_id = %[1]sid AND a.username = %[2]s::text)
+		OR EXISTS (SELECT 1 FROM %[6]s u WHERE u.username = %[2]s::text))`,
+		alias, viewer, models.VisibilityPublic, models.VisibilityInternal, accessTable, usersTable)
+}
+
 type BroadcastsPostgres struct {
 	db *sqlx.DB
 }
@@ -25,25 +49,95 @@ func NewBroadcastsPostgres(db *sqlx.DB) *BroadcastsPostgres {
 	return &BroadcastsPostgres{db}
 }
 
-func (b *BroadcastsPostgres) GetBroadcasts() ([]models.Broadcasts, error) {
-	var items []models.Broadcasts
-	query := fmt.Sprintf(
-		`SELECT id, name, owner, description, previewurl, streamkey, start_time, life
-				FROM %s WHERE life='%s' ORDER by start_time ASC;`,
-		broadcastTable, models.Created.String())
+func (b *BroadcastsPostgres) GetBroadcasts(f models.BroadcastFilter) (models.BroadcastsPage, error) {
+	var page = models.BroadcastsPage{Items: make([]models.Broadcasts, 0)}
 
-	if err := b.db.Select(&items, query); err != nil {
-		return items, err
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
 	}
+	var where = []string{notDeleted, visibleTo("", arg(f.Viewer))}
 
-	return items, nil
+	if len(f.Life) > 0 {
+		life := make([]string, 0, len(f.Life))
+		for _, l := range f.Life {
+			life = append(life, arg(l.String()))
+		}
+		where = append(where, fmt.Sprintf("life IN (%s)", strings.Join(life, ", ")))
+	}
+	if f.Owner != nil {
+		where = append(where, "owner = "+arg(*f.Owner))
+	}
+	if f.From != nil {
+		where = append(where, "start_time >= "+arg(*f.From))
+	}
+	if f.To != nil {
+		where = append(where, "start_time < "+arg(*f.To))
+	}
+	if len(f.Tags) > 0 {
+		where = append(where, fmt.Sprintf(`id IN (SELECT bt.broadcast_id FROM %s bt JOIN %s t ON t.id = bt.tag_id
+			WHERE t.name = ANY(%s) GROUP BY bt.broadcast_id HAVING count(*) = %s)`,
+			broadcastTagsTable, tagsTable, arg(pq.Array(f.Tags)), arg(len(f.Tags))))
+	}
+
+	cond := ""
+	if len(where) > 0 {
+		cond = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	qCount := fmt.Sprintf("SELECT count(*) FROM %s %s;", broadcastTable, cond)
+	if err := b.db.Get(&page.Total, qCount, args...); err != nil {
+		return page, err
+	}
+
+	qFacets := fmt.Sprintf(`SELECT t.name AS tag, count(*) AS count FROM %s bt JOIN %s t ON t.id = bt.tag_id
+		WHERE bt.broadcast_id IN (SELECT id FROM %s %s) GROUP BY t.name ORDER BY t.name;`,
+		broadcastTagsTable, tagsTable, broadcastTable, cond)
+	if err := b.db.Select(&page.Facets, qFacets, args...); err != nil {
+		return page, err
+	}
+
+	cmp := ">"
+	if f.Order == models.OrderDesc {
+		cmp = "<"
+	}
+	if f.Cursor != nil {
+		where = append(where, fmt.Sprintf("(%s, id) %s (%s, %s)", f.Sort, cmp, arg(f.Cursor.Value), arg(f.Cursor.Id)))
+		cond = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s %s ORDER BY %s %s, id %s LIMIT %s;",
+		broadcastFields, broadcastTable, cond, f.Sort, f.Order, f.Order, arg(f.Limit+1))
+	if err := b.db.Select(&page.Items, query, args...); err != nil {
+		return page, err
+	}
+
+	if len(page.Items) > f.Limit {
+		page.Items = page.Items[:f.Limit]
+		next := f.NextCursor(page.Items[f.Limit-1])
+		page.NextCursor = &next
+	}
+
+	return page, nil
 }
 
 func (b *BroadcastsPostgres) GetBroadcastById(id types.UUID) (models.Broadcasts, error) {
 	var item models.Broadcasts
-	q := fmt.Sprintf(
-		"SELECT id, name, owner, description, previewurl, streamkey, start_time, life FROM %s WHERE id = $1",
-		broadcastTable)
+	q := fmt.Sprintf("SELECT %s FROM %s WHERE id = $1 AND %s", broadcastFields, broadcastTable, notDeleted)
+	if err := b.db.Get(&item, q, id); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+// GetBroadcastWithTrash also returns broadcasts in the trash, deleted_at is set for them.
+func (b *BroadcastsPostgres) GetBroadcastWithTrash(id types.UUID) (models.Broadcasts, error) {
+	var item models.Broadcasts
+	q := fmt.Sprintf("SELECT %s FROM %s WHERE id = $1", broadcastFields, broadcastTable)
 	if err := b.db.Get(&item, q, id); err != nil {
 		if err == sql.ErrNoRows {
 			return item, nil
@@ -53,11 +147,24 @@ func (b *BroadcastsPostgres) GetBroadcastById(id types.UUID) (models.Broadcasts,
 	return item, nil
 }
 
-func (b *BroadcastsPostgres) DeleteBroadcast(id types.UUID) (api.SIdentifier, error) {
+func (b *BroadcastsPostgres) GetBroadcastByStreamKey(streamKey string) (models.Broadcasts, error) {
+	var item models.Broadcasts
+	q := fmt.Sprintf("SELECT %s FROM %s WHERE streamkey = $1 AND %s", broadcastFields, broadcastTable, notDeleted)
+	if err := b.db.Get(&item, q, streamKey); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+func (b *BroadcastsPostgres) DeleteBroadcast(id types.UUID, deletedBy *string) (api.SIdentifier, error) {
 	var item api.SIdentifier
 
-	query := fmt.Sprintf("DELETE FROM %s WHERE id = $1 RETURNING id;", broadcastTable)
-	if err := b.db.QueryRowx(query, id).StructScan(&item); err != nil {
+	query := fmt.Sprintf("UPDATE %s SET deleted_at = now(), deleted_by = $2 WHERE id = $1 AND %s RETURNING id;",
+		broadcastTable, notDeleted)
+	if err := b.db.QueryRowx(query, id, deletedBy).StructScan(&item); err != nil {
 		if err == sql.ErrNoRows {
 			return item, nil
 		}
@@ -78,41 +185,24 @@ func (b *BroadcastsPostgres) CheckAdminUser(user api.SUsername) (bool, error) {
 	return true, nil
 }
 
-func (b *BroadcastsPostgres) GetPastBroadcastAll() ([]models.Broadcasts, error) {
-	var items = make([]models.Broadcasts, 0)
-	query := fmt.Sprintf(
-		`SELECT id, name, owner, description, previewurl, streamkey, start_time, life
-				FROM %s WHERE life='%s' ORDER by start_time DESC;`,
-		broadcastTable, models.Past.String())
-	if err := b.db.Select(&items, query); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-func (b *BroadcastsPostgres) GetPastBroadcastOwner(user api.SUsername) ([]models.Broadcasts, error) {
-	var items = make([]models.Broadcasts, 0)
-	query := fmt.Sprintf(
-		`SELECT id, name, owner, description, previewurl, streamkey, start_time, life
-				FROM %s WHERE life='%s' AND owner = '%s' ORDER by start_time DESC;`,
-		broadcastTable, models.Past.String(), *user.Username)
-	if err := b.db.Select(&items, query); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 func (b *BroadcastsPostgres) CreateBroadcast(item models.PostBroadcast) (models.Broadcasts, error) {
 	var broadcast models.Broadcasts
 
+	streamKey, err := models.NewSecret()
+	if err != nil {
+		return broadcast, err
+	}
+
 	tx := b.db.MustBegin()
 
 	query := fmt.Sprintf(`INSERT INTO %s
-	(id, life, name, owner, description, streamkey , start_time) 
-	values (uuid_generate_v4(), '%s', $1, $2, $3, $4, $5) RETURNING id;`, broadcastTable, models.Created)
+	(id, life, name, owner, description, streamkey , start_time, end_time, capacity, visibility) 
+	values (uuid_generate_v4(), '%s', $1, $2, $3, $4, $5, $6, $7, $8) RETURNING %s;`, broadcastTable, models.Created, broadcastFields)
 
-	row := tx.QueryRowx(query, *item.Name, *item.Owner, *item.Description, *item.StreamKey, *item.StartTime)
-	if err := row.Scan(&broadcast.Id); err != nil {
+	visibility, _ := models.ParseVisibility(item.Visibility)
+	row := tx.QueryRowx(query, *item.Name, *item.Owner, *item.Description, streamKey, *item.StartTime, item.EndTime,
+		item.Capacity, visibility)
+	if err := row.StructScan(&broadcast); err != nil {
 		err = tx.Rollback()
 		if err != nil {
 			return broadcast, err
@@ -120,6 +210,15 @@ func (b *BroadcastsPostgres) CreateBroadcast(item models.PostBroadcast) (models.
 		return broadcast, err
 	}
 
+	if item.Tags != nil {
+		if broadcast.Tags, err = setTags(tx, *broadcast.Id, *item.Tags); err != nil {
+			if e := tx.Rollback(); e != nil {
+				return broadcast, e
+			}
+			return broadcast, err
+		}
+	}
+
 	queryEmpty := fmt.Sprintf("INSERT INTO %s (id) VALUES ('%s');", imagesTable, *broadcast.Id)
 
 	if _, err := tx.Exec(queryEmpty); err != nil {
@@ -130,7 +229,7 @@ func (b *BroadcastsPostgres) CreateBroadcast(item models.PostBroadcast) (models.
 		return broadcast, err
 	}
 
-	err := tx.Commit()
+	err = tx.Commit()
 	if err != nil {
 		return broadcast, err
 	}
@@ -138,19 +237,372 @@ func (b *BroadcastsPostgres) CreateBroadcast(item models.PostBroadcast) (models.
 	return broadcast, nil
 }
 
-func (b *BroadcastsPostgres) ChangeBroadcast(i models.PutBroadcast) (models.Broadcasts, error) {
+// ChangeBroadcast updates the broadcast if its version equals the given one,
+// any version matches when it is nil. Tags are left unchanged when they are nil.
+func (b *BroadcastsPostgres) ChangeBroadcast(i models.PutBroadcast, version *int) (models.Broadcasts, error) {
 	var item models.Broadcasts
 
+	tx := b.db.MustBegin()
+
 	q := fmt.Sprintf(`UPDATE %s
-		SET name = $1, description = $2, streamkey = $3 , start_time = $4 WHERE id = $5 RETURNING *;`,
-		broadcastTable)
+		SET name = $1, description = $2, start_time = $3, end_time = $4
+		WHERE id = $5 AND ($6::integer IS NULL OR version = $6) AND %s RETURNING %s;`,
+		broadcastTable, notDeleted, broadcastFields)
+
+	row := tx.QueryRowx(q, *i.Name, *i.Description, *i.StartTime, i.EndTime, *i.Id, version)
+	if err := row.StructScan(&item); err != nil {
+		if e := tx.Rollback(); e != nil {
+			return item, e
+		}
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+
+	if i.Tags != nil {
+		tags, err := setTags(tx, *item.Id, *i.Tags)
+		if err != nil {
+			if e := tx.Rollback(); e != nil {
+				return models.Broadcasts{}, e
+			}
+			return models.Broadcasts{}, err
+		}
+		item.Tags = tags
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Broadcasts{}, err
+	}
+
+	return item, nil
+}
+
+// PatchBroadcast updates only the columns present in the patch, the patch must not be empty.
+func (b *BroadcastsPostgres) PatchBroadcast(
+	id types.UUID, p models.PatchBroadcast, version *int) (models.Broadcasts, error) {
+	var item models.Broadcasts
+
+	var set []string
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if p.Name != nil {
+		set = append(set, "name = "+arg(*p.Name))
+	}
+	if p.Description != nil {
+		set = append(set, "description = "+arg(*p.Description))
+	}
+	if p.StartTime != nil {
+		set = append(set, "start_time = "+arg(*p.StartTime))
+	}
+	if p.EndTime != nil {
+		set = append(set, "end_time = "+arg(*p.EndTime))
+	}
+	if p.ClearEndTime {
+		set = append(set, "end_time = NULL")
+	}
+	if p.Capacity != nil {
+		set = append(set, "capacity = "+arg(*p.Capacity))
+	}
+	if p.ClearCapacity {
+		set = append(set, "capacity = NULL")
+	}
+	if p.Visibility != nil {
+		set = append(set, "visibility = "+arg(*p.Visibility))
+	}
+
+	idArg, versionArg := arg(id), arg(version)
+	q := fmt.Sprintf(`UPDATE %s SET %s
+		WHERE id = %s AND (%s::integer IS NULL OR version = %s) AND %s RETURNING %s;`,
+		broadcastTable, strings.Join(set, ", "), idArg, versionArg, versionArg, notDeleted, broadcastFields)
+
+	if err := b.db.QueryRowx(q, args...).StructScan(&item); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+
+	return item, nil
+}
+
+func (b *BroadcastsPostgres) RotateStreamKey(id types.UUID) (models.Broadcasts, error) {
+	var item models.Broadcasts
+
+	streamKey, err := models.NewSecret()
+	if err != nil {
+		return item, err
+	}
+
+	query := fmt.Sprintf(`UPDATE %s SET streamkey = $1 WHERE id = $2 AND %s RETURNING %s;`,
+		broadcastTable, notDeleted, broadcastFields)
+	if err := b.db.QueryRowx(query, streamKey, id).StructScan(&item); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+func (b *BroadcastsPostgres) StartBroadcasts(now time.Time) ([]models.Broadcasts, error) {
+	var items = make([]models.Broadcasts, 0)
+	query := fmt.Sprintf(
+		`UPDATE %s SET life='%s' WHERE life='%s' AND start_time <= $1 AND %s RETURNING %s;`,
+		broadcastTable, models.OnAir, models.Created, notDeleted, broadcastFields)
+	if err := b.db.Select(&items, query, now); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// FinishBroadcasts skips broadcasts still published by the media server, they
+// are finished by the end of the stream.
+func (b *BroadcastsPostgres) FinishBroadcasts(now time.Time, duration time.Duration) ([]models.Broadcasts, error) {
+	var items = make([]models.Broadcasts, 0)
+	query := fmt.Sprintf(
+		`UPDATE %s SET life='%s'
+		WHERE life IN ('%s', '%s') AND COALESCE(end_time, start_time + $2 * INTERVAL '1 second') <= $1
+			AND publishing_at IS NULL AND %s
+		RETURNING %s;`,
+		broadcastTable, models.Past, models.Created, models.OnAir, notDeleted, broadcastFields)
+	if err := b.db.Select(&items, query, now, duration.Seconds()); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// SetPublishing marks the broadcast as published by the media server.
+func (b *BroadcastsPostgres) SetPublishing(id types.UUID, publishing bool) error {
+	query := fmt.Sprintf(`UPDATE %s SET publishing_at = CASE WHEN $2 THEN now() END WHERE id = $1;`, broadcastTable)
+	_, err := b.db.Exec(query, id, publishing)
+	return err
+}
 
-	if err := b.db.QueryRowx(q, *i.Name, *i.Description, *i.StreamKey, *i.StartTime, *i.Id).StructScan(&item); err != nil {
+func (b *BroadcastsPostgres) ChangeLifeCycle(id types.UUID, life models.LifeCycleBroadcast) (models.Broadcasts, error) {
+	var item models.Broadcasts
+	query := fmt.Sprintf(`UPDATE %s SET life = $1 WHERE id = $2 AND %s RETURNING %s;`,
+		broadcastTable, notDeleted, broadcastFields)
+	if err := b.db.QueryRowx(query, life.String(), id).StructScan(&item); err != nil {
 		if err == sql.ErrNoRows {
 			return item, nil
 		}
 		return item, err
 	}
+	return item, nil
+}
+
+func (b *BroadcastsPostgres) CreateSeries(item models.PostBroadcast, starts []time.Time) ([]models.Broadcasts, error) {
+	var items = make([]models.Broadcasts, 0, len(starts))
+	var seriesId types.UUID
+
+	tx := b.db.MustBegin()
+
+	qSeries := fmt.Sprintf(`INSERT INTO %s (id, owner, rrule, start_time)
+	VALUES (uuid_generate_v4(), $1, $2, $3) RETURNING id;`, seriesTable)
+	if err := tx.QueryRowx(qSeries, *item.Owner, *item.Rrule, *item.StartTime).Scan(&seriesId); err != nil {
+		if e := tx.Rollback(); e != nil {
+			return nil, e
+		}
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s
+	(id, life, name, owner, description, streamkey, start_time, end_time, series_id, capacity, visibility)
+	VALUES (uuid_generate_v4(), '%s', $1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING %s;`,
+		broadcastTable, models.Created, broadcastFields)
+	qImage := fmt.Sprintf("INSERT INTO %s (id) VALUES ($1);", imagesTable)
+	visibility, _ := models.ParseVisibility(item.Visibility)
+
+	for _, start := range starts {
+		var broadcast models.Broadcasts
+		var end *time.Time
+		if item.EndTime != nil {
+			e := start.Add(item.EndTime.Sub(*item.StartTime))
+			end = &e
+		}
+
+		streamKey, err := models.NewSecret()
+		if err != nil {
+			if e := tx.Rollback(); e != nil {
+				return nil, e
+			}
+			return nil, err
+		}
+
+		row := tx.QueryRowx(query, *item.Name, *item.Owner, *item.Description, streamKey, start, end, seriesId,
+			item.Capacity, visibility)
+		if err := row.StructScan(&broadcast); err != nil {
+			if e := tx.Rollback(); e != nil {
+				return nil, e
+			}
+			return nil, err
+		}
+
+		if item.Tags != nil {
+			if broadcast.Tags, err = setTags(tx, *broadcast.Id, *item.Tags); err != nil {
+				if e := tx.Rollback(); e != nil {
+					return nil, e
+				}
+				return nil, err
+			}
+		}
+
+		if _, err := tx.Exec(qImage, *broadcast.Id); err != nil {
+			if e := tx.Rollback(); e != nil {
+				return nil, e
+			}
+			return nil, err
+		}
+
+		items = append(items, broadcast)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (b *BroadcastsPostgres) GetSeriesBroadcasts(seriesId types.UUID) ([]models.Broadcasts, error) {
+	var items = make([]models.Broadcasts, 0)
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE series_id = $1 AND %s ORDER BY start_time ASC;`,
+		broadcastFields, broadcastTable, notDeleted)
+	if err := b.db.Select(&items, query, seriesId); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ChangeSeriesBroadcasts applies the changes of one occurrence to the upcoming
+// occurrences of its series starting at from, or to all of them when from is nil.
+// Start times are shifted by the same offset as the changed occurrence.
+// Tags are left unchanged when they are nil.
+func (b *BroadcastsPostgres) ChangeSeriesBroadcasts(
+	i models.PutBroadcast, seriesId types.UUID, shift time.Duration, from *time.Time) ([]models.Broadcasts, error) {
+	var items = make([]models.Broadcasts, 0)
+
+	var duration *float64
+	if i.EndTime != nil {
+		d := i.EndTime.Sub(*i.StartTime).Seconds()
+		duration = &d
+	}
+
+	query := fmt.Sprintf(`UPDATE %s
+		SET name = $1, description = $2,
+			start_time = start_time + $3::float8 * INTERVAL '1 second',
+			end_time = start_time + ($3::float8 + $4::float8) * INTERVAL '1 second'
+		WHERE series_id = $5 AND life = '%s' AND ($6::timestamptz IS NULL OR start_time >= $6) AND %s
+		RETURNING %s;`,
+		broadcastTable, models.Created, notDeleted, broadcastFields)
+
+	tx := b.db.MustBegin()
+
+	err := tx.Select(&items, query, *i.Name, *i.Description, shift.Seconds(), duration, seriesId, from)
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return nil, e
+		}
+		return nil, err
+	}
+
+	if i.Tags != nil {
+		for n := range items {
+			if items[n].Tags, err = setTags(tx, *items[n].Id, *i.Tags); err != nil {
+				if e := tx.Rollback(); e != nil {
+					return nil, e
+				}
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (b *BroadcastsPostgres) DeleteSeriesBroadcasts(
+	seriesId types.UUID, from *time.Time, deletedBy *string) ([]api.SIdentifier, error) {
+	var items = make([]api.SIdentifier, 0)
+	query := fmt.Sprintf(`UPDATE %s SET deleted_at = now(), deleted_by = $3
+		WHERE series_id = $1 AND life = '%s' AND ($2::timestamptz IS NULL OR start_time >= $2) AND %s
+		RETURNING id;`,
+		broadcastTable, models.Created, notDeleted)
+	if err := b.db.Select(&items, query, seriesId, from, deletedBy); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// GetConflicts returns upcoming and live broadcasts of the slot owner or stream key
+// overlapping the slot, excluded broadcasts are skipped.
+func (b *BroadcastsPostgres) GetConflicts(
+	slot models.Slot, exclude []types.UUID, duration time.Duration) ([]models.Broadcasts, error) {
+	var items = make([]models.Broadcasts, 0)
+
+	ids := make([]string, 0, len(exclude))
+	for _, id := range exclude {
+		ids = append(ids, id.String())
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM %s
+		WHERE life <> '%s' AND NOT (id = ANY($1::uuid[])) AND (owner = $2 OR streamkey = $3)
+			AND start_time < $5 AND COALESCE(end_time, start_time + $6 * INTERVAL '1 second') > $4 AND %s
+		ORDER BY start_time ASC;`,
+		broadcastFields, broadcastTable, models.Past, notDeleted)
+	err := b.db.Select(&items, query, pq.Array(ids), slot.Owner, slot.StreamKey, slot.Start, slot.End, duration.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (b *BroadcastsPostgres) GetOnAir() ([]api.SIdentifier, error) {
+	var items = make([]api.SIdentifier, 0)
+	query := fmt.Sprintf(`SELECT id FROM %s WHERE life = '%s' AND %s;`, broadcastTable, models.OnAir, notDeleted)
+	if err := b.db.Select(&items, query); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (b *BroadcastsPostgres) GetTrash() ([]models.Broadcasts, error) {
+	var items = make([]models.Broadcasts, 0)
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC;`,
+		broadcastFields, broadcastTable)
+	if err := b.db.Select(&items, query); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
 
+func (b *BroadcastsPostgres) RestoreBroadcast(id types.UUID) (models.Broadcasts, error) {
+	var item models.Broadcasts
+	query := fmt.Sprintf(`UPDATE %s SET deleted_at = NULL, deleted_by = NULL
+		WHERE id = $1 AND deleted_at IS NOT NULL RETURNING %s;`, broadcastTable, broadcastFields)
+	if err := b.db.QueryRowx(query, id).StructScan(&item); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
 	return item, nil
 }
+
+// PurgeTrash permanently deletes broadcasts trashed before the given time,
+// images and files are removed by the cascade.
+func (b *BroadcastsPostgres) PurgeTrash(before time.Time) ([]api.SIdentifier, error) {
+	var items = make([]api.SIdentifier, 0)
+	query := fmt.Sprintf(`DELETE FROM %s WHERE deleted_at < $1 RETURNING id;`, broadcastTable)
+	if err := b.db.Select(&items, query, before); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/alexm24/golang/internal/models"
+)
+
+const calendarFeedsTable = "calendar_feeds"
+
+type CalendarPostgres struct {
+	db *sqlx.DB
+}
+
+func NewCalendarPostgres(db *sqlx.DB) *CalendarPostgres {
+	return &CalendarPostgres{db}
+}
+
+func (c *CalendarPostgres) SaveCalendarFeed(feed models.CalendarFeed) (models.CalendarFeed, error) {
+	var item models.CalendarFeed
+	query := fmt.Sprintf(
+		`INSERT INTO %s (token, username, scope) VALUES ($1, $2, $3)
+		ON CONFLICT (username, scope) DO UPDATE SET token = EXCLUDED.token, created_at = now()
+		RETURNING token, username, scope;`, calendarFeedsTable)
+	if err := c.db.QueryRowx(query, feed.Token, feed.Username, feed.Scope).StructScan(&item); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+func (c *CalendarPostgres) GetCalendarFeed(token string) (models.CalendarFeed, error) {
+	var item models.CalendarFeed
+	query := fmt.Sprintf("SELECT token, username, scope FROM %s WHERE token = $1", calendarFeedsTable)
+	if err := c.db.Get(&item, query, token); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+// GetCalendarEvents returns upcoming broadcasts of the owner, or of everyone
+// when owner is nil, that the viewer can see.
+func (c *CalendarPostgres) GetCalendarEvents(owner *string, viewer string) ([]models.CalendarEvent, error) {
+	var items = make([]models.CalendarEvent, 0)
+	query := fmt.Sprintf(
+		`SELECT id, name, description, start_time, end_time, updated_at, sequence FROM %s
+		WHERE life <> '%s' AND %s AND ($1::text IS NULL OR owner = $1) AND %s
+		ORDER BY start_time;`, broadcastTable, models.Past, notDeleted, visibleTo("", "$2"))
+	if err := c.db.Select(&items, query, owner, viewer); err != nil {
+		return items, err
+	}
+	return items, nil
+}
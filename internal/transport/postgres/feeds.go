@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/alexm24/golang/internal/models"
+)
+
+type FeedsPostgres struct {
+	db *sqlx.DB
+}
+
+func NewFeedsPostgres(db *sqlx.DB) *FeedsPostgres {
+	return &FeedsPostgres{db}
+}
+
+// GetArchiveEntries returns past public broadcasts, latest first, with their
+// last recording. A broadcast must have all tags of the filter.
+func (f *FeedsPostgres) GetArchiveEntries(filter models.FeedFilter) ([]models.FeedEntry, error) {
+	var items = make([]models.FeedEntry, 0)
+	query := fmt.Sprintf(
+		`SELECT id, name, description, owner, start_time, updated_at, previewurl, %s,
+			(SELECT r.id FROM %s r WHERE r.broadcast_id = %s.id ORDER BY r.created_at DESC LIMIT 1) AS recording_id,
+			(SELECT r.format FROM %s r WHERE r.broadcast_id = %s.id ORDER BY r.created_at DESC LIMIT 1) AS recording_format
+		FROM %s WHERE life = '%s' AND %s AND %s AND ($1::text IS NULL OR owner = $1)
+			AND ($2::text[] IS NULL OR id IN (SELECT bt.broadcast_id FROM %s bt JOIN %s t ON t.id = bt.tag_id
+				WHERE t.name = ANY($2) GROUP BY bt.broadcast_id HAVING count(*) = cardinality($2::text[])))
+		ORDER BY start_time DESC LIMIT $3;`,
+		broadcastTags, recordingsTable, broadcastTable, recordingsTable, broadcastTable, broadcastTable, models.Past,
+		notDeleted, visibleTo("", "NULL"), broadcastTagsTable, tagsTable)
+	if err := f.db.Select(&items, query, filter.Owner, pq.Array(filter.Tags), filter.Limit); err != nil {
+		return items, err
+	}
+	return items, nil
+}
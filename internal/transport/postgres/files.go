@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+const filesTable = "files"
+
+const fileInfoFields = "id, broadcast_id, name, type, size"
+
+type FilesPostgres struct {
+	db *sqlx.DB
+}
+
+func NewFilesPostgres(db *sqlx.DB) *FilesPostgres {
+	return &FilesPostgres{db}
+}
+
+func (f *FilesPostgres) CreateFile(item models.File) (models.FileInfo, error) {
+	var info models.FileInfo
+	query := fmt.Sprintf(`INSERT INTO %s (id, broadcast_id, name, type, size, file)
+	VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5) RETURNING %s;`, filesTable, fileInfoFields)
+	row := f.db.QueryRowx(query, *item.BroadcastId, *item.Name, *item.Type, *item.Size, item.Data)
+	if err := row.StructScan(&info); err != nil {
+		return info, err
+	}
+	return info, nil
+}
+
+func (f *FilesPostgres) GetFiles(broadcastId types.UUID) ([]models.FileInfo, error) {
+	var items = make([]models.FileInfo, 0)
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE broadcast_id = $1 ORDER BY name;`, fileInfoFields, filesTable)
+	if err := f.db.Select(&items, query, broadcastId); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (f *FilesPostgres) GetFileById(id types.UUID) (models.File, error) {
+	var item models.File
+	query := fmt.Sprintf(`SELECT %s, file FROM %s WHERE id = $1;`, fileInfoFields, filesTable)
+	if err := f.db.Get(&item, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+func (f *FilesPostgres) GetFileInfo(id types.UUID) (models.FileInfo, error) {
+	var item models.FileInfo
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE id = $1;`, fileInfoFields, filesTable)
+	if err := f.db.Get(&item, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+func (f *FilesPostgres) DeleteFile(id types.UUID) (api.SIdentifier, error) {
+	var item api.SIdentifier
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = $1 RETURNING id;`, filesTable)
+	if err := f.db.QueryRowx(query, id).StructScan(&item); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
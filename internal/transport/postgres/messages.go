@@ -1,17 +1,35 @@
 package postgres
 
 import (
+	"database/sql"
 	"fmt"
 
+	"github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/jmoiron/sqlx"
 
+	"github.com/alexm24/golang/internal/handler/api"
 	"github.com/alexm24/golang/internal/models"
 )
 
 const (
-	messagesTable = "messages"
+	messagesTable     = "messages"
+	messageEditsTable = "message_edits"
+	messageFields     = "id, fullname, text, time, username, avatar, is_question, is_anon, reactions, edited_at, deleted_at, parent_id, created_at"
+	// messageNotDeleted excludes tombstones of deleted messages.
+	messageNotDeleted = "deleted_at IS NULL"
+	replyCount        = "(SELECT count(*) FROM messages r WHERE r.parent_id = messages.id) AS reply_count"
 )
 
+// publicMessage matches messages shown to everyone, questions which are not
+// approved or answered are shown to moderators only. alias prefixes the columns.
+func publicMessage(alias string) string {
+	return fmt.Sprintf("(NOT %[1]sis_question OR %[1]squestion_state IN ('%[2]s', '%[3]s'))",
+		alias, models.QuestionApproved, models.QuestionAnswered)
+}
+
+// messageHidden selects Messages.Hidden.
+var messageHidden = fmt.Sprintf("NOT COALESCE(%s, false) AS hidden", publicMessage(""))
+
 type MessagesPostgres struct {
 	db *sqlx.DB
 }
@@ -24,9 +42,8 @@ func (m *MessagesPostgres) GetMessageByChannel(channel string) ([]models.Message
 	var msg = make([]models.Messages, 0)
 
 	query := fmt.Sprintf(
-		`SELECT id, fullname, text, time, username, avatar, is_question, is_anon, reactions
-		FROM %s WHERE channel = $1 ORDER by time ASC;`,
-		messagesTable)
+		`SELECT %s FROM %s WHERE channel = $1 AND %s ORDER by time ASC;`,
+		messageFields, messagesTable, messageNotDeleted)
 
 	if err := m.db.Select(&msg, query, channel); err != nil {
 		return msg, err
@@ -35,16 +52,75 @@ func (m *MessagesPostgres) GetMessageByChannel(channel string) ([]models.Message
 	return msg, nil
 }
 
+// GetMessagesPage reads one row more than the limit to know whether the page
+// is followed by more messages, newer ones are read forward from After and
+// older ones backward from Before or the end of the history.
+func (m *MessagesPostgres) GetMessagesPage(f models.MessageFilter) (models.MessagesPage, error) {
+	var page = models.MessagesPage{Items: make([]models.Messages, 0)}
+
+	args := []interface{}{f.Channel, f.Limit + 1}
+	cond, order := "", "DESC"
+	switch {
+	case f.After != nil:
+		cond, order = "AND (time, id) > ($3::timestamptz, $4)", "ASC"
+		args = append(args, f.After.Value, f.After.Id)
+	case f.Before != nil:
+		cond = "AND (time, id) < ($3::timestamptz, $4)"
+		args = append(args, f.Before.Value, f.Before.Id)
+	}
+	if !f.Moderator {
+		cond += " AND " + publicMessage("")
+	}
+
+	query := fmt.Sprintf(
+		`SELECT %s, %s FROM %s WHERE channel = $1 AND parent_id IS NULL %s ORDER BY time %s, id %s LIMIT $2;`,
+		messageFields, replyCount, messagesTable, cond, order, order)
+
+	if err := m.db.Select(&page.Items, query, args...); err != nil {
+		return page, err
+	}
+
+	more := len(page.Items) > f.Limit
+	if more {
+		page.Items = page.Items[:f.Limit]
+	}
+	if f.After == nil {
+		for i, j := 0, len(page.Items)-1; i < j; i, j = i+1, j-1 {
+			page.Items[i], page.Items[j] = page.Items[j], page.Items[i]
+		}
+	}
+	if len(page.Items) == 0 {
+		return page, nil
+	}
+
+	first, last := models.MessageCursor(page.Items[0]), models.MessageCursor(page.Items[len(page.Items)-1])
+	if f.After != nil {
+		page.Prev = &first
+		if more {
+			page.Next = &last
+		}
+		return page, nil
+	}
+	if more {
+		page.Prev = &first
+	}
+	if f.Before != nil {
+		page.Next = &last
+	}
+
+	return page, nil
+}
+
 func (m *MessagesPostgres) CreateMsg(channel string, msg models.PostMessage) (models.Messages, error) {
 	var resMsg models.Messages
 
 	q := fmt.Sprintf(
 		`INSERT INTO %s 
-		(id, channel, username, fullname, text, avatar, time, is_question, is_anon) 
+		(id, channel, username, fullname, text, avatar, time, is_question, is_anon, parent_id, question_state) 
 		VALUES 
-		(uuid_generate_v4(), $1, $2, $3, $4, $5, $6, $7, $8) RETURNING *;`,
-		messagesTable)
-	row := m.db.QueryRowx(q, channel, *msg.Username, *msg.Fullname, *msg.Text, *msg.Avatar, *msg.Time, *msg.IsQuestion, *msg.IsAnon)
+		(uuid_generate_v4(), $1, $2, $3, $4, $5, $6, $7, $8, $9, CASE WHEN $7 THEN '%s' END) RETURNING %s, channel, %s;`,
+		messagesTable, models.QuestionPending, messageFields, messageHidden)
+	row := m.db.QueryRowx(q, channel, *msg.Username, *msg.Fullname, *msg.Text, *msg.Avatar, *msg.Time, *msg.IsQuestion, *msg.IsAnon, msg.ParentId)
 
 	err := row.StructScan(&resMsg)
 	if err != nil {
@@ -54,6 +130,85 @@ func (m *MessagesPostgres) CreateMsg(channel string, msg models.PostMessage) (mo
 	return resMsg, nil
 }
 
+// GetReplies returns questions among the replies which are not public to moderators only.
+func (m *MessagesPostgres) GetReplies(id types.UUID, moderator bool) ([]models.Messages, error) {
+	var items = make([]models.Messages, 0)
+	q := fmt.Sprintf("SELECT %s FROM %s WHERE parent_id = $1 AND ($2 OR %s) ORDER BY time ASC, id ASC;",
+		messageFields, messagesTable, publicMessage(""))
+	if err := m.db.Select(&items, q, id, moderator); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (m *MessagesPostgres) GetMessageById(id types.UUID) (models.Messages, error) {
+	var msg models.Messages
+	q := fmt.Sprintf("SELECT %s, channel, %s FROM %s WHERE id = $1;", messageFields, messageHidden, messagesTable)
+	if err := m.db.Get(&msg, q, id); err != nil {
+		if err == sql.ErrNoRows {
+			return msg, nil
+		}
+		return msg, err
+	}
+	return msg, nil
+}
+
+// EditMessage keeps the previous text in the edit history and changes the
+// text of the message in one transaction.
+func (m *MessagesPostgres) EditMessage(id types.UUID, text string, editor string) (models.Messages, error) {
+	var msg models.Messages
+
+	tx, err := m.db.Beginx()
+	if err != nil {
+		return msg, err
+	}
+
+	qEdit := fmt.Sprintf(`INSERT INTO %s (id, message_id, text, edited_by)
+		SELECT uuid_generate_v4(), id, text, $2 FROM %s WHERE id = $1;`, messageEditsTable, messagesTable)
+	if _, err = tx.Exec(qEdit, id, editor); err != nil {
+		if e := tx.Rollback(); e != nil {
+			return msg, e
+		}
+		return msg, err
+	}
+
+	q := fmt.Sprintf(`UPDATE %s SET text = $2, edited_at = now() WHERE id = $1 RETURNING %s, channel, %s;`,
+		messagesTable, messageFields, messageHidden)
+	if err = tx.Get(&msg, q, id, text); err != nil {
+		if e := tx.Rollback(); e != nil {
+			return msg, e
+		}
+		return msg, err
+	}
+
+	return msg, tx.Commit()
+}
+
+// DeleteMessage leaves a tombstone in place of the message, so that its
+// replies and edit history are kept.
+func (m *MessagesPostgres) DeleteMessage(id types.UUID) (api.SIdentifier, error) {
+	var item api.SIdentifier
+	q := fmt.Sprintf(`UPDATE %s SET text = '', reactions = '{}'::jsonb, pinned_at = NULL, deleted_at = now()
+		WHERE id = $1 AND %s RETURNING id;`, messagesTable, messageNotDeleted)
+	if err := m.db.Get(&item, q, id); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+func (m *MessagesPostgres) GetMessageEdits(id types.UUID) ([]models.MessageEdit, error) {
+	var items = make([]models.MessageEdit, 0)
+	q := fmt.Sprintf(`SELECT id, message_id, text, edited_by, edited_at FROM %s
+		WHERE message_id = $1 ORDER BY edited_at DESC;`, messageEditsTable)
+	if err := m.db.Select(&items, q, id); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
 func (m *MessagesPostgres) DeleteMessages(channel string) error {
 	query := fmt.Sprintf("DELETE FROM %s WHERE channel=$1", messagesTable)
 	_, err := m.db.Exec(query, channel)
@@ -66,8 +221,8 @@ func (m *MessagesPostgres) DeleteMessages(channel string) error {
 func (m *MessagesPostgres) AddReaction(item models.PostReactionMsg) (models.Messages, error) {
 	var msg models.Messages
 	q := fmt.Sprintf(
-		`UPDATE %s SET reactions = reactions || '{ "%s": "%s" }'::jsonb WHERE id=$1 RETURNING *;`,
-		messagesTable, *item.Username, *item.Type)
+		`UPDATE %s SET reactions = reactions || '{ "%s": "%s" }'::jsonb WHERE id=$1 AND %s RETURNING %s, channel;`,
+		messagesTable, *item.Username, *item.Type, messageNotDeleted, messageFields)
 	row := m.db.QueryRowx(q, *item.Id)
 	err := row.StructScan(&msg)
 	return msg, err
@@ -75,7 +230,8 @@ func (m *MessagesPostgres) AddReaction(item models.PostReactionMsg) (models.Mess
 
 func (m *MessagesPostgres) DeleteReaction(item models.PatchReactionMsg) (models.Messages, error) {
 	var msg models.Messages
-	q := fmt.Sprintf(`UPDATE %s SET reactions = reactions - $1 WHERE id=$2 RETURNING *;`, messagesTable)
+	q := fmt.Sprintf(`UPDATE %s SET reactions = reactions - $1 WHERE id=$2 RETURNING %s, channel;`,
+		messagesTable, messageFields)
 	row := m.db.QueryRowx(q, *item.Username, *item.Id)
 	err := row.StructScan(&msg)
 	return msg, err
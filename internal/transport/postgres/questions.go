@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/alexm24/golang/internal/models"
+)
+
+const questionVotesTable = "question_votes"
+
+var questionFields = fmt.Sprintf(`%s, channel, question_state,
+	(SELECT count(*) FROM %s v WHERE v.message_id = messages.id) AS votes, pinned_at IS NOT NULL AS pinned`,
+	messageFields, questionVotesTable)
+
+type QuestionsPostgres struct {
+	db *sqlx.DB
+}
+
+func NewQuestionsPostgres(db *sqlx.DB) *QuestionsPostgres {
+	return &QuestionsPostgres{db}
+}
+
+// GetQuestions returns the pinned question first and the others by votes,
+// questions in any state are returned for empty states.
+func (q *QuestionsPostgres) GetQuestions(channel string, states []models.QuestionState) ([]models.Question, error) {
+	var items = make([]models.Question, 0)
+
+	var names []string
+	for _, s := range states {
+		names = append(names, string(s))
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM %s
+		WHERE channel = $1 AND is_question AND %s AND ($2::text[] IS NULL OR question_state = ANY($2))
+		ORDER BY pinned DESC, votes DESC, time ASC, id ASC;`, questionFields, messagesTable, messageNotDeleted)
+	if err := q.db.Select(&items, query, channel, pq.Array(names)); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (q *QuestionsPostgres) GetQuestionById(id types.UUID) (models.Question, error) {
+	var item models.Question
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id = $1 AND is_question AND %s;",
+		questionFields, messagesTable, messageNotDeleted)
+	if err := q.db.Get(&item, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+// Vote counts repeated votes of the user once.
+func (q *QuestionsPostgres) Vote(id types.UUID, username string) error {
+	query := fmt.Sprintf(`INSERT INTO %s (message_id, username) VALUES ($1, $2)
+		ON CONFLICT (message_id, username) DO NOTHING;`, questionVotesTable)
+	_, err := q.db.Exec(query, id, username)
+	return err
+}
+
+func (q *QuestionsPostgres) Unvote(id types.UUID, username string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE message_id = $1 AND username = $2;", questionVotesTable)
+	_, err := q.db.Exec(query, id, username)
+	return err
+}
+
+func (q *QuestionsPostgres) SetQuestionState(id types.UUID, state models.QuestionState) error {
+	query := fmt.Sprintf(`UPDATE %s SET question_state = $2, pinned_at = CASE WHEN $3 THEN NULL ELSE pinned_at END
+		WHERE id = $1;`, messagesTable)
+	_, err := q.db.Exec(query, id, state, state.Closed())
+	return err
+}
+
+// PinQuestion unpins the other question of the channel in the same
+// transaction, so a channel has one pinned question at most.
+func (q *QuestionsPostgres) PinQuestion(channel string, id types.UUID) error {
+	tx, err := q.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	qUnpin := fmt.Sprintf(`UPDATE %s SET pinned_at = NULL
+		WHERE channel = $1 AND pinned_at IS NOT NULL AND id <> $2;`, messagesTable)
+	if _, err = tx.Exec(qUnpin, channel, id); err != nil {
+		if e := tx.Rollback(); e != nil {
+			return e
+		}
+		return err
+	}
+
+	qPin := fmt.Sprintf("UPDATE %s SET pinned_at = now() WHERE id = $1 AND pinned_at IS NULL;", messagesTable)
+	if _, err = tx.Exec(qPin, id); err != nil {
+		if e := tx.Rollback(); e != nil {
+			return e
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (q *QuestionsPostgres) UnpinQuestion(id types.UUID) error {
+	query := fmt.Sprintf("UPDATE %s SET pinned_at = NULL WHERE id = $1;", messagesTable)
+	_, err := q.db.Exec(query, id)
+	return err
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+const recordingsTable = "recordings"
+
+const recordingFields = "id, broadcast_id, path, url, duration, size, format, created_by, created_at"
+
+type RecordingsPostgres struct {
+	db *sqlx.DB
+}
+
+func NewRecordingsPostgres(db *sqlx.DB) *RecordingsPostgres {
+	return &RecordingsPostgres{db}
+}
+
+func (r *RecordingsPostgres) CreateRecording(item models.Recording) (models.Recording, error) {
+	var recording models.Recording
+	query := fmt.Sprintf(`INSERT INTO %s (id, broadcast_id, path, url, duration, size, format, created_by)
+		VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5, $6, $7) RETURNING %s;`, recordingsTable, recordingFields)
+	err := r.db.Get(&recording, query,
+		item.BroadcastId, item.Path, item.Url, item.Duration, item.Size, item.Format, item.CreatedBy)
+	if err != nil {
+		return recording, err
+	}
+	return recording, nil
+}
+
+// GetRecordings returns recordings of the broadcasts in the order they were made.
+func (r *RecordingsPostgres) GetRecordings(broadcastIds []types.UUID) ([]models.Recording, error) {
+	var items = make([]models.Recording, 0)
+	ids := make([]string, len(broadcastIds))
+	for i, id := range broadcastIds {
+		ids[i] = id.String()
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE broadcast_id = ANY($1::uuid[]) ORDER BY created_at;",
+		recordingFields, recordingsTable)
+	if err := r.db.Select(&items, query, pq.Array(ids)); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (r *RecordingsPostgres) GetRecordingById(id types.UUID) (models.Recording, error) {
+	var item models.Recording
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id = $1;", recordingFields, recordingsTable)
+	if err := r.db.Get(&item, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+func (r *RecordingsPostgres) DeleteRecording(id types.UUID) (api.SIdentifier, error) {
+	var item api.SIdentifier
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = $1 RETURNING id;", recordingsTable)
+	if err := r.db.QueryRowx(query, id).StructScan(&item); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/alexm24/golang/internal/models"
+)
+
+const registrationsTable = "registrations"
+
+const registrationFields = "id, broadcast_id, username, fullname, email, status, created_at"
+
+type RegistrationsPostgres struct {
+	db *sqlx.DB
+}
+
+func NewRegistrationsPostgres(db *sqlx.DB) *RegistrationsPostgres {
+	return &RegistrationsPostgres{db}
+}
+
+// Register confirms the user while the broadcast has free seats and puts the
+// user on the waitlist after that. The broadcast row is locked, so concurrent
+// registrations can not exceed the capacity. Repeated registrations return the
+// existing one with created false, an empty registration is returned for
+// unknown broadcasts.
+func (r *RegistrationsPostgres) Register(
+	broadcastId types.UUID, user models.PostParticipant) (item models.Registration, created bool, err error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return item, false, err
+	}
+	rollback := func(err error) (models.Registration, bool, error) {
+		if e := tx.Rollback(); e != nil {
+			return item, false, e
+		}
+		return item, false, err
+	}
+
+	var capacity *int
+	qLock := fmt.Sprintf(`SELECT capacity FROM %s WHERE id = $1 AND %s FOR UPDATE;`, broadcastTable, notDeleted)
+	if err = tx.Get(&capacity, qLock, broadcastId); err != nil {
+		if err == sql.ErrNoRows {
+			return rollback(nil)
+		}
+		return rollback(err)
+	}
+
+	qExisting := fmt.Sprintf(`SELECT %s FROM %s WHERE broadcast_id = $1 AND username = $2;`,
+		registrationFields, registrationsTable)
+	err = tx.Get(&item, qExisting, broadcastId, *user.Username)
+	if err == nil {
+		return item, false, tx.Commit()
+	}
+	if err != sql.ErrNoRows {
+		return rollback(err)
+	}
+
+	status := models.RegistrationConfirmed
+	if capacity != nil {
+		var confirmed int
+		qCount := fmt.Sprintf(`SELECT count(*) FROM %s WHERE broadcast_id = $1 AND status = $2;`, registrationsTable)
+		if err = tx.Get(&confirmed, qCount, broadcastId, models.RegistrationConfirmed); err != nil {
+			return rollback(err)
+		}
+		if confirmed >= *capacity {
+			status = models.RegistrationWaitlisted
+		}
+	}
+
+	qInsert := fmt.Sprintf(`INSERT INTO %s (id, broadcast_id, username, fullname, email, status)
+		VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5) RETURNING %s;`, registrationsTable, registrationFields)
+	row := tx.QueryRowx(qInsert, broadcastId, *user.Username, user.Fullname, user.Email, status)
+	if err = row.StructScan(&item); err != nil {
+		return rollback(err)
+	}
+
+	return item, true, tx.Commit()
+}
+
+// Unregister removes the registration and, when a confirmed seat is freed,
+// confirms the first user on the waitlist.
+func (r *RegistrationsPostgres) Unregister(
+	broadcastId types.UUID, username string) (removed models.Registration, promoted models.Registration, err error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return removed, promoted, err
+	}
+	rollback := func(err error) (models.Registration, models.Registration, error) {
+		if e := tx.Rollback(); e != nil {
+			return removed, promoted, e
+		}
+		return removed, promoted, err
+	}
+
+	var capacity *int
+	qLock := fmt.Sprintf(`SELECT capacity FROM %s WHERE id = $1 FOR UPDATE;`, broadcastTable)
+	if err = tx.Get(&capacity, qLock, broadcastId); err != nil {
+		if err == sql.ErrNoRows {
+			return rollback(nil)
+		}
+		return rollback(err)
+	}
+
+	qDelete := fmt.Sprintf(`DELETE FROM %s WHERE broadcast_id = $1 AND username = $2 RETURNING %s;`,
+		registrationsTable, registrationFields)
+	if err = tx.QueryRowx(qDelete, broadcastId, username).StructScan(&removed); err != nil {
+		if err == sql.ErrNoRows {
+			return rollback(nil)
+		}
+		return rollback(err)
+	}
+
+	if models.RegistrationStatus(*removed.Status) == models.RegistrationConfirmed {
+		qPromote := fmt.Sprintf(`UPDATE %s SET status = $2
+			WHERE id = (SELECT id FROM %s WHERE broadcast_id = $1 AND status = $3 ORDER BY created_at LIMIT 1)
+			  AND ($4::integer IS NULL OR (SELECT count(*) FROM %s WHERE broadcast_id = $1 AND status = $2) < $4)
+			RETURNING %s;`, registrationsTable, registrationsTable, registrationsTable, registrationFields)
+		row := tx.QueryRowx(qPromote, broadcastId, models.RegistrationConfirmed, models.RegistrationWaitlisted, capacity)
+		if err = row.StructScan(&promoted); err != nil && err != sql.ErrNoRows {
+			return rollback(err)
+		}
+	}
+
+	return removed, promoted, tx.Commit()
+}
+
+// PromoteWaitlist confirms users from the waitlist in the order they registered
+// while the broadcast has free seats, e.g. after its capacity was raised or cleared.
+func (r *RegistrationsPostgres) PromoteWaitlist(broadcastId types.UUID) ([]models.Registration, error) {
+	var items = make([]models.Registration, 0)
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return items, err
+	}
+	rollback := func(err error) ([]models.Registration, error) {
+		if e := tx.Rollback(); e != nil {
+			return items, e
+		}
+		return items, err
+	}
+
+	var capacity *int
+	qLock := fmt.Sprintf(`SELECT capacity FROM %s WHERE id = $1 FOR UPDATE;`, broadcastTable)
+	if err = tx.Get(&capacity, qLock, broadcastId); err != nil {
+		if err == sql.ErrNoRows {
+			return rollback(nil)
+		}
+		return rollback(err)
+	}
+
+	qPromote := fmt.Sprintf(`UPDATE %s SET status = $2
+		WHERE id IN (SELECT id FROM %s WHERE broadcast_id = $1 AND status = $3 ORDER BY created_at
+			LIMIT CASE WHEN $4::integer IS NULL THEN NULL
+				ELSE GREATEST($4 - (SELECT count(*) FROM %s WHERE broadcast_id = $1 AND status = $2), 0) END)
+		RETURNING %s;`, registrationsTable, registrationsTable, registrationsTable, registrationFields)
+	if err = tx.Select(&items, qPromote, broadcastId, models.RegistrationConfirmed, models.RegistrationWaitlisted, capacity); err != nil {
+		return rollback(err)
+	}
+
+	return items, tx.Commit()
+}
+
+func (r *RegistrationsPostgres) GetRegistrationCounts(broadcastId types.UUID) (models.RegistrationCounts, error) {
+	var item models.RegistrationCounts
+	query := fmt.Sprintf(`SELECT b.capacity,
+			count(r.id) FILTER (WHERE r.status = $2) AS confirmed,
+			count(r.id) FILTER (WHERE r.status = $3) AS waitlisted
+		FROM %s b LEFT JOIN %s r ON r.broadcast_id = b.id
+		WHERE b.id = $1 GROUP BY b.capacity;`, broadcastTable, registrationsTable)
+	if err := r.db.Get(&item, query, broadcastId, models.RegistrationConfirmed, models.RegistrationWaitlisted); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/alexm24/golang/internal/models"
+)
+
+const reportMailsTable = "report_mails"
+
+const reportMailFields = "broadcast_id, email, created_at, sent_at"
+
+type ReportsPostgres struct {
+	db *sqlx.DB
+}
+
+func NewReportsPostgres(db *sqlx.DB) *ReportsPostgres {
+	return &ReportsPostgres{db}
+}
+
+// SaveReportMail sets the address of the report, a changed address is mailed again.
+func (r *ReportsPostgres) SaveReportMail(broadcastId types.UUID, email string) (models.ReportMail, error) {
+	var item models.ReportMail
+	query := fmt.Sprintf(`INSERT INTO %s (broadcast_id, email) VALUES ($1, $2)
+		ON CONFLICT (broadcast_id) DO UPDATE SET email = EXCLUDED.email, created_at = now(), sent_at = NULL
+		RETURNING %s;`, reportMailsTable, reportMailFields)
+	if err := r.db.Get(&item, query, broadcastId, email); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+func (r *ReportsPostgres) DeleteReportMail(broadcastId types.UUID) (models.ReportMail, error) {
+	var item models.ReportMail
+	query := fmt.Sprintf("DELETE FROM %s WHERE broadcast_id = $1 RETURNING %s;", reportMailsTable, reportMailFields)
+	if err := r.db.Get(&item, query, broadcastId); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+// GetPendingReportMails returns not sent mails of past broadcasts.
+func (r *ReportsPostgres) GetPendingReportMails() ([]models.ReportMail, error) {
+	var items = make([]models.ReportMail, 0)
+	query := fmt.Sprintf(`SELECT r.broadcast_id, r.email, r.created_at, r.sent_at FROM %s r
+		JOIN %s b ON b.id = r.broadcast_id
+		WHERE r.sent_at IS NULL AND b.life = '%s' AND b.%s ORDER BY r.created_at;`,
+		reportMailsTable, broadcastTable, models.Past, notDeleted)
+	if err := r.db.Select(&items, query); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (r *ReportsPostgres) SetReportMailSent(broadcastId types.UUID, sentAt time.Time) error {
+	query := fmt.Sprintf("UPDATE %s SET sent_at = $2 WHERE broadcast_id = $1;", reportMailsTable)
+	_, err := r.db.Exec(query, broadcastId, sentAt)
+	return err
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/alexm24/golang/internal/models"
+)
+
+const (
+	revisionsTable = "revisions"
+	revisionFields = "id, broadcast_id, action, actor, changes, snapshot, created_at"
+)
+
+type RevisionsPostgres struct {
+	db *sqlx.DB
+}
+
+func NewRevisionsPostgres(db *sqlx.DB) *RevisionsPostgres {
+	return &RevisionsPostgres{db}
+}
+
+func (r *RevisionsPostgres) CreateRevision(item models.Revision) (models.Revision, error) {
+	var revision models.Revision
+	query := fmt.Sprintf(`INSERT INTO %s (id, broadcast_id, action, actor, changes, snapshot)
+		VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5) RETURNING %s;`, revisionsTable, revisionFields)
+	row := r.db.QueryRowx(query, item.BroadcastId, item.Action, item.Actor, item.Changes, item.Snapshot)
+	if err := row.StructScan(&revision); err != nil {
+		return revision, err
+	}
+	return revision, nil
+}
+
+func (r *RevisionsPostgres) GetRevisions(broadcastId types.UUID) ([]models.Revision, error) {
+	var items = make([]models.Revision, 0)
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE broadcast_id = $1 ORDER BY created_at DESC;`,
+		revisionFields, revisionsTable)
+	if err := r.db.Select(&items, query, broadcastId); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *RevisionsPostgres) GetRevisionById(id types.UUID) (models.Revision, error) {
+	var item models.Revision
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE id = $1;`, revisionFields, revisionsTable)
+	if err := r.db.Get(&item, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+// The vectors must match the expressions of the GIN indexes from the search migration.
+const (
+	broadcastVector = `(setweight(to_tsvector('russian', b.name), 'A') || setweight(to_tsvector('english', b.name), 'A') ||
+		setweight(to_tsvector('russian', b.description), 'B') || setweight(to_tsvector('english', b.description), 'B'))`
+	messageVector = `(to_tsvector('russian', m.text) || to_tsvector('english', m.text))`
+	searchQuery   = `(SELECT websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS q) query`
+	headlineOpts  = `'StartSel=<b>, StopSel=</b>, MaxFragments=2'`
+)
+
+// escapeHTML wraps a text expression so that ts_headline only ever adds its own <b> markers
+// to the escaped source and the headline is safe to render as HTML.
+func escapeHTML(expr string) string {
+	return fmt.Sprintf(`replace(replace(replace(replace(%s, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;')`, expr)
+}
+
+type SearchPostgres struct {
+	db *sqlx.DB
+}
+
+func NewSearchPostgres(db *sqlx.DB) *SearchPostgres {
+	return &SearchPostgres{db}
+}
+
+func (s *SearchPostgres) SearchBroadcasts(q models.SearchQuery) ([]api.SSearchBroadcast, error) {
+	var items = make([]api.SSearchBroadcast, 0)
+
+	query := fmt.Sprintf(
+		`SELECT b.id, b.name, b.owner, b.life, b.start_time,
+			ts_rank(%[1]s, query.q) AS rank,
+			ts_headline('russian', %[7]s, query.q, %[2]s) AS headline
+		FROM %[3]s b, %[4]s
+		WHERE %[1]s @@ query.q AND b.deleted_at IS NULL AND (b.life <> '%[5]s' OR b.owner = $2 OR $3) AND %[6]s
+		ORDER BY rank DESC, b.start_time DESC LIMIT $4;`,
+		broadcastVector, headlineOpts, broadcastTable, searchQuery, models.Past, visibleTo("b.", "$2"),
+		escapeHTML(`b.name || '. ' || b.description`))
+
+	if err := s.db.Select(&items, query, q.Text, q.Username, q.IsAdmin, q.Limit); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (s *SearchPostgres) SearchMessages(q models.SearchQuery) ([]api.SSearchMessage, error) {
+	var items = make([]api.SSearchMessage, 0)
+
+	query := fmt.Sprintf(
+		`SELECT m.id, m.channel, m.time,
+			CASE WHEN m.is_anon THEN '' ELSE m.username END AS username,
+			CASE WHEN m.is_anon THEN '' ELSE m.fullname END AS fullname,
+			ts_rank(%[1]s, query.q) AS rank,
+			ts_headline('russian', %[8]s, query.q, %[2]s) AS headline
+		FROM %[3]s m LEFT JOIN %[4]s b ON b.id::text = m.channel, %[5]s
+		WHERE %[1]s @@ query.q AND m.deleted_at IS NULL AND b.deleted_at IS NULL AND (b.id IS NULL OR b.life <> '%[6]s' OR b.owner = $2 OR $3)
+			AND (b.id IS NULL OR %[7]s) AND (%[9]s OR b.owner = $2 OR $3)
+		ORDER BY rank DESC, m.time DESC LIMIT $4;`,
+		messageVector, headlineOpts, messagesTable, broadcastTable, searchQuery, models.Past, visibleTo("b.", "$2"),
+		escapeHTML("m.text"), publicMessage("m."))
+
+	if err := s.db.Select(&items, query, q.Text, q.Username, q.IsAdmin, q.Limit); err != nil {
+		return items, err
+	}
+	return items, nil
+}
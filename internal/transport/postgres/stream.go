@@ -14,6 +14,8 @@ const (
 	streamTable = "stream"
 )
 
+const streamFields = "id, username, description, streamkey, version"
+
 type StreamPostgres struct {
 	db *sqlx.DB
 }
@@ -25,14 +27,19 @@ func NewStreamPostgres(db *sqlx.DB) *StreamPostgres {
 func (s *StreamPostgres) CreateStream(user api.SUsername) (models.Stream, error) {
 	var stream models.Stream
 
-	qSelect := fmt.Sprintf(`SELECT * FROM %s WHERE username=$1`, streamTable)
-	qInsert := fmt.Sprintf(`INSERT INTO %s (id, username, description) values (uuid_generate_v4(), $1, $1) RETURNING *;`, streamTable)
+	qSelect := fmt.Sprintf(`SELECT %s FROM %s WHERE username=$1`, streamFields, streamTable)
+	qInsert := fmt.Sprintf(`INSERT INTO %s (id, username, description, streamkey)
+	values (uuid_generate_v4(), $1, $1, $2) RETURNING %s;`, streamTable, streamFields)
 
 	err := s.db.Get(&stream, qSelect, *user.Username)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			row := s.db.QueryRowx(qInsert, *user.Username)
-			if err = row.Scan(&stream.Id, &stream.Username, &stream.Description); err != nil {
+			streamKey, err := models.NewSecret()
+			if err != nil {
+				return stream, err
+			}
+			row := s.db.QueryRowx(qInsert, *user.Username, streamKey)
+			if err = row.StructScan(&stream); err != nil {
 				return stream, err
 			}
 			return stream, nil
@@ -45,7 +52,7 @@ func (s *StreamPostgres) CreateStream(user api.SUsername) (models.Stream, error)
 func (s *StreamPostgres) GetStream(username string) (models.Stream, error) {
 	var stream models.Stream
 
-	query := fmt.Sprintf(`SELECT * FROM %s WHERE username=$1`, streamTable)
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE username=$1`, streamFields, streamTable)
 
 	err := s.db.Get(&stream, query, username)
 	if err != nil {
@@ -57,13 +64,51 @@ func (s *StreamPostgres) GetStream(username string) (models.Stream, error) {
 	return stream, err
 }
 
-func (s *StreamPostgres) ChangeDescByUsername(stream models.PutStream) (models.Stream, error) {
+// ChangeDescByUsername updates the stream if its version equals the given one,
+// any version matches when it is nil.
+func (s *StreamPostgres) ChangeDescByUsername(stream models.PutStream, version *int) (models.Stream, error) {
 	var item models.Stream
 
-	query := fmt.Sprintf(`UPDATE %s SET description = $1 WHERE username = $2 RETURNING *;`, streamTable)
+	query := fmt.Sprintf(`UPDATE %s SET description = $1
+		WHERE username = $2 AND ($3::integer IS NULL OR version = $3) RETURNING %s;`, streamTable, streamFields)
 
-	row := s.db.QueryRowx(query, *stream.Description, *stream.Username)
-	if err := row.Scan(&item.Id, &item.Username, &item.Description); err != nil {
+	row := s.db.QueryRowx(query, *stream.Description, *stream.Username, version)
+	if err := row.StructScan(&item); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+func (s *StreamPostgres) GetStreamByKey(streamKey string) (models.Stream, error) {
+	var stream models.Stream
+
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE streamkey=$1`, streamFields, streamTable)
+
+	if err := s.db.Get(&stream, query, streamKey); err != nil {
+		if err == sql.ErrNoRows {
+			return stream, nil
+		}
+		return stream, err
+	}
+	return stream, nil
+}
+
+func (s *StreamPostgres) RotateStreamKey(username string) (models.Stream, error) {
+	var item models.Stream
+
+	streamKey, err := models.NewSecret()
+	if err != nil {
+		return item, err
+	}
+
+	query := fmt.Sprintf(`UPDATE %s SET streamkey = $1 WHERE username = $2 RETURNING %s;`, streamTable, streamFields)
+	if err := s.db.QueryRowx(query, streamKey, username).StructScan(&item); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
 		return item, err
 	}
 	return item, nil
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+const (
+	tagsTable          = "tags"
+	categoriesTable    = "categories"
+	broadcastTagsTable = "broadcast_tags"
+)
+
+// tagFields selects the tag with the name of its category, t is the alias of the tags table.
+const tagFields = "t.id, t.name, c.name AS category"
+
+type TagsPostgres struct {
+	db *sqlx.DB
+}
+
+func NewTagsPostgres(db *sqlx.DB) *TagsPostgres {
+	return &TagsPostgres{db}
+}
+
+func (t *TagsPostgres) GetTags() ([]models.Tag, error) {
+	var items = make([]models.Tag, 0)
+	query := fmt.Sprintf("SELECT %s FROM %s t LEFT JOIN %s c ON c.id = t.category_id ORDER BY t.name;",
+		tagFields, tagsTable, categoriesTable)
+	if err := t.db.Select(&items, query); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// SaveTag adds the tag or changes the category of the tag with the same name.
+func (t *TagsPostgres) SaveTag(item models.Tag) (models.Tag, error) {
+	var tag models.Tag
+
+	categoryId, err := t.getCategoryId(item.Category)
+	if err != nil {
+		return tag, err
+	}
+
+	query := fmt.Sprintf(`WITH t AS (INSERT INTO %s (id, name, category_id) VALUES (uuid_generate_v4(), $1, $2)
+		ON CONFLICT (name) DO UPDATE SET category_id = EXCLUDED.category_id RETURNING id, name, category_id)
+		SELECT %s FROM t LEFT JOIN %s c ON c.id = t.category_id;`, tagsTable, tagFields, categoriesTable)
+	if err := t.db.Get(&tag, query, *item.Name, categoryId); err != nil {
+		return tag, err
+	}
+	return tag, nil
+}
+
+func (t *TagsPostgres) ChangeTag(id types.UUID, item models.Tag) (models.Tag, error) {
+	var tag models.Tag
+
+	categoryId, err := t.getCategoryId(item.Category)
+	if err != nil {
+		return tag, err
+	}
+
+	query := fmt.Sprintf(`WITH t AS (UPDATE %s SET name = $2, category_id = $3 WHERE id = $1
+		RETURNING id, name, category_id)
+		SELECT %s FROM t LEFT JOIN %s c ON c.id = t.category_id;`, tagsTable, tagFields, categoriesTable)
+	if err := t.db.Get(&tag, query, id, *item.Name, categoryId); err != nil {
+		if err == sql.ErrNoRows {
+			return tag, nil
+		}
+		return tag, err
+	}
+	return tag, nil
+}
+
+// DeleteTag deletes the tag, it is removed from broadcasts by the cascade.
+func (t *TagsPostgres) DeleteTag(id types.UUID) (api.SIdentifier, error) {
+	var item api.SIdentifier
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = $1 RETURNING id;", tagsTable)
+	if err := t.db.QueryRowx(query, id).StructScan(&item); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+func (t *TagsPostgres) GetCategories() ([]models.Category, error) {
+	var items = make([]models.Category, 0)
+	query := fmt.Sprintf("SELECT id, name FROM %s ORDER BY name;", categoriesTable)
+	if err := t.db.Select(&items, query); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// CreateCategory returns the existing category when one with the same name exists.
+func (t *TagsPostgres) CreateCategory(item models.Category) (models.Category, error) {
+	var category models.Category
+	query := fmt.Sprintf(`INSERT INTO %s (id, name) VALUES (uuid_generate_v4(), $1)
+		ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name RETURNING id, name;`, categoriesTable)
+	if err := t.db.QueryRowx(query, *item.Name).StructScan(&category); err != nil {
+		return category, err
+	}
+	return category, nil
+}
+
+// DeleteCategory deletes the category, its tags are kept without a category.
+func (t *TagsPostgres) DeleteCategory(id types.UUID) (api.SIdentifier, error) {
+	var item api.SIdentifier
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = $1 RETURNING id;", categoriesTable)
+	if err := t.db.QueryRowx(query, id).StructScan(&item); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+// getCategoryId returns nil for an empty name and ErrCategoryNotFound for an unknown one.
+func (t *TagsPostgres) getCategoryId(name *string) (*types.UUID, error) {
+	if name == nil || len(*name) == 0 {
+		return nil, nil
+	}
+	var id types.UUID
+	query := fmt.Sprintf("SELECT id FROM %s WHERE name = $1;", categoriesTable)
+	if err := t.db.Get(&id, query, *name); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	return &id, nil
+}
+
+// setTags replaces the tags of the broadcast, all names must be in the vocabulary.
+func setTags(tx *sqlx.Tx, broadcastId types.UUID, names []string) (models.TagList, error) {
+	var tags = make(models.TagList, 0, len(names))
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			tags = append(tags, name)
+		}
+	}
+	sort.Strings(tags)
+
+	if _, err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE broadcast_id = $1;", broadcastTagsTable), broadcastId); err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return tags, nil
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (broadcast_id, tag_id) SELECT $1, id FROM %s WHERE name = ANY($2);",
+		broadcastTagsTable, tagsTable)
+	res, err := tx.Exec(query, broadcastId, pq.Array([]string(tags)))
+	if err != nil {
+		return nil, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if int(n) != len(tags) {
+		return nil, models.ErrUnknownTag
+	}
+	return tags, nil
+}
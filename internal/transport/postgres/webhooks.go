@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/alexm24/golang/internal/handler/api"
+	"github.com/alexm24/golang/internal/models"
+)
+
+const (
+	webhooksTable          = "webhooks"
+	webhookDeliveriesTable = "webhook_deliveries"
+	webhookAttemptsTable   = "webhook_attempts"
+)
+
+const webhookFields = "id, url, events, active, created_by, created_at"
+
+const deliveryFields = "id, event_id, event, status, attempts, next_attempt_at, delivered_at, created_at"
+
+// deliveryHistory aggregates attempts of the delivery d in the order they were made.
+var deliveryHistory = fmt.Sprintf(`COALESCE((SELECT json_agg(json_build_object(
+		'time', a.time, 'status_code', a.status_code, 'error', a.error, 'duration_ms', a.duration_ms) ORDER BY a.time)
+	FROM %s a WHERE a.delivery_id = d.id), '[]') AS history`, webhookAttemptsTable)
+
+type WebhooksPostgres struct {
+	db *sqlx.DB
+}
+
+func NewWebhooksPostgres(db *sqlx.DB) *WebhooksPostgres {
+	return &WebhooksPostgres{db}
+}
+
+func (w *WebhooksPostgres) GetWebhooks() ([]models.Webhook, error) {
+	var items = make([]models.Webhook, 0)
+	query := fmt.Sprintf("SELECT %s FROM %s ORDER BY created_at;", webhookFields, webhooksTable)
+	if err := w.db.Select(&items, query); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+func (w *WebhooksPostgres) CreateWebhook(item models.PostWebhook, secret string, actor *string) (models.Webhook, error) {
+	var webhook models.Webhook
+	active := item.Active == nil || *item.Active
+	query := fmt.Sprintf(`INSERT INTO %s (id, url, secret, events, active, created_by)
+		VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5) RETURNING %s, secret;`, webhooksTable, webhookFields)
+	if err := w.db.Get(&webhook, query, *item.Url, secret, item.EventList(), active, actor); err != nil {
+		return webhook, err
+	}
+	return webhook, nil
+}
+
+// ChangeWebhook returns an empty webhook for unknown ids, the active flag is
+// kept when it is not set.
+func (w *WebhooksPostgres) ChangeWebhook(id types.UUID, item models.PostWebhook) (models.Webhook, error) {
+	var webhook models.Webhook
+	query := fmt.Sprintf(`UPDATE %s SET url = $2, events = $3, active = COALESCE($4, active)
+		WHERE id = $1 RETURNING %s;`, webhooksTable, webhookFields)
+	if err := w.db.Get(&webhook, query, id, *item.Url, item.EventList(), item.Active); err != nil {
+		if err == sql.ErrNoRows {
+			return webhook, nil
+		}
+		return webhook, err
+	}
+	return webhook, nil
+}
+
+func (w *WebhooksPostgres) DeleteWebhook(id types.UUID) (api.SIdentifier, error) {
+	var item api.SIdentifier
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = $1 RETURNING id;", webhooksTable)
+	if err := w.db.QueryRowx(query, id).StructScan(&item); err != nil {
+		if err == sql.ErrNoRows {
+			return item, nil
+		}
+		return item, err
+	}
+	return item, nil
+}
+
+func (w *WebhooksPostgres) WebhookExists(id types.UUID) (bool, error) {
+	var exists bool
+	query := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE id = $1);", webhooksTable)
+	if err := w.db.Get(&exists, query, id); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// EnqueueEvent adds a pending delivery of the event for every active webhook
+// subscribed to its type.
+func (w *WebhooksPostgres) EnqueueEvent(event models.WebhookEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`INSERT INTO %s (id, webhook_id, event_id, event, payload, next_attempt_at)
+		SELECT uuid_generate_v4(), id, $1, $2, $3, now() FROM %s
+		WHERE active AND (events = '[]'::jsonb OR events ? $2);`, webhookDeliveriesTable, webhooksTable)
+	_, err = w.db.Exec(query, event.Id, event.Type, payload)
+	return err
+}
+
+func (w *WebhooksPostgres) GetDeliveries(webhookId types.UUID, limit int) ([]models.WebhookDelivery, error) {
+	var items = make([]models.WebhookDelivery, 0)
+	query := fmt.Sprintf(`SELECT %s, %s FROM %s d WHERE d.webhook_id = $1 ORDER BY d.created_at DESC LIMIT $2;`,
+		deliveryFields, deliveryHistory, webhookDeliveriesTable)
+	if err := w.db.Select(&items, query, webhookId, limit); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// GetDueDeliveries returns pending deliveries whose next attempt has come,
+// with the url and secret of their webhook.
+func (w *WebhooksPostgres) GetDueDeliveries(now time.Time, limit int) ([]models.WebhookDelivery, error) {
+	var items = make([]models.WebhookDelivery, 0)
+	query := fmt.Sprintf(`SELECT d.id, d.event_id, d.event, d.status, d.attempts, d.next_attempt_at,
+			d.delivered_at, d.created_at, d.webhook_id, d.payload, h.url, h.secret
+		FROM %s d JOIN %s h ON h.id = d.webhook_id
+		WHERE d.status = '%s' AND d.next_attempt_at <= $1 ORDER BY d.next_attempt_at LIMIT $2;`,
+		webhookDeliveriesTable, webhooksTable, models.WebhookPending)
+	if err := w.db.Select(&items, query, now, limit); err != nil {
+		return items, err
+	}
+	return items, nil
+}
+
+// SaveAttempt records the attempt and moves the delivery to the given status,
+// next is the time of the next attempt of pending deliveries.
+func (w *WebhooksPostgres) SaveAttempt(deliveryId types.UUID, attempt api.SWebhookAttempt,
+	status models.WebhookStatus, next *time.Time) error {
+	tx, err := w.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	qAttempt := fmt.Sprintf(`INSERT INTO %s (id, delivery_id, time, status_code, error, duration_ms)
+		VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5);`, webhookAttemptsTable)
+	_, err = tx.Exec(qAttempt, deliveryId, attempt.Time, attempt.StatusCode, attempt.Error, attempt.DurationMs)
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return e
+		}
+		return err
+	}
+
+	qDelivery := fmt.Sprintf(`UPDATE %s SET status = $2, attempts = attempts + 1, next_attempt_at = $3,
+		delivered_at = CASE WHEN $2 = '%s' THEN $4 ELSE delivered_at END WHERE id = $1;`,
+		webhookDeliveriesTable, models.WebhookDelivered)
+	if _, err = tx.Exec(qDelivery, deliveryId, status, next, attempt.Time); err != nil {
+		if e := tx.Rollback(); e != nil {
+			return e
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,82 @@
+package redis
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"github.com/alexm24/golang/internal/models"
+)
+
+// viewersTTL keeps peaks of viewers for 30 days after the last sample.
+const viewersTTL = 2592000
+
+const viewersPeak = "peak"
+
+func viewersKey(channel string) string {
+	return "viewers:" + channel
+}
+
+// trackViewers stores the number of viewers present in the channel as the peak
+// of the channel and of the minute, when it is higher than the stored one.
+var trackViewers = redis.NewScript(1, `
+local n = tonumber(ARGV[3])
+for _, field in ipairs({'`+viewersPeak+`', ARGV[1]}) do
+	if n > tonumber(redis.call('HGET', KEYS[1], field) or '0') then
+		redis.call('HSET', KEYS[1], field, n)
+	end
+end
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+return n
+`)
+
+type AnalyticsRedis struct {
+	redisPool *redis.Pool
+}
+
+func NewAnalyticsRedis(redisPool *redis.Pool) *AnalyticsRedis {
+	return &AnalyticsRedis{redisPool}
+}
+
+// TrackViewers records the number of viewers present in the channel at the given time.
+func (a *AnalyticsRedis) TrackViewers(channel string, viewers int, at time.Time) error {
+	redisCon := a.redisPool.Get()
+	defer redisCon.Close()
+
+	minute := at.Truncate(models.ViewersBucket).Unix()
+	_, err := trackViewers.Do(redisCon, viewersKey(channel), minute, viewersTTL, viewers)
+	return err
+}
+
+func (a *AnalyticsRedis) GetPeakViewers(channel string) (int, error) {
+	redisCon := a.redisPool.Get()
+	defer redisCon.Close()
+
+	peak, err := redis.Int(redisCon.Do("HGET", viewersKey(channel), viewersPeak))
+	if err == redis.ErrNil {
+		return 0, nil
+	}
+	return peak, err
+}
+
+// GetViewers returns peaks of viewers per minute by unix time of the minute.
+func (a *AnalyticsRedis) GetViewers(channel string) (map[int64]int, error) {
+	redisCon := a.redisPool.Get()
+	defer redisCon.Close()
+
+	values, err := redis.IntMap(redisCon.Do("HGETALL", viewersKey(channel)))
+	if err != nil {
+		return nil, err
+	}
+
+	var viewers = make(map[int64]int, len(values))
+	for field, count := range values {
+		minute, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			continue
+		}
+		viewers[minute] = count
+	}
+	return viewers, nil
+}
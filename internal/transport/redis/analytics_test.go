@@ -0,0 +1,73 @@
+package redis
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// testPool connects to the Redis at REDIS_ADDR, the test is skipped when
+// there is none.
+func testPool(t *testing.T) *redis.Pool {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_ADDR is not set")
+	}
+	pool := &redis.Pool{Dial: func() (redis.Conn, error) { return redis.Dial("tcp", addr) }}
+	redisCon := pool.Get()
+	defer redisCon.Close()
+	if _, err := redisCon.Do("PING"); err != nil {
+		t.Skipf("redis at %s: %v", addr, err)
+	}
+	return pool
+}
+
+func TestRedis_TrackViewers(t *testing.T) {
+	pool := testPool(t)
+	defer pool.Close()
+
+	channel := uuid.New().String()
+	defer func() {
+		redisCon := pool.Get()
+		defer redisCon.Close()
+		_, _ = redisCon.Do("DEL", viewersKey(channel))
+	}()
+
+	minute := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	samples := []struct {
+		at      time.Time
+		viewers int
+	}{
+		{at: minute, viewers: 3},
+		{at: minute.Add(30 * time.Second), viewers: 2},
+		{at: minute.Add(time.Minute), viewers: 5},
+		{at: minute.Add(2 * time.Minute), viewers: 1},
+	}
+
+	a := NewAnalyticsRedis(pool)
+	for _, s := range samples {
+		assert.NoError(t, a.TrackViewers(channel, s.viewers, s.at))
+	}
+
+	peak, err := a.GetPeakViewers(channel)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, peak)
+
+	viewers, err := a.GetViewers(channel)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]int{
+		minute.Unix():                      3,
+		minute.Add(time.Minute).Unix():     5,
+		minute.Add(2 * time.Minute).Unix(): 1,
+	}, viewers)
+
+	redisCon := pool.Get()
+	defer redisCon.Close()
+	ttl, err := redis.Int(redisCon.Do("TTL", viewersKey(channel)))
+	assert.NoError(t, err)
+	assert.Greater(t, ttl, 0)
+}
@@ -27,9 +27,13 @@ func (p *ParticipantsRedis) CreateParticipant(channel string, user models.PostPa
 	}
 
 	_, err = redisCon.Do("EXPIRE", channel, 432000)
-	if err != nil {
-		return err
-	}
+	return err
+}
+
+func (p *ParticipantsRedis) DeleteParticipant(channel string, username string) error {
+	redisCon := p.redisPool.Get()
+	defer redisCon.Close()
 
-	return nil
+	_, err := redisCon.Do("HDEL", channel, username)
+	return err
 }
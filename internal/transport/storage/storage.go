@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alexm24/golang/internal/models"
+)
+
+type Storage struct {
+	dir string
+}
+
+func NewStorage(dir string) *Storage {
+	return &Storage{dir}
+}
+
+// Save writes the content to a file of the storage and returns its path and
+// size, files larger than maxSize are removed.
+func (s *Storage) Save(name string, content io.Reader, maxSize int64) (string, int64, error) {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return "", 0, err
+	}
+
+	path := filepath.Join(s.dir, filepath.Base(name))
+	f, err := os.Create(path)
+	if err != nil {
+		return "", 0, err
+	}
+
+	size, err := io.Copy(f, io.LimitReader(content, maxSize+1))
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err == nil && size > maxSize {
+		err = models.ErrFileTooLarge
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return "", 0, err
+	}
+	return path, size, nil
+}
+
+// Size returns nil for files that are not available on this host, e.g.
+// recorded on another media server.
+func (s *Storage) Size(path string) (*int64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	size := info.Size()
+	return &size, nil
+}
+
+// Contains reports whether the path resolves to a file inside the storage dir.
+func (s *Storage) Contains(path string) bool {
+	dir, err := filepath.Abs(s.dir)
+	if err != nil {
+		return false
+	}
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// Open returns nil content for missing files, files outside the storage dir
+// are rejected.
+func (s *Storage) Open(path string) (io.ReadSeekCloser, time.Time, error) {
+	if !s.Contains(path) {
+		return nil, time.Time{}, models.ErrRecordingOutside
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, time.Time{}, nil
+		}
+		return nil, time.Time{}, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, time.Time{}, err
+	}
+	return f, info.ModTime(), nil
+}
+
+// Remove deletes files of the storage, files recorded elsewhere are kept.
+func (s *Storage) Remove(path string) error {
+	if !s.Contains(path) {
+		return nil
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package transport
 
 import (
+	"io"
+	"time"
+
 	"github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/gomodule/redigo/redis"
 	"github.com/jmoiron/sqlx"
@@ -11,17 +14,35 @@ import (
 	"github.com/alexm24/golang/internal/transport/mail"
 	"github.com/alexm24/golang/internal/transport/postgres"
 	redisPool "github.com/alexm24/golang/internal/transport/redis"
+	"github.com/alexm24/golang/internal/transport/storage"
+	"github.com/alexm24/golang/internal/transport/webhook"
 )
 
 type IBroadcastsPostgres interface {
 	CreateBroadcast(item models.PostBroadcast) (models.Broadcasts, error)
-	ChangeBroadcast(item models.PutBroadcast) (models.Broadcasts, error)
-	GetBroadcasts() ([]models.Broadcasts, error)
+	ChangeBroadcast(item models.PutBroadcast, version *int) (models.Broadcasts, error)
+	PatchBroadcast(id types.UUID, patch models.PatchBroadcast, version *int) (models.Broadcasts, error)
+	GetBroadcasts(filter models.BroadcastFilter) (models.BroadcastsPage, error)
 	GetBroadcastById(id types.UUID) (models.Broadcasts, error)
-	DeleteBroadcast(id types.UUID) (api.SIdentifier, error)
+	GetBroadcastWithTrash(id types.UUID) (models.Broadcasts, error)
+	GetBroadcastByStreamKey(streamKey string) (models.Broadcasts, error)
+	DeleteBroadcast(id types.UUID, deletedBy *string) (api.SIdentifier, error)
 	CheckAdminUser(username api.SUsername) (bool, error)
-	GetPastBroadcastAll() ([]models.Broadcasts, error)
-	GetPastBroadcastOwner(user api.SUsername) ([]models.Broadcasts, error)
+	RotateStreamKey(id types.UUID) (models.Broadcasts, error)
+	StartBroadcasts(now time.Time) ([]models.Broadcasts, error)
+	FinishBroadcasts(now time.Time, duration time.Duration) ([]models.Broadcasts, error)
+	ChangeLifeCycle(id types.UUID, life models.LifeCycleBroadcast) (models.Broadcasts, error)
+	SetPublishing(id types.UUID, publishing bool) error
+	CreateSeries(item models.PostBroadcast, starts []time.Time) ([]models.Broadcasts, error)
+	GetSeriesBroadcasts(seriesId types.UUID) ([]models.Broadcasts, error)
+	ChangeSeriesBroadcasts(
+		item models.PutBroadcast, seriesId types.UUID, shift time.Duration, from *time.Time) ([]models.Broadcasts, error)
+	DeleteSeriesBroadcasts(seriesId types.UUID, from *time.Time, deletedBy *string) ([]api.SIdentifier, error)
+	GetConflicts(slot models.Slot, exclude []types.UUID, duration time.Duration) ([]models.Broadcasts, error)
+	GetOnAir() ([]api.SIdentifier, error)
+	GetTrash() ([]models.Broadcasts, error)
+	RestoreBroadcast(id types.UUID) (models.Broadcasts, error)
+	PurgeTrash(before time.Time) ([]api.SIdentifier, error)
 }
 
 type IParticipantsPostgres interface {
@@ -30,11 +51,35 @@ type IParticipantsPostgres interface {
 
 type IParticipantsRedis interface {
 	CreateParticipant(channel string, user models.PostParticipant) error
+	DeleteParticipant(channel string, username string) error
+}
+
+type IRegistrationsPostgres interface {
+	Register(broadcastId types.UUID, user models.PostParticipant) (models.Registration, bool, error)
+	Unregister(broadcastId types.UUID, username string) (models.Registration, models.Registration, error)
+	PromoteWaitlist(broadcastId types.UUID) ([]models.Registration, error)
+	GetRegistrationCounts(broadcastId types.UUID) (models.RegistrationCounts, error)
+}
+
+type IQuestionsPostgres interface {
+	GetQuestions(channel string, states []models.QuestionState) ([]models.Question, error)
+	GetQuestionById(id types.UUID) (models.Question, error)
+	Vote(id types.UUID, username string) error
+	Unvote(id types.UUID, username string) error
+	SetQuestionState(id types.UUID, state models.QuestionState) error
+	PinQuestion(channel string, id types.UUID) error
+	UnpinQuestion(id types.UUID) error
 }
 
 type IMessagesPostgres interface {
 	GetMessageByChannel(channel string) ([]models.Messages, error)
+	GetMessagesPage(f models.MessageFilter) (models.MessagesPage, error)
 	CreateMsg(channel string, msg models.PostMessage) (models.Messages, error)
+	GetReplies(id types.UUID, moderator bool) ([]models.Messages, error)
+	GetMessageById(id types.UUID) (models.Messages, error)
+	EditMessage(id types.UUID, text string, editor string) (models.Messages, error)
+	DeleteMessage(id types.UUID) (api.SIdentifier, error)
+	GetMessageEdits(id types.UUID) ([]models.MessageEdit, error)
 	DeleteMessages(channel string) error
 	AddReaction(item models.PostReactionMsg) (models.Messages, error)
 	DeleteReaction(item models.PatchReactionMsg) (models.Messages, error)
@@ -43,12 +88,16 @@ type IMessagesPostgres interface {
 type IStreamPostgres interface {
 	CreateStream(username api.SUsername) (models.Stream, error)
 	GetStream(username string) (models.Stream, error)
-	ChangeDescByUsername(stream models.PutStream) (models.Stream, error)
+	ChangeDescByUsername(stream models.PutStream, version *int) (models.Stream, error)
+	GetStreamByKey(streamKey string) (models.Stream, error)
+	RotateStreamKey(username string) (models.Stream, error)
 }
 
 type ICentrifugo interface {
 	GetToken(username api.SUsername) (api.SToken, error)
+	GetChannelToken(username api.SUsername, channel string) (api.SToken, error)
 	Publish(channel string, msg interface{}) error
+	PresenceStats(channel string) (models.PresenceStats, error)
 }
 
 type ILivePostgres interface {
@@ -68,34 +117,166 @@ type IZoomPostgres interface {
 	GetZoomById(id types.UUID) (models.Zoom, error)
 }
 
+type ISearchPostgres interface {
+	SearchBroadcasts(q models.SearchQuery) ([]api.SSearchBroadcast, error)
+	SearchMessages(q models.SearchQuery) ([]api.SSearchMessage, error)
+}
+
+type ICalendarPostgres interface {
+	SaveCalendarFeed(feed models.CalendarFeed) (models.CalendarFeed, error)
+	GetCalendarFeed(token string) (models.CalendarFeed, error)
+	GetCalendarEvents(owner *string, viewer string) ([]models.CalendarEvent, error)
+}
+
+type IFeedsPostgres interface {
+	GetArchiveEntries(filter models.FeedFilter) ([]models.FeedEntry, error)
+}
+
+type IRecordingsPostgres interface {
+	CreateRecording(item models.Recording) (models.Recording, error)
+	GetRecordings(broadcastIds []types.UUID) ([]models.Recording, error)
+	GetRecordingById(id types.UUID) (models.Recording, error)
+	DeleteRecording(id types.UUID) (api.SIdentifier, error)
+}
+
+type IStorage interface {
+	Save(name string, content io.Reader, maxSize int64) (string, int64, error)
+	Size(path string) (*int64, error)
+	Contains(path string) bool
+	Open(path string) (io.ReadSeekCloser, time.Time, error)
+	Remove(path string) error
+}
+
+type IFilesPostgres interface {
+	CreateFile(item models.File) (models.FileInfo, error)
+	GetFiles(broadcastId types.UUID) ([]models.FileInfo, error)
+	GetFileById(id types.UUID) (models.File, error)
+	GetFileInfo(id types.UUID) (models.FileInfo, error)
+	DeleteFile(id types.UUID) (api.SIdentifier, error)
+}
+
+type IRevisionsPostgres interface {
+	CreateRevision(item models.Revision) (models.Revision, error)
+	GetRevisions(broadcastId types.UUID) ([]models.Revision, error)
+	GetRevisionById(id types.UUID) (models.Revision, error)
+}
+
+type IAccessPostgres interface {
+	GetAccess(broadcastId types.UUID) ([]models.Access, error)
+	GrantAccess(broadcastId types.UUID, username string, grantedBy *string) (models.Access, error)
+	RevokeAccess(broadcastId types.UUID, username string) (models.Access, error)
+	HasAccess(broadcastId types.UUID, username string) (bool, error)
+	CreateInvite(broadcastId types.UUID, token string) (api.SIdentifier, error)
+	GetBroadcastByInvite(token string) (models.Broadcasts, error)
+}
+
+type IAnalyticsPostgres interface {
+	GetChatStats(channel string) (models.Analytics, error)
+	GetReactionCounts(channel string) ([]models.ReactionCount, error)
+	GetChatSeries(channel string, bucket time.Duration) ([]models.AnalyticsBucket, error)
+}
+
+type IAnalyticsRedis interface {
+	TrackViewers(channel string, viewers int, at time.Time) error
+	GetPeakViewers(channel string) (int, error)
+	GetViewers(channel string) (map[int64]int, error)
+}
+
+type IReportsPostgres interface {
+	SaveReportMail(broadcastId types.UUID, email string) (models.ReportMail, error)
+	DeleteReportMail(broadcastId types.UUID) (models.ReportMail, error)
+	GetPendingReportMails() ([]models.ReportMail, error)
+	SetReportMailSent(broadcastId types.UUID, sentAt time.Time) error
+}
+
+type IWebhooksPostgres interface {
+	GetWebhooks() ([]models.Webhook, error)
+	CreateWebhook(item models.PostWebhook, secret string, actor *string) (models.Webhook, error)
+	ChangeWebhook(id types.UUID, item models.PostWebhook) (models.Webhook, error)
+	DeleteWebhook(id types.UUID) (api.SIdentifier, error)
+	WebhookExists(id types.UUID) (bool, error)
+	EnqueueEvent(event models.WebhookEvent) error
+	GetDeliveries(webhookId types.UUID, limit int) ([]models.WebhookDelivery, error)
+	GetDueDeliveries(now time.Time, limit int) ([]models.WebhookDelivery, error)
+	SaveAttempt(deliveryId types.UUID, attempt api.SWebhookAttempt, status models.WebhookStatus, next *time.Time) error
+}
+
+type IWebhook interface {
+	Send(item models.WebhookRequest) (int, error)
+}
+
+type ITagsPostgres interface {
+	GetTags() ([]models.Tag, error)
+	SaveTag(item models.Tag) (models.Tag, error)
+	ChangeTag(id types.UUID, item models.Tag) (models.Tag, error)
+	DeleteTag(id types.UUID) (api.SIdentifier, error)
+	GetCategories() ([]models.Category, error)
+	CreateCategory(item models.Category) (models.Category, error)
+	DeleteCategory(id types.UUID) (api.SIdentifier, error)
+}
+
 type IMail interface {
 	SendMail(item models.Zoom) error
+	SendPromotion(item models.Promotion) error
+	SendReport(item models.ReportLetter) error
 }
 
 type Transport struct {
 	IParticipantsRedis
 	IBroadcastsPostgres
 	IParticipantsPostgres
+	IRegistrationsPostgres
 	IMessagesPostgres
+	IQuestionsPostgres
 	IStreamPostgres
 	ILivePostgres
 	ICentrifugo
 	IImagesPostgres
 	IZoomPostgres
+	ISearchPostgres
+	ICalendarPostgres
+	IFeedsPostgres
+	IRecordingsPostgres
+	IFilesPostgres
+	IRevisionsPostgres
+	IAccessPostgres
+	ITagsPostgres
+	IAnalyticsPostgres
+	IAnalyticsRedis
+	IReportsPostgres
+	IWebhooksPostgres
+	IWebhook
+	IStorage
 	IMail
 }
 
-func NewTransport(db *sqlx.DB, rp *redis.Pool, c models.CentrifugoConfig) *Transport {
+func NewTransport(db *sqlx.DB, rp *redis.Pool, c models.CentrifugoConfig, dir string) *Transport {
 	return &Transport{
-		IParticipantsRedis:    redisPool.NewParticipantsRedis(rp),
-		IBroadcastsPostgres:   postgres.NewBroadcastsPostgres(db),
-		IParticipantsPostgres: postgres.NewParticipantsPostgres(db),
-		IMessagesPostgres:     postgres.NewMessagesPostgres(db),
-		IStreamPostgres:       postgres.NewStreamPostgres(db),
-		ILivePostgres:         postgres.NewLivePostgres(db),
-		ICentrifugo:           centrifugo.NewCentrifugo(c),
-		IImagesPostgres:       postgres.NewImagesPostgres(db),
-		IZoomPostgres:         postgres.NewZoomPostgres(db),
-		IMail:                 mail.NewMail(),
+		IParticipantsRedis:     redisPool.NewParticipantsRedis(rp),
+		IBroadcastsPostgres:    postgres.NewBroadcastsPostgres(db),
+		IParticipantsPostgres:  postgres.NewParticipantsPostgres(db),
+		IRegistrationsPostgres: postgres.NewRegistrationsPostgres(db),
+		IMessagesPostgres:      postgres.NewMessagesPostgres(db),
+		IQuestionsPostgres:     postgres.NewQuestionsPostgres(db),
+		IStreamPostgres:        postgres.NewStreamPostgres(db),
+		ILivePostgres:          postgres.NewLivePostgres(db),
+		ICentrifugo:            centrifugo.NewCentrifugo(c),
+		IImagesPostgres:        postgres.NewImagesPostgres(db),
+		IZoomPostgres:          postgres.NewZoomPostgres(db),
+		ISearchPostgres:        postgres.NewSearchPostgres(db),
+		ICalendarPostgres:      postgres.NewCalendarPostgres(db),
+		IFeedsPostgres:         postgres.NewFeedsPostgres(db),
+		IRecordingsPostgres:    postgres.NewRecordingsPostgres(db),
+		IFilesPostgres:         postgres.NewFilesPostgres(db),
+		IRevisionsPostgres:     postgres.NewRevisionsPostgres(db),
+		IAccessPostgres:        postgres.NewAccessPostgres(db),
+		ITagsPostgres:          postgres.NewTagsPostgres(db),
+		IAnalyticsPostgres:     postgres.NewAnalyticsPostgres(db),
+		IAnalyticsRedis:        redisPool.NewAnalyticsRedis(rp),
+		IReportsPostgres:       postgres.NewReportsPostgres(db),
+		IWebhooksPostgres:      postgres.NewWebhooksPostgres(db),
+		IWebhook:               webhook.NewWebhook(),
+		IStorage:               storage.NewStorage(dir),
+		IMail:                  mail.NewMail(),
 	}
 }
@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/alexm24/golang/internal/models"
+)
+
+const timeout = 10 * time.Second
+
+// maxResponse limits the response body read before the connection is reused.
+const maxResponse = 64 << 10
+
+type Webhook struct {
+	client *http.Client
+}
+
+func NewWebhook() *Webhook {
+	return &Webhook{&http.Client{Timeout: timeout}}
+}
+
+// Send posts the request and returns the status code of the response.
+func (w *Webhook) Send(item models.WebhookRequest) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, item.Url, bytes.NewReader(item.Body))
+	if err != nil {
+		return 0, err
+	}
+	for name, value := range item.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponse))
+	return resp.StatusCode, nil
+}
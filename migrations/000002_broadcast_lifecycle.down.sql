@@ -0,0 +1,11 @@
+DROP INDEX broadcasts_life_start_time_idx;
+
+ALTER TABLE broadcasts
+    DROP COLUMN end_time;
+
+UPDATE broadcasts SET life = 'created' WHERE life = 'live';
+
+ALTER TYPE lifecycle RENAME TO lifecycle_old;
+CREATE TYPE lifecycle AS ENUM ('created', 'past');
+ALTER TABLE broadcasts ALTER COLUMN life TYPE lifecycle USING life::text::lifecycle;
+DROP TYPE lifecycle_old;
@@ -0,0 +1,6 @@
+ALTER TYPE lifecycle ADD VALUE IF NOT EXISTS 'live' BEFORE 'past';
+
+ALTER TABLE broadcasts
+    ADD COLUMN end_time timestamp with time zone;
+
+CREATE INDEX broadcasts_life_start_time_idx ON broadcasts (life, start_time);
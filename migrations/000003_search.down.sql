@@ -0,0 +1,2 @@
+DROP INDEX messages_search_idx;
+DROP INDEX broadcasts_search_idx;
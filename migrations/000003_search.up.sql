@@ -0,0 +1,8 @@
+CREATE INDEX broadcasts_search_idx ON broadcasts USING GIN (
+    (setweight(to_tsvector('russian', name), 'A') || setweight(to_tsvector('english', name), 'A') ||
+     setweight(to_tsvector('russian', description), 'B') || setweight(to_tsvector('english', description), 'B'))
+);
+
+CREATE INDEX messages_search_idx ON messages USING GIN (
+    (to_tsvector('russian', text) || to_tsvector('english', text))
+);
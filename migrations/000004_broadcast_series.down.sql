@@ -0,0 +1,6 @@
+DROP INDEX broadcasts_series_id_start_time_idx;
+
+ALTER TABLE broadcasts
+    DROP COLUMN series_id;
+
+DROP TABLE series;
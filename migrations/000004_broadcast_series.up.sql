@@ -0,0 +1,12 @@
+CREATE TABLE series
+(
+    id         UUID                     NOT NULL PRIMARY KEY,
+    owner      VARCHAR(100)             NOT NULL,
+    rrule      TEXT                     NOT NULL,
+    start_time timestamp with time zone NOT NULL
+);
+
+ALTER TABLE broadcasts
+    ADD COLUMN series_id UUID REFERENCES series (id) ON DELETE SET NULL;
+
+CREATE INDEX broadcasts_series_id_start_time_idx ON broadcasts (series_id, start_time);
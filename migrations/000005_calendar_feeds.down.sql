@@ -0,0 +1,9 @@
+DROP TABLE calendar_feeds;
+
+DROP TRIGGER broadcasts_touch ON broadcasts;
+
+DROP FUNCTION broadcasts_touch();
+
+ALTER TABLE broadcasts
+    DROP COLUMN sequence,
+    DROP COLUMN updated_at;
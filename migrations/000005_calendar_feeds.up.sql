@@ -0,0 +1,29 @@
+ALTER TABLE broadcasts
+    ADD COLUMN updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    ADD COLUMN sequence   integer                  NOT NULL DEFAULT 0;
+
+CREATE FUNCTION broadcasts_touch() RETURNS trigger AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    NEW.sequence = OLD.sequence + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER broadcasts_touch
+    BEFORE UPDATE
+    ON broadcasts
+    FOR EACH ROW
+    WHEN ((OLD.name, OLD.description, OLD.start_time, OLD.end_time) IS DISTINCT FROM
+          (NEW.name, NEW.description, NEW.start_time, NEW.end_time))
+EXECUTE PROCEDURE broadcasts_touch();
+
+CREATE TABLE calendar_feeds
+(
+    token      VARCHAR(64)              NOT NULL PRIMARY KEY,
+    username   VARCHAR(100)             NOT NULL,
+    scope      VARCHAR(10)              NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    UNIQUE (username, scope)
+);
@@ -0,0 +1 @@
+DROP INDEX broadcasts_streamkey_idx;
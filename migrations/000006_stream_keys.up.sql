@@ -0,0 +1,8 @@
+UPDATE broadcasts
+SET streamkey = replace(uuid_generate_v4()::text || uuid_generate_v4()::text, '-', '')
+WHERE id IN (SELECT id
+             FROM (SELECT id, row_number() OVER (PARTITION BY streamkey ORDER BY start_time) AS rn
+                   FROM broadcasts) d
+             WHERE d.rn > 1);
+
+CREATE UNIQUE INDEX broadcasts_streamkey_idx ON broadcasts (streamkey);
@@ -0,0 +1,6 @@
+DROP TABLE recordings;
+
+DROP INDEX stream_streamkey_idx;
+
+ALTER TABLE stream
+    DROP COLUMN streamkey;
@@ -0,0 +1,20 @@
+ALTER TABLE stream
+    ADD COLUMN streamkey VARCHAR(150);
+
+UPDATE stream
+SET streamkey = replace(uuid_generate_v4()::text || uuid_generate_v4()::text, '-', '');
+
+ALTER TABLE stream
+    ALTER COLUMN streamkey SET NOT NULL;
+
+CREATE UNIQUE INDEX stream_streamkey_idx ON stream (streamkey);
+
+CREATE TABLE recordings
+(
+    id           UUID                     NOT NULL PRIMARY KEY,
+    broadcast_id UUID                     NOT NULL REFERENCES broadcasts (id) ON DELETE CASCADE,
+    path         TEXT                     NOT NULL,
+    created_at   timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX recordings_broadcast_id_idx ON recordings (broadcast_id);
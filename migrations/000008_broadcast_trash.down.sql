@@ -0,0 +1,5 @@
+DROP INDEX broadcasts_deleted_at_idx;
+
+ALTER TABLE broadcasts
+    DROP COLUMN deleted_by,
+    DROP COLUMN deleted_at;
@@ -0,0 +1,5 @@
+ALTER TABLE broadcasts
+    ADD COLUMN deleted_at timestamp with time zone,
+    ADD COLUMN deleted_by VARCHAR(100);
+
+CREATE INDEX broadcasts_deleted_at_idx ON broadcasts (deleted_at) WHERE deleted_at IS NOT NULL;
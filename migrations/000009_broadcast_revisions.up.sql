@@ -0,0 +1,12 @@
+CREATE TABLE revisions
+(
+    id           UUID                     NOT NULL PRIMARY KEY,
+    broadcast_id UUID                     NOT NULL REFERENCES broadcasts (id) ON DELETE CASCADE,
+    action       VARCHAR(10)              NOT NULL,
+    actor        VARCHAR(100),
+    changes      JSONB                    NOT NULL DEFAULT '[]',
+    snapshot     JSONB                    NOT NULL,
+    created_at   timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX revisions_broadcast_id_idx ON revisions (broadcast_id, created_at);
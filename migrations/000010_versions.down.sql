@@ -0,0 +1,9 @@
+DROP TRIGGER stream_version ON stream;
+DROP TRIGGER broadcasts_version ON broadcasts;
+DROP FUNCTION bump_version();
+
+ALTER TABLE stream
+    DROP COLUMN version;
+
+ALTER TABLE broadcasts
+    DROP COLUMN version;
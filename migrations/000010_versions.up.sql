@@ -0,0 +1,25 @@
+ALTER TABLE broadcasts
+    ADD COLUMN version integer NOT NULL DEFAULT 1;
+
+ALTER TABLE stream
+    ADD COLUMN version integer NOT NULL DEFAULT 1;
+
+CREATE FUNCTION bump_version() RETURNS trigger AS
+$$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER broadcasts_version
+    BEFORE UPDATE
+    ON broadcasts
+    FOR EACH ROW
+EXECUTE PROCEDURE bump_version();
+
+CREATE TRIGGER stream_version
+    BEFORE UPDATE
+    ON stream
+    FOR EACH ROW
+EXECUTE PROCEDURE bump_version();
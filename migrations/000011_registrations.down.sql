@@ -0,0 +1,4 @@
+DROP TABLE registrations;
+
+ALTER TABLE broadcasts
+    DROP COLUMN capacity;
@@ -0,0 +1,16 @@
+ALTER TABLE broadcasts
+    ADD COLUMN capacity integer CHECK (capacity > 0);
+
+CREATE TABLE registrations
+(
+    id           UUID                     NOT NULL PRIMARY KEY,
+    broadcast_id UUID                     NOT NULL REFERENCES broadcasts (id) ON DELETE CASCADE,
+    username     VARCHAR(200)             NOT NULL,
+    fullname     VARCHAR(200),
+    email        VARCHAR(100),
+    status       VARCHAR(10)              NOT NULL,
+    created_at   timestamp with time zone NOT NULL DEFAULT now(),
+    UNIQUE (broadcast_id, username)
+);
+
+CREATE INDEX registrations_waitlist_idx ON registrations (broadcast_id, created_at) WHERE status = 'waitlisted';
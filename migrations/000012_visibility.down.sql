@@ -0,0 +1,7 @@
+DROP TABLE broadcast_access;
+
+DROP INDEX broadcasts_invite_token_idx;
+
+ALTER TABLE broadcasts
+    DROP COLUMN invite_token,
+    DROP COLUMN visibility;
@@ -0,0 +1,15 @@
+ALTER TABLE broadcasts
+    ADD COLUMN visibility   VARCHAR(16) NOT NULL DEFAULT 'public'
+        CHECK (visibility IN ('public', 'internal', 'invite-only')),
+    ADD COLUMN invite_token VARCHAR(64);
+
+CREATE UNIQUE INDEX broadcasts_invite_token_idx ON broadcasts (invite_token);
+
+CREATE TABLE broadcast_access
+(
+    broadcast_id UUID                     NOT NULL REFERENCES broadcasts (id) ON DELETE CASCADE,
+    username     VARCHAR(200)             NOT NULL,
+    granted_by   VARCHAR(200),
+    created_at   timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (broadcast_id, username)
+);
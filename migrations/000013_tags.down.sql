@@ -0,0 +1,5 @@
+DROP TABLE broadcast_tags;
+
+DROP TABLE tags;
+
+DROP TABLE categories;
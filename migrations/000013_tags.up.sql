@@ -0,0 +1,23 @@
+CREATE TABLE categories
+(
+    id         UUID PRIMARY KEY,
+    name       VARCHAR(100)             NOT NULL UNIQUE,
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE TABLE tags
+(
+    id          UUID PRIMARY KEY,
+    name        VARCHAR(100)             NOT NULL UNIQUE,
+    category_id UUID REFERENCES categories (id) ON DELETE SET NULL,
+    created_at  timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE TABLE broadcast_tags
+(
+    broadcast_id UUID NOT NULL REFERENCES broadcasts (id) ON DELETE CASCADE,
+    tag_id       UUID NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (broadcast_id, tag_id)
+);
+
+CREATE INDEX broadcast_tags_tag_id_idx ON broadcast_tags (tag_id);
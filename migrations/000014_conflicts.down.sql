@@ -0,0 +1 @@
+DROP INDEX broadcasts_owner_start_time_idx;
@@ -0,0 +1 @@
+CREATE INDEX broadcasts_owner_start_time_idx ON broadcasts (owner, start_time);
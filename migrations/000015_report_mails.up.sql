@@ -0,0 +1,9 @@
+CREATE TABLE report_mails
+(
+    broadcast_id UUID                     NOT NULL PRIMARY KEY REFERENCES broadcasts (id) ON DELETE CASCADE,
+    email        VARCHAR(100)             NOT NULL,
+    created_at   timestamp with time zone NOT NULL DEFAULT now(),
+    sent_at      timestamp with time zone
+);
+
+CREATE INDEX report_mails_pending_idx ON report_mails (broadcast_id) WHERE sent_at IS NULL;
@@ -0,0 +1,39 @@
+CREATE TABLE webhooks
+(
+    id         UUID                     NOT NULL PRIMARY KEY,
+    url        TEXT                     NOT NULL,
+    secret     VARCHAR(64)              NOT NULL,
+    events     jsonb                    NOT NULL DEFAULT '[]'::jsonb,
+    active     BOOLEAN                  NOT NULL DEFAULT TRUE,
+    created_by VARCHAR(150),
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE TABLE webhook_deliveries
+(
+    id              UUID                     NOT NULL PRIMARY KEY,
+    webhook_id      UUID                     NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_id        UUID                     NOT NULL,
+    event           VARCHAR(50)              NOT NULL,
+    payload         jsonb                    NOT NULL,
+    status          VARCHAR(10)              NOT NULL DEFAULT 'pending',
+    attempts        integer                  NOT NULL DEFAULT 0,
+    next_attempt_at timestamp with time zone,
+    delivered_at    timestamp with time zone,
+    created_at      timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX webhook_deliveries_webhook_idx ON webhook_deliveries (webhook_id, created_at);
+
+CREATE TABLE webhook_attempts
+(
+    id          UUID                     NOT NULL PRIMARY KEY,
+    delivery_id UUID                     NOT NULL REFERENCES webhook_deliveries (id) ON DELETE CASCADE,
+    time        timestamp with time zone NOT NULL,
+    status_code integer,
+    error       TEXT,
+    duration_ms integer                  NOT NULL
+);
+
+CREATE INDEX webhook_attempts_delivery_idx ON webhook_attempts (delivery_id, time);
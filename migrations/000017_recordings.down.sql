@@ -0,0 +1,12 @@
+DELETE
+FROM recordings
+WHERE path IS NULL;
+
+ALTER TABLE recordings
+    DROP CONSTRAINT recordings_source_check,
+    DROP COLUMN url,
+    DROP COLUMN duration,
+    DROP COLUMN size,
+    DROP COLUMN format,
+    DROP COLUMN created_by,
+    ALTER COLUMN path SET NOT NULL;
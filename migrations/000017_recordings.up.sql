@@ -0,0 +1,8 @@
+ALTER TABLE recordings
+    ALTER COLUMN path DROP NOT NULL,
+    ADD COLUMN url        TEXT,
+    ADD COLUMN duration   integer,
+    ADD COLUMN size       bigint,
+    ADD COLUMN format     VARCHAR(20),
+    ADD COLUMN created_by VARCHAR(100),
+    ADD CONSTRAINT recordings_source_check CHECK (path IS NOT NULL OR url IS NOT NULL);
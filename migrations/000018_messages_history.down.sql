@@ -0,0 +1 @@
+DROP INDEX messages_channel_time_idx;
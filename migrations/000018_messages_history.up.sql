@@ -0,0 +1 @@
+CREATE INDEX messages_channel_time_idx ON messages (channel, time, id);
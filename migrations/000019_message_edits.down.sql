@@ -0,0 +1,4 @@
+DROP TABLE message_edits;
+
+ALTER TABLE messages
+    DROP COLUMN edited_at;
@@ -0,0 +1,13 @@
+ALTER TABLE messages
+    ADD COLUMN edited_at timestamp with time zone;
+
+CREATE TABLE message_edits
+(
+    id         UUID                     NOT NULL PRIMARY KEY,
+    message_id UUID                     NOT NULL REFERENCES messages (id) ON DELETE CASCADE,
+    text       TEXT                     NOT NULL,
+    edited_by  VARCHAR(150)             NOT NULL,
+    edited_at  timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX message_edits_message_idx ON message_edits (message_id, edited_at);
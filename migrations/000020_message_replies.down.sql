@@ -0,0 +1,4 @@
+DROP INDEX messages_parent_idx;
+
+ALTER TABLE messages
+    DROP COLUMN parent_id;
@@ -0,0 +1,4 @@
+ALTER TABLE messages
+    ADD COLUMN parent_id UUID REFERENCES messages (id) ON DELETE CASCADE;
+
+CREATE INDEX messages_parent_idx ON messages (parent_id, time, id);
@@ -0,0 +1,7 @@
+DROP TABLE question_votes;
+
+DROP INDEX messages_pinned_idx;
+
+ALTER TABLE messages
+    DROP COLUMN pinned_at,
+    DROP COLUMN question_state;
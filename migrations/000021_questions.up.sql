@@ -0,0 +1,17 @@
+ALTER TABLE messages
+    ADD COLUMN question_state VARCHAR(20),
+    ADD COLUMN pinned_at      timestamp with time zone;
+
+UPDATE messages
+SET question_state = 'approved'
+WHERE is_question;
+
+CREATE UNIQUE INDEX messages_pinned_idx ON messages (channel) WHERE pinned_at IS NOT NULL;
+
+CREATE TABLE question_votes
+(
+    message_id UUID                     NOT NULL REFERENCES messages (id) ON DELETE CASCADE,
+    username   VARCHAR(150)             NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (message_id, username)
+);
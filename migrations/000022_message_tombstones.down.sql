@@ -0,0 +1,3 @@
+ALTER TABLE messages
+    DROP COLUMN deleted_at,
+    DROP COLUMN created_at;
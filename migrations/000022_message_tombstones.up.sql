@@ -0,0 +1,10 @@
+ALTER TABLE messages
+    ADD COLUMN created_at timestamp with time zone,
+    ADD COLUMN deleted_at timestamp with time zone;
+
+UPDATE messages
+SET created_at = least(time, now());
+
+ALTER TABLE messages
+    ALTER COLUMN created_at SET DEFAULT now(),
+    ALTER COLUMN created_at SET NOT NULL;
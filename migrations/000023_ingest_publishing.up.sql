@@ -0,0 +1,2 @@
+ALTER TABLE broadcasts
+    ADD COLUMN publishing_at timestamp with time zone;
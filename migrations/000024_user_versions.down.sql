@@ -0,0 +1,14 @@
+DROP TRIGGER broadcasts_version ON broadcasts;
+DROP TRIGGER stream_version ON stream;
+
+CREATE TRIGGER broadcasts_version
+    BEFORE UPDATE
+    ON broadcasts
+    FOR EACH ROW
+EXECUTE PROCEDURE bump_version();
+
+CREATE TRIGGER stream_version
+    BEFORE UPDATE
+    ON stream
+    FOR EACH ROW
+EXECUTE PROCEDURE bump_version();
@@ -0,0 +1,17 @@
+DROP TRIGGER broadcasts_version ON broadcasts;
+DROP TRIGGER stream_version ON stream;
+
+CREATE TRIGGER broadcasts_version
+    BEFORE UPDATE
+    ON broadcasts
+    FOR EACH ROW
+    WHEN ((OLD.name, OLD.description, OLD.start_time, OLD.end_time, OLD.capacity, OLD.visibility)
+        IS DISTINCT FROM (NEW.name, NEW.description, NEW.start_time, NEW.end_time, NEW.capacity, NEW.visibility))
+EXECUTE PROCEDURE bump_version();
+
+CREATE TRIGGER stream_version
+    BEFORE UPDATE
+    ON stream
+    FOR EACH ROW
+    WHEN (OLD.description IS DISTINCT FROM NEW.description)
+EXECUTE PROCEDURE bump_version();